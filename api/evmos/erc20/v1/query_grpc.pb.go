@@ -22,9 +22,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_TokenPairs_FullMethodName = "/evmos.erc20.v1.Query/TokenPairs"
-	Query_TokenPair_FullMethodName  = "/evmos.erc20.v1.Query/TokenPair"
-	Query_Params_FullMethodName     = "/evmos.erc20.v1.Query/Params"
+	Query_TokenPairs_FullMethodName         = "/evmos.erc20.v1.Query/TokenPairs"
+	Query_TokenPair_FullMethodName          = "/evmos.erc20.v1.Query/TokenPair"
+	Query_Params_FullMethodName             = "/evmos.erc20.v1.Query/Params"
+	Query_Reconcile_FullMethodName          = "/evmos.erc20.v1.Query/Reconcile"
+	Query_Invariants_FullMethodName         = "/evmos.erc20.v1.Query/Invariants"
+	Query_FilteredTokenPairs_FullMethodName = "/evmos.erc20.v1.Query/FilteredTokenPairs"
+	Query_ConversionStats_FullMethodName    = "/evmos.erc20.v1.Query/ConversionStats"
+	Query_AllConversionStats_FullMethodName = "/evmos.erc20.v1.Query/AllConversionStats"
+	Query_FeeTokens_FullMethodName          = "/evmos.erc20.v1.Query/FeeTokens"
+	Query_FeeTokenRate_FullMethodName       = "/evmos.erc20.v1.Query/FeeTokenRate"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +44,24 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Reconcile compares, for each registered token pair, the balance escrowed
+	// by the module account with the supply of the converted representation
+	Reconcile(ctx context.Context, in *QueryReconcileRequest, opts ...grpc.CallOption) (*QueryReconcileResponse, error)
+	// Invariants runs the erc20 module invariants and returns the broken ones
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// FilteredTokenPairs retrieves the registered token pairs matching the given
+	// filters
+	FilteredTokenPairs(ctx context.Context, in *QueryFilteredTokenPairsRequest, opts ...grpc.CallOption) (*QueryFilteredTokenPairsResponse, error)
+	// ConversionStats retrieves the cumulative conversion volume of a registered
+	// token pair
+	ConversionStats(ctx context.Context, in *QueryConversionStatsRequest, opts ...grpc.CallOption) (*QueryConversionStatsResponse, error)
+	// AllConversionStats retrieves the cumulative conversion volumes of all token
+	// pairs
+	AllConversionStats(ctx context.Context, in *QueryAllConversionStatsRequest, opts ...grpc.CallOption) (*QueryAllConversionStatsResponse, error)
+	// FeeTokens retrieves the tokens whitelisted to pay Ethereum transaction fees
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// FeeTokenRate retrieves the current conversion rate of a fee token
+	FeeTokenRate(ctx context.Context, in *QueryFeeTokenRateRequest, opts ...grpc.CallOption) (*QueryFeeTokenRateResponse, error)
 }
 
 type queryClient struct {
@@ -74,6 +99,69 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Reconcile(ctx context.Context, in *QueryReconcileRequest, opts ...grpc.CallOption) (*QueryReconcileResponse, error) {
+	out := new(QueryReconcileResponse)
+	err := c.cc.Invoke(ctx, Query_Reconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, Query_Invariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FilteredTokenPairs(ctx context.Context, in *QueryFilteredTokenPairsRequest, opts ...grpc.CallOption) (*QueryFilteredTokenPairsResponse, error) {
+	out := new(QueryFilteredTokenPairsResponse)
+	err := c.cc.Invoke(ctx, Query_FilteredTokenPairs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionStats(ctx context.Context, in *QueryConversionStatsRequest, opts ...grpc.CallOption) (*QueryConversionStatsResponse, error) {
+	out := new(QueryConversionStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ConversionStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllConversionStats(ctx context.Context, in *QueryAllConversionStatsRequest, opts ...grpc.CallOption) (*QueryAllConversionStatsResponse, error) {
+	out := new(QueryAllConversionStatsResponse)
+	err := c.cc.Invoke(ctx, Query_AllConversionStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, Query_FeeTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokenRate(ctx context.Context, in *QueryFeeTokenRateRequest, opts ...grpc.CallOption) (*QueryFeeTokenRateResponse, error) {
+	out := new(QueryFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, Query_FeeTokenRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -84,6 +172,24 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Reconcile compares, for each registered token pair, the balance escrowed
+	// by the module account with the supply of the converted representation
+	Reconcile(context.Context, *QueryReconcileRequest) (*QueryReconcileResponse, error)
+	// Invariants runs the erc20 module invariants and returns the broken ones
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// FilteredTokenPairs retrieves the registered token pairs matching the given
+	// filters
+	FilteredTokenPairs(context.Context, *QueryFilteredTokenPairsRequest) (*QueryFilteredTokenPairsResponse, error)
+	// ConversionStats retrieves the cumulative conversion volume of a registered
+	// token pair
+	ConversionStats(context.Context, *QueryConversionStatsRequest) (*QueryConversionStatsResponse, error)
+	// AllConversionStats retrieves the cumulative conversion volumes of all token
+	// pairs
+	AllConversionStats(context.Context, *QueryAllConversionStatsRequest) (*QueryAllConversionStatsResponse, error)
+	// FeeTokens retrieves the tokens whitelisted to pay Ethereum transaction fees
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// FeeTokenRate retrieves the current conversion rate of a fee token
+	FeeTokenRate(context.Context, *QueryFeeTokenRateRequest) (*QueryFeeTokenRateResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -100,6 +206,27 @@ func (UnimplementedQueryServer) TokenPair(context.Context, *QueryTokenPairReques
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) Reconcile(context.Context, *QueryReconcileRequest) (*QueryReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
+}
+func (UnimplementedQueryServer) Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (UnimplementedQueryServer) FilteredTokenPairs(context.Context, *QueryFilteredTokenPairsRequest) (*QueryFilteredTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilteredTokenPairs not implemented")
+}
+func (UnimplementedQueryServer) ConversionStats(context.Context, *QueryConversionStatsRequest) (*QueryConversionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionStats not implemented")
+}
+func (UnimplementedQueryServer) AllConversionStats(context.Context, *QueryAllConversionStatsRequest) (*QueryAllConversionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllConversionStats not implemented")
+}
+func (UnimplementedQueryServer) FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (UnimplementedQueryServer) FeeTokenRate(context.Context, *QueryFeeTokenRateRequest) (*QueryFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenRate not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +294,132 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Reconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Reconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reconcile(ctx, req.(*QueryReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Invariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FilteredTokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFilteredTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FilteredTokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FilteredTokenPairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FilteredTokenPairs(ctx, req.(*QueryFilteredTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConversionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionStats(ctx, req.(*QueryConversionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllConversionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllConversionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllConversionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllConversionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllConversionStats(ctx, req.(*QueryAllConversionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeTokenRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenRate(ctx, req.(*QueryFeeTokenRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -186,6 +439,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Reconcile",
+			Handler:    _Query_Reconcile_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "FilteredTokenPairs",
+			Handler:    _Query_FilteredTokenPairs_Handler,
+		},
+		{
+			MethodName: "ConversionStats",
+			Handler:    _Query_ConversionStats_Handler,
+		},
+		{
+			MethodName: "AllConversionStats",
+			Handler:    _Query_AllConversionStats_Handler,
+		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "FeeTokenRate",
+			Handler:    _Query_FeeTokenRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/query.proto",
@@ -22,10 +22,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/evmos.erc20.v1.Msg/ConvertERC20"
-	Msg_UpdateParams_FullMethodName     = "/evmos.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/evmos.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/evmos.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName                = "/evmos.erc20.v1.Msg/ConvertERC20"
+	Msg_UpdateParams_FullMethodName                = "/evmos.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName               = "/evmos.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName            = "/evmos.erc20.v1.Msg/ToggleConversion"
+	Msg_SetTokenPairMinter_FullMethodName          = "/evmos.erc20.v1.Msg/SetTokenPairMinter"
+	Msg_CreateDenom_FullMethodName                 = "/evmos.erc20.v1.Msg/CreateDenom"
+	Msg_Mint_FullMethodName                        = "/evmos.erc20.v1.Msg/Mint"
+	Msg_Burn_FullMethodName                        = "/evmos.erc20.v1.Msg/Burn"
+	Msg_ChangeAdmin_FullMethodName                 = "/evmos.erc20.v1.Msg/ChangeAdmin"
+	Msg_SetDenomMetadata_FullMethodName            = "/evmos.erc20.v1.Msg/SetDenomMetadata"
+	Msg_PermissionlessRegisterERC20_FullMethodName = "/evmos.erc20.v1.Msg/PermissionlessRegisterERC20"
+	Msg_UpdateTokenPairMetadata_FullMethodName     = "/evmos.erc20.v1.Msg/UpdateTokenPairMetadata"
+	Msg_MigrateTokenPair_FullMethodName            = "/evmos.erc20.v1.Msg/MigrateTokenPair"
+	Msg_RemoveTokenPair_FullMethodName             = "/evmos.erc20.v1.Msg/RemoveTokenPair"
+	Msg_RegisterFeeToken_FullMethodName            = "/evmos.erc20.v1.Msg/RegisterFeeToken"
+	Msg_RemoveFeeToken_FullMethodName              = "/evmos.erc20.v1.Msg/RemoveFeeToken"
+	Msg_UpdateFeeTokenPrice_FullMethodName         = "/evmos.erc20.v1.Msg/UpdateFeeTokenPrice"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +57,53 @@ type MsgClient interface {
 	// ToggleConversion defines a governance operation for enabling/disablen a token pair conversion.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// SetTokenPairMinter defines a governance operation for setting the address allowed to
+	// mint and burn the tokens of a module owned token pair through the ERC20 precompile.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairMinter(ctx context.Context, in *MsgSetTokenPairMinter, opts ...grpc.CallOption) (*MsgSetTokenPairMinterResponse, error)
+	// CreateDenom creates a new token factory denomination owned by the sender and
+	// registers its ERC20 extension.
+	CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error)
+	// Mint mints tokens of a token factory denomination. Only callable by the denom admin.
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// Burn burns tokens of a token factory denomination from the admin balance.
+	// Only callable by the denom admin.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// ChangeAdmin changes the admin of a token factory denomination. Only callable
+	// by the denom admin.
+	ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error)
+	// SetDenomMetadata overwrites the bank metadata of a token factory denomination.
+	// Only callable by the denom admin.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// PermissionlessRegisterERC20 registers a token pair for an ERC20 contract
+	// without a governance proposal. The sender posts the refundable registration
+	// deposit and the contract is verified before the token pair is created.
+	PermissionlessRegisterERC20(ctx context.Context, in *MsgPermissionlessRegisterERC20, opts ...grpc.CallOption) (*MsgPermissionlessRegisterERC20Response, error)
+	// UpdateTokenPairMetadata defines a governance operation for overwriting the
+	// bank metadata of a registered token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for pointing an ERC20 token
+	// pair to an upgraded ERC20 contract. The tokens escrowed in the previous
+	// contract are transferred to the escrow recipient.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error)
+	// RemoveTokenPair defines a governance operation for deleting a disabled token
+	// pair with no escrowed balance.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RemoveTokenPair(ctx context.Context, in *MsgRemoveTokenPair, opts ...grpc.CallOption) (*MsgRemoveTokenPairResponse, error)
+	// RegisterFeeToken defines a governance operation for whitelisting a token pair
+	// denomination to pay Ethereum transaction fees, or updating its rate source.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a token from
+	// the fee token whitelist.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error)
+	// UpdateFeeTokenPrice defines a governance operation for recording a price of a
+	// fee token in the oracle store.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateFeeTokenPrice(ctx context.Context, in *MsgUpdateFeeTokenPrice, opts ...grpc.CallOption) (*MsgUpdateFeeTokenPriceResponse, error)
 }
 
 type msgClient struct {
@@ -90,6 +150,123 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) SetTokenPairMinter(ctx context.Context, in *MsgSetTokenPairMinter, opts ...grpc.CallOption) (*MsgSetTokenPairMinterResponse, error) {
+	out := new(MsgSetTokenPairMinterResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTokenPairMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error) {
+	out := new(MsgCreateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, Msg_Mint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_Burn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error) {
+	out := new(MsgChangeAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangeAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PermissionlessRegisterERC20(ctx context.Context, in *MsgPermissionlessRegisterERC20, opts ...grpc.CallOption) (*MsgPermissionlessRegisterERC20Response, error) {
+	out := new(MsgPermissionlessRegisterERC20Response)
+	err := c.cc.Invoke(ctx, Msg_PermissionlessRegisterERC20_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTokenPairMetadata(ctx context.Context, in *MsgUpdateTokenPairMetadata, opts ...grpc.CallOption) (*MsgUpdateTokenPairMetadataResponse, error) {
+	out := new(MsgUpdateTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTokenPairMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateTokenPair(ctx context.Context, in *MsgMigrateTokenPair, opts ...grpc.CallOption) (*MsgMigrateTokenPairResponse, error) {
+	out := new(MsgMigrateTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateTokenPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveTokenPair(ctx context.Context, in *MsgRemoveTokenPair, opts ...grpc.CallOption) (*MsgRemoveTokenPairResponse, error) {
+	out := new(MsgRemoveTokenPairResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveTokenPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterFeeToken(ctx context.Context, in *MsgRegisterFeeToken, opts ...grpc.CallOption) (*MsgRegisterFeeTokenResponse, error) {
+	out := new(MsgRegisterFeeTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterFeeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error) {
+	out := new(MsgRemoveFeeTokenResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFeeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeTokenPrice(ctx context.Context, in *MsgUpdateFeeTokenPrice, opts ...grpc.CallOption) (*MsgUpdateFeeTokenPriceResponse, error) {
+	out := new(MsgUpdateFeeTokenPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeTokenPrice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -106,6 +283,53 @@ type MsgServer interface {
 	// ToggleConversion defines a governance operation for enabling/disablen a token pair conversion.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// SetTokenPairMinter defines a governance operation for setting the address allowed to
+	// mint and burn the tokens of a module owned token pair through the ERC20 precompile.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetTokenPairMinter(context.Context, *MsgSetTokenPairMinter) (*MsgSetTokenPairMinterResponse, error)
+	// CreateDenom creates a new token factory denomination owned by the sender and
+	// registers its ERC20 extension.
+	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
+	// Mint mints tokens of a token factory denomination. Only callable by the denom admin.
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	// Burn burns tokens of a token factory denomination from the admin balance.
+	// Only callable by the denom admin.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// ChangeAdmin changes the admin of a token factory denomination. Only callable
+	// by the denom admin.
+	ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error)
+	// SetDenomMetadata overwrites the bank metadata of a token factory denomination.
+	// Only callable by the denom admin.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// PermissionlessRegisterERC20 registers a token pair for an ERC20 contract
+	// without a governance proposal. The sender posts the refundable registration
+	// deposit and the contract is verified before the token pair is created.
+	PermissionlessRegisterERC20(context.Context, *MsgPermissionlessRegisterERC20) (*MsgPermissionlessRegisterERC20Response, error)
+	// UpdateTokenPairMetadata defines a governance operation for overwriting the
+	// bank metadata of a registered token pair.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateTokenPairMetadata(context.Context, *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error)
+	// MigrateTokenPair defines a governance operation for pointing an ERC20 token
+	// pair to an upgraded ERC20 contract. The tokens escrowed in the previous
+	// contract are transferred to the escrow recipient.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error)
+	// RemoveTokenPair defines a governance operation for deleting a disabled token
+	// pair with no escrowed balance.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RemoveTokenPair(context.Context, *MsgRemoveTokenPair) (*MsgRemoveTokenPairResponse, error)
+	// RegisterFeeToken defines a governance operation for whitelisting a token pair
+	// denomination to pay Ethereum transaction fees, or updating its rate source.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RegisterFeeToken(context.Context, *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a token from
+	// the fee token whitelist.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error)
+	// UpdateFeeTokenPrice defines a governance operation for recording a price of a
+	// fee token in the oracle store.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateFeeTokenPrice(context.Context, *MsgUpdateFeeTokenPrice) (*MsgUpdateFeeTokenPriceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -125,6 +349,45 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) SetTokenPairMinter(context.Context, *MsgSetTokenPairMinter) (*MsgSetTokenPairMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTokenPairMinter not implemented")
+}
+func (UnimplementedMsgServer) CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (UnimplementedMsgServer) ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAdmin not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) PermissionlessRegisterERC20(context.Context, *MsgPermissionlessRegisterERC20) (*MsgPermissionlessRegisterERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PermissionlessRegisterERC20 not implemented")
+}
+func (UnimplementedMsgServer) UpdateTokenPairMetadata(context.Context, *MsgUpdateTokenPairMetadata) (*MsgUpdateTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenPairMetadata not implemented")
+}
+func (UnimplementedMsgServer) MigrateTokenPair(context.Context, *MsgMigrateTokenPair) (*MsgMigrateTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateTokenPair not implemented")
+}
+func (UnimplementedMsgServer) RemoveTokenPair(context.Context, *MsgRemoveTokenPair) (*MsgRemoveTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTokenPair not implemented")
+}
+func (UnimplementedMsgServer) RegisterFeeToken(context.Context, *MsgRegisterFeeToken) (*MsgRegisterFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterFeeToken not implemented")
+}
+func (UnimplementedMsgServer) RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeToken not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeeTokenPrice(context.Context, *MsgUpdateFeeTokenPrice) (*MsgUpdateFeeTokenPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokenPrice not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +473,240 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTokenPairMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTokenPairMinter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTokenPairMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTokenPairMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTokenPairMinter(ctx, req.(*MsgSetTokenPairMinter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Burn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangeAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeAdmin(ctx, req.(*MsgChangeAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PermissionlessRegisterERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPermissionlessRegisterERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PermissionlessRegisterERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PermissionlessRegisterERC20_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PermissionlessRegisterERC20(ctx, req.(*MsgPermissionlessRegisterERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTokenPairMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTokenPairMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTokenPairMetadata(ctx, req.(*MsgUpdateTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateTokenPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateTokenPair(ctx, req.(*MsgMigrateTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTokenPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveTokenPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTokenPair(ctx, req.(*MsgRemoveTokenPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterFeeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterFeeToken(ctx, req.(*MsgRegisterFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFeeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeToken(ctx, req.(*MsgRemoveFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeTokenPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokenPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokenPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeTokenPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokenPrice(ctx, req.(*MsgUpdateFeeTokenPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +730,58 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "SetTokenPairMinter",
+			Handler:    _Msg_SetTokenPairMinter_Handler,
+		},
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "ChangeAdmin",
+			Handler:    _Msg_ChangeAdmin_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "PermissionlessRegisterERC20",
+			Handler:    _Msg_PermissionlessRegisterERC20_Handler,
+		},
+		{
+			MethodName: "UpdateTokenPairMetadata",
+			Handler:    _Msg_UpdateTokenPairMetadata_Handler,
+		},
+		{
+			MethodName: "MigrateTokenPair",
+			Handler:    _Msg_MigrateTokenPair_Handler,
+		},
+		{
+			MethodName: "RemoveTokenPair",
+			Handler:    _Msg_RemoveTokenPair_Handler,
+		},
+		{
+			MethodName: "RegisterFeeToken",
+			Handler:    _Msg_RegisterFeeToken_Handler,
+		},
+		{
+			MethodName: "RemoveFeeToken",
+			Handler:    _Msg_RemoveFeeToken_Handler,
+		},
+		{
+			MethodName: "UpdateFeeTokenPrice",
+			Handler:    _Msg_UpdateFeeTokenPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/tx.proto",
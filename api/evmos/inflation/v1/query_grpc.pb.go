@@ -22,12 +22,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Period_FullMethodName             = "/evmos.inflation.v1.Query/Period"
-	Query_EpochMintProvision_FullMethodName = "/evmos.inflation.v1.Query/EpochMintProvision"
-	Query_SkippedEpochs_FullMethodName      = "/evmos.inflation.v1.Query/SkippedEpochs"
-	Query_CirculatingSupply_FullMethodName  = "/evmos.inflation.v1.Query/CirculatingSupply"
-	Query_InflationRate_FullMethodName      = "/evmos.inflation.v1.Query/InflationRate"
-	Query_Params_FullMethodName             = "/evmos.inflation.v1.Query/Params"
+	Query_Period_FullMethodName              = "/evmos.inflation.v1.Query/Period"
+	Query_EpochMintProvision_FullMethodName  = "/evmos.inflation.v1.Query/EpochMintProvision"
+	Query_SkippedEpochs_FullMethodName       = "/evmos.inflation.v1.Query/SkippedEpochs"
+	Query_CirculatingSupply_FullMethodName   = "/evmos.inflation.v1.Query/CirculatingSupply"
+	Query_InflationRate_FullMethodName       = "/evmos.inflation.v1.Query/InflationRate"
+	Query_InflationProjection_FullMethodName = "/evmos.inflation.v1.Query/InflationProjection"
+	Query_Params_FullMethodName              = "/evmos.inflation.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +46,9 @@ type QueryClient interface {
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// InflationProjection projects the mint provisions and supply of the next
+	// periods with the current or the given hypothetical params.
+	InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -102,6 +106,15 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error) {
+	out := new(QueryInflationProjectionResponse)
+	err := c.cc.Invoke(ctx, Query_InflationProjection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -126,6 +139,9 @@ type QueryServer interface {
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// InflationProjection projects the mint provisions and supply of the next
+	// periods with the current or the given hypothetical params.
+	InflationProjection(context.Context, *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -150,6 +166,9 @@ func (UnimplementedQueryServer) CirculatingSupply(context.Context, *QueryCircula
 func (UnimplementedQueryServer) InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (UnimplementedQueryServer) InflationProjection(context.Context, *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjection not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -256,6 +275,24 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InflationProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjection(ctx, req.(*QueryInflationProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -301,6 +338,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "InflationProjection",
+			Handler:    _Query_InflationProjection_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
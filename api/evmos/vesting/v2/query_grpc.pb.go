@@ -22,7 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Balances_FullMethodName = "/evmos.vesting.v2.Query/Balances"
+	Query_Balances_FullMethodName                = "/evmos.vesting.v2.Query/Balances"
+	Query_ClawbackVestingAccounts_FullMethodName = "/evmos.vesting.v2.Query/ClawbackVestingAccounts"
+	Query_UnvestedSupply_FullMethodName          = "/evmos.vesting.v2.Query/UnvestedSupply"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,12 @@ const (
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// ClawbackVestingAccounts retrieves all clawback vesting accounts, optionally
+	// filtered by funder
+	ClawbackVestingAccounts(ctx context.Context, in *QueryClawbackVestingAccountsRequest, opts ...grpc.CallOption) (*QueryClawbackVestingAccountsResponse, error)
+	// UnvestedSupply retrieves the total unvested and locked tokens held by all
+	// clawback vesting accounts at the current block time
+	UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -50,12 +58,36 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ClawbackVestingAccounts(ctx context.Context, in *QueryClawbackVestingAccountsRequest, opts ...grpc.CallOption) (*QueryClawbackVestingAccountsResponse, error) {
+	out := new(QueryClawbackVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, Query_ClawbackVestingAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error) {
+	out := new(QueryUnvestedSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_UnvestedSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// ClawbackVestingAccounts retrieves all clawback vesting accounts, optionally
+	// filtered by funder
+	ClawbackVestingAccounts(context.Context, *QueryClawbackVestingAccountsRequest) (*QueryClawbackVestingAccountsResponse, error)
+	// UnvestedSupply retrieves the total unvested and locked tokens held by all
+	// clawback vesting accounts at the current block time
+	UnvestedSupply(context.Context, *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -66,6 +98,12 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (UnimplementedQueryServer) ClawbackVestingAccounts(context.Context, *QueryClawbackVestingAccountsRequest) (*QueryClawbackVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackVestingAccounts not implemented")
+}
+func (UnimplementedQueryServer) UnvestedSupply(context.Context, *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnvestedSupply not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -97,6 +135,42 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClawbackVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClawbackVestingAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClawbackVestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClawbackVestingAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClawbackVestingAccounts(ctx, req.(*QueryClawbackVestingAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnvestedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnvestedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnvestedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnvestedSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnvestedSupply(ctx, req.(*QueryUnvestedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -108,6 +182,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "ClawbackVestingAccounts",
+			Handler:    _Query_ClawbackVestingAccounts_Handler,
+		},
+		{
+			MethodName: "UnvestedSupply",
+			Handler:    _Query_UnvestedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/query.proto",
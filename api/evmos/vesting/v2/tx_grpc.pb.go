@@ -27,6 +27,11 @@ const (
 	Msg_Clawback_FullMethodName                     = "/evmos.vesting.v2.Msg/Clawback"
 	Msg_UpdateVestingFunder_FullMethodName          = "/evmos.vesting.v2.Msg/UpdateVestingFunder"
 	Msg_ConvertVestingAccount_FullMethodName        = "/evmos.vesting.v2.Msg/ConvertVestingAccount"
+	Msg_AccelerateVesting_FullMethodName            = "/evmos.vesting.v2.Msg/AccelerateVesting"
+	Msg_AcceleratePeriod_FullMethodName             = "/evmos.vesting.v2.Msg/AcceleratePeriod"
+	Msg_ExtendLockup_FullMethodName                 = "/evmos.vesting.v2.Msg/ExtendLockup"
+	Msg_SetUnvestedStaking_FullMethodName           = "/evmos.vesting.v2.Msg/SetUnvestedStaking"
+	Msg_BatchFundVestingAccounts_FullMethodName     = "/evmos.vesting.v2.Msg/BatchFundVestingAccounts"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +50,21 @@ type MsgClient interface {
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// AccelerateVesting vests all the unvested coins of a ClawbackVestingAccount
+	// and optionally unlocks all its locked coins.
+	AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error)
+	// AcceleratePeriod vests a single future vesting period of a
+	// ClawbackVestingAccount.
+	AcceleratePeriod(ctx context.Context, in *MsgAcceleratePeriod, opts ...grpc.CallOption) (*MsgAcceleratePeriodResponse, error)
+	// ExtendLockup delays the future unlocking events of a
+	// ClawbackVestingAccount with the consent of the vesting account.
+	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
+	// SetUnvestedStaking allows or disallows a ClawbackVestingAccount to
+	// delegate its unvested coins.
+	SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error)
+	// BatchFundVestingAccounts funds multiple existing ClawbackVestingAccounts
+	// in a single transaction using shared lockup and vesting schedules.
+	BatchFundVestingAccounts(ctx context.Context, in *MsgBatchFundVestingAccounts, opts ...grpc.CallOption) (*MsgBatchFundVestingAccountsResponse, error)
 }
 
 type msgClient struct {
@@ -100,6 +120,51 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error) {
+	out := new(MsgAccelerateVestingResponse)
+	err := c.cc.Invoke(ctx, Msg_AccelerateVesting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceleratePeriod(ctx context.Context, in *MsgAcceleratePeriod, opts ...grpc.CallOption) (*MsgAcceleratePeriodResponse, error) {
+	out := new(MsgAcceleratePeriodResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceleratePeriod_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error) {
+	out := new(MsgExtendLockupResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendLockup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error) {
+	out := new(MsgSetUnvestedStakingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetUnvestedStaking_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchFundVestingAccounts(ctx context.Context, in *MsgBatchFundVestingAccounts, opts ...grpc.CallOption) (*MsgBatchFundVestingAccountsResponse, error) {
+	out := new(MsgBatchFundVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchFundVestingAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -116,6 +181,21 @@ type MsgServer interface {
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// AccelerateVesting vests all the unvested coins of a ClawbackVestingAccount
+	// and optionally unlocks all its locked coins.
+	AccelerateVesting(context.Context, *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error)
+	// AcceleratePeriod vests a single future vesting period of a
+	// ClawbackVestingAccount.
+	AcceleratePeriod(context.Context, *MsgAcceleratePeriod) (*MsgAcceleratePeriodResponse, error)
+	// ExtendLockup delays the future unlocking events of a
+	// ClawbackVestingAccount with the consent of the vesting account.
+	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
+	// SetUnvestedStaking allows or disallows a ClawbackVestingAccount to
+	// delegate its unvested coins.
+	SetUnvestedStaking(context.Context, *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error)
+	// BatchFundVestingAccounts funds multiple existing ClawbackVestingAccounts
+	// in a single transaction using shared lockup and vesting schedules.
+	BatchFundVestingAccounts(context.Context, *MsgBatchFundVestingAccounts) (*MsgBatchFundVestingAccountsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -138,6 +218,21 @@ func (UnimplementedMsgServer) UpdateVestingFunder(context.Context, *MsgUpdateVes
 func (UnimplementedMsgServer) ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) AccelerateVesting(context.Context, *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccelerateVesting not implemented")
+}
+func (UnimplementedMsgServer) AcceleratePeriod(context.Context, *MsgAcceleratePeriod) (*MsgAcceleratePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceleratePeriod not implemented")
+}
+func (UnimplementedMsgServer) ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLockup not implemented")
+}
+func (UnimplementedMsgServer) SetUnvestedStaking(context.Context, *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnvestedStaking not implemented")
+}
+func (UnimplementedMsgServer) BatchFundVestingAccounts(context.Context, *MsgBatchFundVestingAccounts) (*MsgBatchFundVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFundVestingAccounts not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -241,6 +336,96 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AccelerateVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccelerateVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AccelerateVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AccelerateVesting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AccelerateVesting(ctx, req.(*MsgAccelerateVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceleratePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceleratePeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceleratePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceleratePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceleratePeriod(ctx, req.(*MsgAcceleratePeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendLockup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendLockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendLockup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendLockup(ctx, req.(*MsgExtendLockup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnvestedStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnvestedStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetUnvestedStaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, req.(*MsgSetUnvestedStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchFundVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchFundVestingAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchFundVestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchFundVestingAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchFundVestingAccounts(ctx, req.(*MsgBatchFundVestingAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +453,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "AccelerateVesting",
+			Handler:    _Msg_AccelerateVesting_Handler,
+		},
+		{
+			MethodName: "AcceleratePeriod",
+			Handler:    _Msg_AcceleratePeriod_Handler,
+		},
+		{
+			MethodName: "ExtendLockup",
+			Handler:    _Msg_ExtendLockup_Handler,
+		},
+		{
+			MethodName: "SetUnvestedStaking",
+			Handler:    _Msg_SetUnvestedStaking_Handler,
+		},
+		{
+			MethodName: "BatchFundVestingAccounts",
+			Handler:    _Msg_BatchFundVestingAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/tx.proto",
@@ -13,10 +13,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // MinGasPriceDecorator will check if the transaction's fee is at least as large
-// as the MinGasPrices param. If fee is too low, decorator returns error and tx
+// as the MinGasPrices param. The message fee overrides of the fee market params
+// can raise the minimum fee of the transactions containing the given message
+// types. If fee is too low, decorator returns error and tx
 // is rejected. This applies for both CheckTx and DeliverTx
 // If fee is high enough, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
@@ -37,7 +40,8 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feesKeeper.GetParams(ctx).MinGasPrice
+	// the message fee overrides can raise the minimum gas price and fee of the transaction
+	minGasPrice, gasMultiplier, fixedFee := mpd.feesKeeper.GetParams(ctx).MessageFeeRequirements(msgTypeURLs(tx.GetMsgs()))
 
 	feeCoins := feeTx.GetFee()
 	baseDenom, err := sdk.GetBaseDenom()
@@ -52,8 +56,8 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, fmt.Errorf("expected only use native token %s for fee, but got %s", baseDenom, feeCoins.String())
 	}
 
-	// Short-circuit if min gas price is 0 or if simulating
-	if minGasPrice.IsZero() || simulate {
+	// Short-circuit if min gas price and fixed fee are 0 or if simulating
+	if (minGasPrice.IsZero() && fixedFee.IsZero()) || simulate {
 		return next(ctx, tx, simulate)
 	}
 
@@ -69,11 +73,12 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	requiredFees := make(sdk.Coins, 0)
 
 	// Determine the required fees by multiplying each required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+	// price by the gas limit and adding the fixed fee, where
+	// fee = ceil(minGasPrice * gasLimit * gasMultiplier) + fixedFee.
 	gasLimit := math.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
 
 	for _, gp := range minGasPrices {
-		fee := gp.Amount.Mul(gasLimit).Ceil().RoundInt()
+		fee := gp.Amount.Mul(gasLimit).Mul(gasMultiplier).Ceil().RoundInt().Add(fixedFee)
 		if fee.IsPositive() {
 			requiredFees = requiredFees.Add(sdk.Coin{Denom: gp.Denom, Amount: fee})
 		}
@@ -95,3 +100,21 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 	return next(ctx, tx, simulate)
 }
+
+// msgTypeURLs returns the type URLs of the messages, including the messages
+// wrapped in authz MsgExec messages.
+func msgTypeURLs(msgs []sdk.Msg) []string {
+	typeURLs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		typeURLs = append(typeURLs, sdk.MsgTypeURL(msg))
+
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			innerMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				continue
+			}
+			typeURLs = append(typeURLs, msgTypeURLs(innerMsgs)...)
+		}
+	}
+	return typeURLs
+}
@@ -8,8 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
+	"github.com/evmos/evmos/v20/app/ante/testutils"
 	"github.com/evmos/evmos/v20/testutil"
 	testutiltx "github.com/evmos/evmos/v20/testutil/tx"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
 var execTypes = []struct {
@@ -203,3 +205,133 @@ func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
 		}
 	}
 }
+
+func (suite *AnteTestSuite) TestMinGasPriceDecoratorMessageFeeOverrides() {
+	denom, err := sdk.GetBaseDenom()
+	suite.Require().NoError(err)
+	testMsg := banktypes.MsgSend{
+		FromAddress: "evmos1x8fhpj9nmhqk8z9kpgjt95ck2xwyue0ptzkucp",
+		ToAddress:   "evmos1dx67l23hz9l0k9hcher8xz04uj7wf3yu26l2yn",
+		Amount:      sdk.Coins{sdk.Coin{Amount: math.NewInt(10), Denom: denom}},
+	}
+	sendTypeURL := sdk.MsgTypeURL(&testMsg)
+	nw := suite.GetNetwork()
+	ctx := nw.GetContext()
+
+	testCases := []struct {
+		name      string
+		overrides []feemarkettypes.MessageFeeOverride
+		tx        func() sdk.Tx
+		expPass   bool
+	}{
+		{
+			"invalid cosmos tx below the min gas price override",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyNewDec(20), math.ZeroInt(), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(10), denom, &testMsg).GetTx()
+			},
+			false,
+		},
+		{
+			"valid cosmos tx at the min gas price override",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyNewDec(20), math.ZeroInt(), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(20), denom, &testMsg).GetTx()
+			},
+			true,
+		},
+		{
+			"valid cosmos tx with an override of another message type",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride("/ibc.applications.transfer.v1.MsgTransfer", math.LegacyNewDec(20), math.ZeroInt(), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(10), denom, &testMsg).GetTx()
+			},
+			true,
+		},
+		{
+			"invalid cosmos tx without the fixed fee",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyZeroDec(), math.NewInt(1000), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(10), denom, &testMsg).GetTx()
+			},
+			false,
+		},
+		{
+			"valid cosmos tx with the fixed fee",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyZeroDec(), math.NewInt(1000), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				fees := sdk.Coins{sdk.NewCoin(denom, math.NewInt(10).MulRaw(int64(testutils.TestGasLimit)).AddRaw(1000))}
+				return suite.CreateTestCosmosTxBuilderWithFees(fees, &testMsg).GetTx()
+			},
+			true,
+		},
+		{
+			"invalid cosmos tx without the fixed fee of each message",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyZeroDec(), math.NewInt(1000), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				fees := sdk.Coins{sdk.NewCoin(denom, math.NewInt(10).MulRaw(int64(testutils.TestGasLimit)).AddRaw(1000))}
+				return suite.CreateTestCosmosTxBuilderWithFees(fees, &testMsg, &testMsg).GetTx()
+			},
+			false,
+		},
+		{
+			"invalid cosmos tx below the gas multiplier",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyZeroDec(), math.ZeroInt(), math.LegacyNewDec(2))},
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(15), denom, &testMsg).GetTx()
+			},
+			false,
+		},
+		{
+			"valid cosmos tx at the gas multiplier",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyZeroDec(), math.ZeroInt(), math.LegacyNewDec(2))},
+			func() sdk.Tx {
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(20), denom, &testMsg).GetTx()
+			},
+			true,
+		},
+		{
+			"invalid cosmos tx below the min gas price override of a message wrapped in MsgExec",
+			[]feemarkettypes.MessageFeeOverride{newMessageFeeOverride(sendTypeURL, math.LegacyNewDec(20), math.ZeroInt(), math.LegacyZeroDec())},
+			func() sdk.Tx {
+				grantee := sdk.MustAccAddressFromBech32(testMsg.ToAddress)
+				return suite.CreateTestCosmosTxBuilder(math.NewInt(10), denom, newMsgExec(grantee, []sdk.Msg{&testMsg})).GetTx()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := nw.App.FeeMarketKeeper.GetParams(ctx)
+			params.MinGasPrice = math.LegacyNewDec(10)
+			params.MessageFeeOverrides = tc.overrides
+			err := nw.App.FeeMarketKeeper.SetParams(ctx, params)
+			suite.Require().NoError(err)
+
+			dec := cosmosante.NewMinGasPriceDecorator(nw.App.FeeMarketKeeper, nw.App.EvmKeeper)
+			_, err = dec.AnteHandle(ctx, tc.tx(), false, testutil.NextFn)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), "provided fee < minimum global fee")
+			}
+		})
+	}
+
+	params := nw.App.FeeMarketKeeper.GetParams(ctx)
+	params.MessageFeeOverrides = nil
+	err = nw.App.FeeMarketKeeper.SetParams(ctx, params)
+	suite.Require().NoError(err)
+}
+
+func newMessageFeeOverride(msgTypeURL string, minGasPrice math.LegacyDec, fixedFee math.Int, gasMultiplier math.LegacyDec) feemarkettypes.MessageFeeOverride {
+	return feemarkettypes.MessageFeeOverride{
+		MsgTypeUrl:    msgTypeURL,
+		MinGasPrice:   minGasPrice,
+		FixedFee:      fixedFee,
+		GasMultiplier: gasMultiplier,
+	}
+}
@@ -15,6 +15,8 @@ func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			options.BankKeeper,
 			options.FeeMarketKeeper,
 			options.EvmKeeper,
+			options.ERC20Keeper,
+			options.FeegrantKeeper,
 			options.DistributionKeeper,
 			options.StakingKeeper,
 			options.MaxTxGasWanted,
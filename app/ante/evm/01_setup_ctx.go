@@ -47,6 +47,8 @@ func SetupContext(ctx sdk.Context, tx sdk.Tx, evmKeeper EVMKeeper) (sdk.Context,
 	// Reset transient gas used to prepare the execution of current cosmos tx.
 	// Transient gas-used is necessary to sum the gas-used of cosmos tx, when it contains multiple eth msgs.
 	evmKeeper.ResetTransientGasUsed(ctx)
+	// Reset transient tx fees to track the fees paid by the eth msgs of current cosmos tx.
+	evmKeeper.ResetTransientTxFees(ctx)
 
 	return newCtx, nil
 }
@@ -9,6 +9,7 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -87,8 +88,10 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// NOTE: the fee granter is allowed in order to sponsor the fees of the eth tx through
+	// a fee allowance granted to its sender
+	if authInfo.Fee.Payer != "" {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	sigs := protoTx.Signatures
@@ -99,6 +102,23 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 	return authInfo.Fee, nil
 }
 
+// IsBundleTx returns true if the Ethereum transactions are wrapped as an atomic
+// bundle, i.e. the Ethereum extension option has the bundle flag set.
+func IsBundleTx(tx sdktypes.Tx) bool {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+
+	opts := extTx.GetExtensionOptions()
+	if len(opts) != 1 {
+		return false
+	}
+
+	option, ok := opts[0].GetCachedValue().(*evmtypes.ExtensionOptionsEthereumTx)
+	return ok && option.Bundle
+}
+
 func CheckTxFee(txFeeInfo *tx.Fee, txFee sdktypes.Coins, txGasLimit uint64) error {
 	if txFeeInfo == nil {
 		return nil
@@ -14,6 +14,8 @@ import (
 )
 
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
+// If the fees are paid in a fee token or by a fee granter, the account balance only needs to cover the
+// transferred value.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
 // - from address is NOT an EOA
@@ -24,6 +26,7 @@ func VerifyAccountBalance(
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	feesPaidSeparately bool,
 ) error {
 	// check whether the sender address is EOA
 	if account != nil && account.IsContract() {
@@ -39,6 +42,16 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
+	if feesPaidSeparately {
+		if account.Balance.Cmp(txData.GetValue()) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"sender balance < tx value (%s < %s)", account.Balance, txData.GetValue(),
+			)
+		}
+		return nil
+	}
+
 	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
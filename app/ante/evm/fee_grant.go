@@ -0,0 +1,112 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/evmos/evmos/v20/x/evm/wrappers"
+)
+
+// IsFeeGranted returns true if the fees of the Ethereum message sent by the given
+// address are paid by the fee granter of the transaction.
+func IsFeeGranted(feeGranter, from sdk.AccAddress) bool {
+	return len(feeGranter) > 0 && !feeGranter.Equals(from)
+}
+
+// GetFeeGrantSignatures returns the signatures of the fee granter by the senders
+// of the Ethereum messages, set on the extension option of the transaction.
+func GetFeeGrantSignatures(tx sdk.Tx) [][]byte {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	opts := extTx.GetExtensionOptions()
+	if len(opts) != 1 {
+		return nil
+	}
+
+	option, ok := opts[0].GetCachedValue().(*evmtypes.ExtensionOptionsEthereumTx)
+	if !ok {
+		return nil
+	}
+	return option.FeeGrantSignatures
+}
+
+// VerifyFeeGrantSignature checks that the sender of the Ethereum transaction signed
+// the fee granter of the transaction. The fee granter is set on the Cosmos
+// transaction wrapping the Ethereum transaction, which is not covered by the
+// signature of the sender, so the fees could otherwise be charged to any granter
+// of the sender by whoever relays the transaction.
+func VerifyFeeGrantSignature(
+	chainID *big.Int,
+	txHash common.Hash,
+	feeGranter sdk.AccAddress,
+	from common.Address,
+	signature []byte,
+) error {
+	if len(signature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"fee grant signature must be %d bytes long, got %d", crypto.SignatureLength, len(signature),
+		)
+	}
+
+	// transform the yellow paper V from 27/28 to 0/1
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(evmtypes.FeeGrantSignHash(chainID, txHash, feeGranter), sig)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid fee grant signature: %s", err)
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != from {
+		return errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"fee grant of %s not signed by the sender %s", feeGranter, from,
+		)
+	}
+
+	return nil
+}
+
+// UseFeeGrant deducts the fees of the Ethereum message from the fee allowance
+// granted by the fee granter to the sender of the message. The allowance is
+// charged for the gas limit of the message, while the fees of the leftover gas
+// are refunded to the balance of the granter once the message is executed.
+func UseFeeGrant(
+	ctx sdk.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	feeGranter, from sdk.AccAddress,
+	fees sdk.Coins,
+	msg sdk.Msg,
+) error {
+	if feegrantKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	// the spend limits of the allowances are defined in the bank denomination,
+	// so the fees are converted from the 18 decimals representation
+	if err := feegrantKeeper.UseGrantedFees(
+		ctx,
+		feeGranter,
+		from,
+		wrappers.ConvertCoinsFrom18Decimals(fees),
+		[]sdk.Msg{msg},
+	); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package evm_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	commonfactory "github.com/evmos/evmos/v20/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *EvmAnteTestSuite) TestSponsoredEthereumTx() {
+	// Setup
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	granterKey := keyring.GetKey(0)
+	contract := utiltx.GenerateAddress()
+	spendLimit := sdk.NewCoins(sdk.NewCoin(unitNetwork.GetDenom(), math.NewInt(1e18)))
+
+	basicAllowance := func() feegrant.FeeAllowanceI {
+		return &feegrant.BasicAllowance{SpendLimit: spendLimit}
+	}
+	// signFeeGrant returns the signature of the granter by the given key
+	signFeeGrant := func(key testkeyring.Key, txHash common.Hash) []byte {
+		privKey, err := key.Priv.(*ethsecp256k1.PrivKey).ToECDSA()
+		suite.Require().NoError(err)
+		sig, err := crypto.Sign(evmtypes.FeeGrantSignHash(evmtypes.GetChainConfig().ChainID, txHash, granterKey.AccAddr), privKey)
+		suite.Require().NoError(err)
+		return sig
+	}
+
+	testCases := []struct {
+		name      string
+		allowance func() feegrant.FeeAllowanceI
+		to        common.Address
+		// signatures returns the fee grant signatures of the transaction,
+		// which default to the signature of the grantee
+		signatures  func(grantee testkeyring.Key, txHash common.Hash) [][]byte
+		expPass     bool
+		errContains string
+	}{
+		{
+			name:        "fail - no allowance granted",
+			allowance:   func() feegrant.FeeAllowanceI { return nil },
+			to:          contract,
+			expPass:     false,
+			errContains: "does not allow to pay fees",
+		},
+		{
+			name:      "pass - basic allowance",
+			allowance: basicAllowance,
+			to:        contract,
+			expPass:   true,
+		},
+		{
+			name: "fail - spend limit lower than the fees",
+			allowance: func() feegrant.FeeAllowanceI {
+				return &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewCoin(unitNetwork.GetDenom(), math.NewInt(1)))}
+			},
+			to:          contract,
+			expPass:     false,
+			errContains: "does not allow to pay fees",
+		},
+		{
+			name: "pass - allowed contract",
+			allowance: func() feegrant.FeeAllowanceI {
+				allowance, err := evmtypes.NewAllowedContractsAllowance(
+					&feegrant.BasicAllowance{SpendLimit: spendLimit},
+					[]string{contract.Hex()},
+				)
+				suite.Require().NoError(err)
+				return allowance
+			},
+			to:      contract,
+			expPass: true,
+		},
+		{
+			name: "fail - contract not allowed",
+			allowance: func() feegrant.FeeAllowanceI {
+				allowance, err := evmtypes.NewAllowedContractsAllowance(
+					&feegrant.BasicAllowance{SpendLimit: spendLimit},
+					[]string{contract.Hex()},
+				)
+				suite.Require().NoError(err)
+				return allowance
+			},
+			to:          utiltx.GenerateAddress(),
+			expPass:     false,
+			errContains: "does not allow to pay fees",
+		},
+		{
+			name:      "fail - missing fee grant signature",
+			allowance: basicAllowance,
+			to:        contract,
+			signatures: func(testkeyring.Key, common.Hash) [][]byte {
+				return nil
+			},
+			expPass:     false,
+			errContains: "missing fee grant signature",
+		},
+		{
+			name:      "fail - invalid fee grant signature",
+			allowance: basicAllowance,
+			to:        contract,
+			signatures: func(testkeyring.Key, common.Hash) [][]byte {
+				return [][]byte{[]byte("invalid")}
+			},
+			expPass:     false,
+			errContains: "fee grant signature must be",
+		},
+		{
+			name:      "fail - fee grant signed by another account",
+			allowance: basicAllowance,
+			to:        contract,
+			signatures: func(_ testkeyring.Key, txHash common.Hash) [][]byte {
+				return [][]byte{signFeeGrant(granterKey, txHash)}
+			},
+			expPass:     false,
+			errContains: "not signed by the sender",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			// the grantee has no funds to pay for the fees
+			granteeKey := keyring.GetKey(keyring.AddKey())
+
+			if allowance := tc.allowance(); allowance != nil {
+				msg, err := feegrant.NewMsgGrantAllowance(allowance, granterKey.AccAddr, granteeKey.AccAddr)
+				suite.Require().NoError(err)
+				res, err := txFactory.CommitCosmosTx(granterKey.Priv, commonfactory.CosmosTxArgs{Msgs: []sdk.Msg{msg}})
+				suite.Require().NoError(err)
+				suite.Require().True(res.IsOK(), res.Log)
+			}
+
+			granterBalance, err := grpcHandler.GetBalance(granterKey.AccAddr, unitNetwork.GetDenom())
+			suite.Require().NoError(err)
+
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(granteeKey.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.To = &tc.to
+			// set a gas limit above the gas used, so that leftover gas is refunded
+			txArgs.GasLimit = 100_000
+
+			signedTx, err := txFactory.GenerateSignedEthTx(granteeKey.Priv, txArgs)
+			suite.Require().NoError(err)
+			txBuilder, err := unitNetwork.App.GetTxConfig().WrapTxBuilder(signedTx)
+			suite.Require().NoError(err)
+			txBuilder.SetFeeGranter(granterKey.AccAddr)
+
+			txHash := signedTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Hash()
+			signatures := [][]byte{signFeeGrant(granteeKey, txHash)}
+			if tc.signatures != nil {
+				signatures = tc.signatures(granteeKey, txHash)
+			}
+			option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{FeeGrantSignatures: signatures})
+			suite.Require().NoError(err)
+			extBuilder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+			suite.Require().True(ok)
+			extBuilder.SetExtensionOptions(option)
+			txBytes, err := unitNetwork.App.GetTxConfig().TxEncoder()(txBuilder.GetTx())
+			suite.Require().NoError(err)
+
+			blockRes, err := unitNetwork.NextBlockWithTxs(txBytes)
+			suite.Require().NoError(err)
+			suite.Require().Len(blockRes.TxResults, 1)
+			res := blockRes.TxResults[0]
+
+			if !tc.expPass {
+				suite.Require().False(res.IsOK())
+				suite.Require().Contains(res.Log, tc.errContains)
+				return
+			}
+			suite.Require().True(res.IsOK(), res.Log)
+
+			// the fees for the gas used are paid by the granter
+			granteeBalance, err := grpcHandler.GetBalance(granteeKey.AccAddr, unitNetwork.GetDenom())
+			suite.Require().NoError(err)
+			suite.Require().True(granteeBalance.Balance.IsZero())
+
+			newGranterBalance, err := grpcHandler.GetBalance(granterKey.AccAddr, unitNetwork.GetDenom())
+			suite.Require().NoError(err)
+			suite.Require().True(newGranterBalance.Balance.IsLT(*granterBalance.Balance))
+
+			// the allowance is consumed by the fees for the gas limit, since it is
+			// charged before the message is executed
+			grant, err := unitNetwork.App.FeeGrantKeeper.GetAllowance(unitNetwork.GetContext(), granterKey.AccAddr, granteeKey.AccAddr)
+			suite.Require().NoError(err)
+			if contractsAllowance, ok := grant.(*evmtypes.AllowedContractsAllowance); ok {
+				grant, err = contractsAllowance.GetAllowance()
+				suite.Require().NoError(err)
+			}
+			basicAllowance, ok := grant.(*feegrant.BasicAllowance)
+			suite.Require().True(ok)
+			gasPrice := granterBalance.Balance.Amount.Sub(newGranterBalance.Balance.Amount).QuoRaw(res.GasUsed)
+			gasLimitFees := gasPrice.MulRaw(res.GasWanted)
+			suite.Require().Equal(spendLimit.Sub(sdk.NewCoin(unitNetwork.GetDenom(), gasLimitFees)), basicAllowance.SpendLimit)
+		})
+	}
+}
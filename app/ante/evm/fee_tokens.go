@@ -0,0 +1,59 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// SelectFeeToken returns the whitelisted fee token used to pay the fees of an
+// Ethereum transaction, with its amount set to the conversion rate. No fee token
+// is returned if the sender can pay the transaction cost in the EVM coin. Otherwise,
+// the first fee token that the sender holds enough of to cover the fees is used.
+// The transferred value is always paid in the EVM coin.
+func SelectFeeToken(
+	ctx sdk.Context,
+	erc20Keeper ERC20Keeper,
+	bankKeeper evmtypes.BankKeeper,
+	account *statedb.Account,
+	from common.Address,
+	txData evmtypes.TxData,
+) (sdk.DecCoin, bool) {
+	balance := big.NewInt(0)
+	if account != nil {
+		balance = account.Balance
+	}
+
+	if balance.Cmp(txData.Cost()) >= 0 || balance.Cmp(txData.GetValue()) < 0 {
+		return sdk.DecCoin{}, false
+	}
+
+	for _, feeToken := range erc20Keeper.GetFeeTokens(ctx) {
+		rate, err := erc20Keeper.GetFeeTokenRate(ctx, feeToken.Denom)
+		if err != nil {
+			continue
+		}
+
+		fees := evmtypes.FeeTokenAmount(txData.Fee(), rate, true)
+		if bankKeeper.GetBalance(ctx, from.Bytes(), feeToken.Denom).Amount.GTE(fees) {
+			return sdk.NewDecCoinFromDec(feeToken.Denom, rate), true
+		}
+	}
+
+	return sdk.DecCoin{}, false
+}
+
+// ConvertFeesToFeeToken converts the fees in EVM coin, in its 18 decimals
+// representation, into the fee token at its conversion rate.
+func ConvertFeesToFeeToken(fees sdk.Coins, evmDenom string, feeToken sdk.DecCoin) sdk.Coins {
+	amount := evmtypes.FeeTokenAmount(fees.AmountOf(evmDenom).BigInt(), feeToken.Amount, true)
+	if !amount.IsPositive() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{sdk.NewCoin(feeToken.Denom, amount)}
+}
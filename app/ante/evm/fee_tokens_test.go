@@ -0,0 +1,105 @@
+package evm_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/app/ante/evm"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *EvmAnteTestSuite) TestSelectFeeToken() {
+	// Setup
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	senderKey := keyring.GetKey(1)
+	ctx := unitNetwork.GetContext()
+
+	pair, err := unitNetwork.App.Erc20Keeper.CreateFactoryDenom(
+		ctx, keyring.GetAccAddr(0), "fee", "Fee Token", "FEE", 18,
+	)
+	suite.Require().NoError(err)
+	rate := math.LegacyNewDec(2)
+	unitNetwork.App.Erc20Keeper.SetFeeToken(ctx, erc20types.NewFixedRateFeeToken(pair.Denom, rate))
+
+	testCases := []struct {
+		name         string
+		balance      *big.Int
+		tokenBalance func(fee *big.Int) math.Int
+		value        *big.Int
+		expFeeToken  bool
+	}{
+		{
+			name:         "EVM coin balance covers the transaction cost",
+			balance:      big.NewInt(1e18),
+			tokenBalance: func(fee *big.Int) math.Int { return math.NewIntFromBigInt(fee) },
+			value:        big.NewInt(0),
+			expFeeToken:  false,
+		},
+		{
+			name:         "fee token balance covers the fees",
+			balance:      big.NewInt(0),
+			tokenBalance: func(fee *big.Int) math.Int { return evmtypes.FeeTokenAmount(fee, rate, true) },
+			value:        big.NewInt(0),
+			expFeeToken:  true,
+		},
+		{
+			name:         "fee token balance lower than the fees",
+			balance:      big.NewInt(0),
+			tokenBalance: func(fee *big.Int) math.Int { return evmtypes.FeeTokenAmount(fee, rate, true).SubRaw(1) },
+			value:        big.NewInt(0),
+			expFeeToken:  false,
+		},
+		{
+			name:         "EVM coin balance lower than the transferred value",
+			balance:      big.NewInt(10),
+			tokenBalance: func(fee *big.Int) math.Int { return math.NewIntFromBigInt(fee) },
+			value:        big.NewInt(100),
+			expFeeToken:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("%v_%v", evmtypes.GetTxTypeName(suite.ethTxType), tc.name), func() {
+			cacheCtx, _ := ctx.CacheContext()
+
+			txArgs, err := txFactory.GenerateDefaultTxTypeArgs(senderKey.Addr, suite.ethTxType)
+			suite.Require().NoError(err)
+			txArgs.Amount = tc.value
+			txData, err := txArgs.ToTxData()
+			suite.Require().NoError(err)
+
+			tokens := sdk.NewCoins(sdk.NewCoin(pair.Denom, tc.tokenBalance(txData.Fee())))
+			suite.Require().NoError(unitNetwork.App.BankKeeper.MintCoins(cacheCtx, erc20types.ModuleName, tokens))
+			suite.Require().NoError(unitNetwork.App.BankKeeper.SendCoinsFromModuleToAccount(cacheCtx, erc20types.ModuleName, senderKey.AccAddr, tokens))
+
+			account := statedb.NewEmptyAccount()
+			account.Balance = tc.balance
+
+			feeToken, found := evm.SelectFeeToken(
+				cacheCtx,
+				unitNetwork.App.Erc20Keeper,
+				unitNetwork.App.BankKeeper,
+				account,
+				senderKey.Addr,
+				txData,
+			)
+			suite.Require().Equal(tc.expFeeToken, found)
+			if tc.expFeeToken {
+				suite.Require().Equal(sdk.NewDecCoinFromDec(pair.Denom, rate), feeToken)
+			}
+		})
+	}
+}
@@ -5,11 +5,13 @@ package evm
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 
 	"github.com/evmos/evmos/v20/x/evm/statedb"
@@ -26,6 +28,10 @@ type EVMKeeper interface { //nolint: revive
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	ResetTransientTxFees(ctx sdk.Context)
+	SetTransientFeeToken(ctx sdk.Context, txHash common.Hash, feeToken sdk.DecCoin)
+	SetTransientFeeGranter(ctx sdk.Context, txHash common.Hash, granter sdk.AccAddress)
+	SetTransientBundleTx(ctx sdk.Context, txHash common.Hash)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
@@ -36,6 +42,13 @@ type FeeMarketKeeper interface {
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 }
 
+// ERC20Keeper defines the expected keeper interface used to pay the fees of
+// Ethereum transactions in whitelisted fee tokens
+type ERC20Keeper interface {
+	GetFeeTokens(ctx sdk.Context) []erc20types.FeeToken
+	GetFeeTokenRate(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error)
+}
+
 // DynamicFeeEVMKeeper is a subset of EVMKeeper interface that supports dynamic fee checker
 type DynamicFeeEVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
@@ -28,6 +29,8 @@ type MonoDecorator struct {
 	bankKeeper         evmtypes.BankKeeper
 	feeMarketKeeper    FeeMarketKeeper
 	evmKeeper          EVMKeeper
+	erc20Keeper        ERC20Keeper
+	feegrantKeeper     authante.FeegrantKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
 	maxGasWanted       uint64
@@ -55,6 +58,8 @@ func NewMonoDecorator(
 	bankKeeper evmtypes.BankKeeper,
 	feeMarketKeeper FeeMarketKeeper,
 	evmKeeper EVMKeeper,
+	erc20Keeper ERC20Keeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
 	maxGasWanted uint64,
@@ -64,6 +69,8 @@ func NewMonoDecorator(
 		bankKeeper:         bankKeeper,
 		feeMarketKeeper:    feeMarketKeeper,
 		evmKeeper:          evmKeeper,
+		erc20Keeper:        erc20Keeper,
+		feegrantKeeper:     feegrantKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
 		maxGasWanted:       maxGasWanted,
@@ -141,6 +148,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
 	}
 
+	// The fees of the Ethereum messages are paid by the fee granter of the
+	// transaction, if any, using the fee allowances granted to the senders.
+	// Each sender must sign the fee granter, since it is not covered by the
+	// signature of the Ethereum transaction.
+	var (
+		feeGranter         sdk.AccAddress
+		feeGrantSignatures [][]byte
+	)
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feeGranter = feeTx.FeeGranter()
+	}
+	if len(feeGranter) > 0 {
+		feeGrantSignatures = GetFeeGrantSignatures(tx)
+	}
+
+	// The Ethereum messages of a bundle are either all executed or all reverted.
+	bundle := IsBundleTx(tx)
+
 	// Use the lowest priority of all the messages as the final one.
 	for i, msg := range msgs {
 		ethMsg, txData, from, err := evmtypes.UnpackEthMsg(msg)
@@ -196,12 +221,47 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		fromAddr := common.HexToAddress(ethMsg.From)
 		// TODO: Use account from AccountKeeper instead
 		account := md.evmKeeper.GetAccount(ctx, fromAddr)
+		feeGranted := IsFeeGranted(feeGranter, from)
+		if feeGranted {
+			if i >= len(feeGrantSignatures) {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrUnauthorized,
+					"missing fee grant signature for message %d", i,
+				)
+			}
+			if err := VerifyFeeGrantSignature(
+				decUtils.EthConfig.ChainID,
+				ethMsg.AsTransaction().Hash(),
+				feeGranter,
+				fromAddr,
+				feeGrantSignatures[i],
+			); err != nil {
+				return ctx, err
+			}
+		}
+
+		var (
+			feeToken        sdk.DecCoin
+			paysFeesInToken bool
+		)
+		if !feeGranted {
+			feeToken, paysFeesInToken = SelectFeeToken(
+				ctx,
+				md.erc20Keeper,
+				md.bankKeeper,
+				account,
+				fromAddr,
+				txData,
+			)
+		}
+
 		if err := VerifyAccountBalance(
 			ctx,
 			md.accountKeeper,
 			account,
 			fromAddr,
 			txData,
+			feeGranted || paysFeesInToken,
 		); err != nil {
 			return ctx, err
 		}
@@ -260,6 +320,21 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		// the fee granter and the fee token used to pay the fees are recorded,
+		// so that the leftover gas is refunded accordingly
+		feePayer := from
+		switch {
+		case feeGranted:
+			if err := UseFeeGrant(ctx, md.feegrantKeeper, feeGranter, from, msgFees, ethMsg); err != nil {
+				return ctx, err
+			}
+			md.evmKeeper.SetTransientFeeGranter(ctx, ethMsg.AsTransaction().Hash(), feeGranter)
+			feePayer = feeGranter
+		case paysFeesInToken:
+			msgFees = ConvertFeesToFeeToken(msgFees, decUtils.EvmDenom, feeToken)
+			md.evmKeeper.SetTransientFeeToken(ctx, ethMsg.AsTransaction().Hash(), feeToken)
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
@@ -269,7 +344,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				Staking:      md.stakingKeeper,
 			},
 			msgFees,
-			from,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
@@ -311,6 +386,10 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		// 12. emit events
 		txIdx := uint64(i) //nolint:gosec // G115 G701
 		EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
+
+		if bundle {
+			md.evmKeeper.SetTransientBundleTx(ctx, ethMsg.AsTransaction().Hash())
+		}
 	}
 
 	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
@@ -146,6 +146,7 @@ func (s *benchmarkSuite) generateHandlerOptions() ante.HandlerOptions {
 		BankKeeper:             s.network.App.BankKeeper,
 		ExtensionOptionChecker: evmostypes.HasDynamicFeeExtensionOption,
 		EvmKeeper:              s.network.App.EvmKeeper,
+		ERC20Keeper:            s.network.App.Erc20Keeper,
 		StakingKeeper:          s.network.App.StakingKeeper,
 		FeegrantKeeper:         s.network.App.FeeGrantKeeper,
 		DistributionKeeper:     s.network.App.DistrKeeper,
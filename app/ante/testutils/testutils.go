@@ -119,6 +119,7 @@ func (suite *AnteTestSuite) SetupTest() {
 		BankKeeper:             suite.network.App.BankKeeper,
 		DistributionKeeper:     suite.network.App.DistrKeeper,
 		EvmKeeper:              suite.network.App.EvmKeeper,
+		ERC20Keeper:            suite.network.App.Erc20Keeper,
 		FeegrantKeeper:         suite.network.App.FeeGrantKeeper,
 		IBCKeeper:              suite.network.App.IBCKeeper,
 		StakingKeeper:          suite.network.App.StakingKeeper,
@@ -107,6 +107,9 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	ratelimit "github.com/cosmos/ibc-apps/modules/rate-limiting/v8"
 	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/keeper"
 	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v8/types"
@@ -183,8 +186,13 @@ func init() {
 	stakingtypes.DefaultMinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
 }
 
-// Name defines the application binary name
-const Name = "evmosd"
+const (
+	// Name defines the application binary name
+	Name = "evmosd"
+
+	// PacketForwardRetriesOnTimeout is zero so that timed out forwards are refunded instead of retried.
+	PacketForwardRetriesOnTimeout = 0
+)
 
 var (
 	// DefaultNodeHome default home directories for the application daemon
@@ -249,6 +257,7 @@ type Evmos struct {
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	RateLimitKeeper       ratelimitkeeper.Keeper
+	PacketForwardKeeper   *packetforwardkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -464,13 +473,6 @@ func NewEvmos(
 		stakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), govConfig, authAddr,
 	)
 
-	// Evmos Keeper
-	app.InflationKeeper = inflationkeeper.NewKeeper(
-		keys[inflationtypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper,
-		authtypes.FeeCollectorName,
-	)
-
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	// NOTE: Distr, Slashing and Claim must be created before calling the Hooks method to avoid returning a Keeper without its table generated
@@ -488,6 +490,12 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.EvmKeeper, app.StakingKeeper, *govKeeper, // NOTE: app.govKeeper not defined yet, use govKeeper
 	)
 
+	app.InflationKeeper = inflationkeeper.NewKeeper(
+		keys[inflationtypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, stakingKeeper, app.VestingKeeper,
+		authtypes.FeeCollectorName,
+	)
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
@@ -505,20 +513,40 @@ func NewEvmos(
 		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 	)
 
+	// Create the packet forward keeper. The transfer keeper is set below,
+	// since it requires the packet forward keeper as its ICS4 wrapper.
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		keys[packetforwardtypes.StoreKey],
+		nil, // transfer keeper is set after its creation
+		app.IBCKeeper.ChannelKeeper,
+		app.DistrKeeper,
+		app.BankKeeper,
+		app.RateLimitKeeper, // ICS4 Wrapper: ratelimit IBC middleware
+		authAddr,
+	)
+
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.RateLimitKeeper, // ICS4 Wrapper: ratelimit IBC middleware
+		app.PacketForwardKeeper, // ICS4 Wrapper: packet forward IBC middleware
 		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 		authAddr,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	// Forwarded packets are sent through the Evmos transfer keeper, so that
+	// ERC20 tokens are converted to their Cosmos coin representation if needed.
+	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
+
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName))
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
-			// insert epoch hooks receivers here
+			// insert epoch hooks receivers here, wrapping the ones that only
+			// handle a single epoch with epochskeeper.NewIdentifierEpochHooks
+			// the inflation hooks handle both the day and the mint epochs
 			app.InflationKeeper.Hooks(),
+			epochskeeper.NewIdentifierEpochHooks(epochstypes.DayEpochID, app.VestingKeeper.Hooks()),
 		),
 	)
 
@@ -568,14 +596,22 @@ func NewEvmos(
 
 		transfer stack contains (from bottom to top):
 			- ERC-20 Middleware
-		 	- Recovery Middleware
+			- Packet Forward Middleware
+			- Rate Limit Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> packetforward.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> erc20.OnRecvPacket -> packetforward.OnRecvPacket -> ratelimit.OnRecvPacket -> transfer.OnRecvPacket
+
+		NOTE: the packet forward middleware returns a nil acknowledgement for
+		forwarded packets, so the ERC-20 middleware skips the conversion of the
+		funds that are only routed through Evmos. The acknowledgements and timeouts
+		of the forwarded packets are identified with the in-flight packets stored
+		by the packet forward middleware, as their refunds are routed back to the
+		sender on the source chain.
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -583,7 +619,14 @@ func NewEvmos(
 
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
-	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = packetforward.NewIBCMiddleware(
+		transferStack,
+		app.PacketForwardKeeper,
+		PacketForwardRetriesOnTimeout,
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
+	)
+	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, app.PacketForwardKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -635,6 +678,7 @@ func NewEvmos(
 		transferModule,
 		ibctm.NewAppModule(),
 		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
 		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
@@ -695,9 +739,12 @@ func NewEvmos(
 	app.mm.SetOrderEndBlockers(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		// NOTE: vesting must run after staking to receive the completed unbondings of clawbacks
+		vestingtypes.ModuleName,
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
 		feegrant.ModuleName,
+		erc20types.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -728,10 +775,12 @@ func NewEvmos(
 		feegrant.ModuleName,
 		upgradetypes.ModuleName,
 		// Evmos modules
+		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
 		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
 	)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
@@ -857,6 +906,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		BankKeeper:             app.BankKeeper,
 		ExtensionOptionChecker: evmostypes.HasDynamicFeeExtensionOption,
 		EvmKeeper:              app.EvmKeeper,
+		ERC20Keeper:            app.Erc20Keeper,
 		StakingKeeper:          app.StakingKeeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
 		DistributionKeeper:     app.DistrKeeper,
@@ -877,8 +927,12 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 
 func (app *Evmos) setPostHandler() {
 	options := post.HandlerOptions{
-		FeeCollectorName: authtypes.FeeCollectorName,
-		BankKeeper:       app.BankKeeper,
+		FeeCollectorName:   authtypes.FeeCollectorName,
+		BankKeeper:         app.BankKeeper,
+		DistributionKeeper: app.DistrKeeper,
+		StakingKeeper:      app.StakingKeeper,
+		EvmKeeper:          app.EvmKeeper,
+		FeeMarketKeeper:    app.FeeMarketKeeper,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -1180,6 +1234,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
 	// FIX: do we need a keytable?
 	paramsKeeper.Subspace(ratelimittypes.ModuleName)
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint: staticcheck
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
@@ -1212,19 +1267,19 @@ func (app *Evmos) setupUpgradeHandlers() {
 		return
 	}
 
-	// var storeUpgrades *storetypes.StoreUpgrades
-
-	// switch upgradeInfo.Name {
-	// case v191.UpgradeName:
-	// 	storeUpgrades = &storetypes.StoreUpgrades{
-	// 		Added: []string{ratelimittypes.ModuleName},
-	// 	}
-	// default:
-	// // no-op
-	// }
-
-	// if storeUpgrades != nil {
-	// 	// configure store loader that checks if version == upgradeHeight and applies store upgrades
-	// 	app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
-	// }
+	var storeUpgrades *storetypes.StoreUpgrades
+
+	switch upgradeInfo.Name {
+	case v20.UpgradeName:
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{packetforwardtypes.StoreKey},
+		}
+	default:
+		// no-op
+	}
+
+	if storeUpgrades != nil {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
 }
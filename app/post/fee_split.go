@@ -0,0 +1,275 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/evmos/evmos/v20/x/evm/wrappers"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/hashicorp/go-metrics"
+)
+
+var _ sdk.PostDecorator = &FeeSplitDecorator{}
+
+// FeeSplitDecorator is the decorator that splits the transaction fees between burning, the block
+// proposer and the community pool, as defined by the fee market parameters. The remaining fees are
+// kept by the fee collector and distributed to the stakers.
+type FeeSplitDecorator struct {
+	feeCollectorName   string
+	bankKeeper         bankkeeper.Keeper
+	distributionKeeper DistributionKeeper
+	stakingKeeper      StakingKeeper
+	evmKeeper          EVMKeeper
+	feeMarketKeeper    FeeMarketKeeper
+}
+
+// feeSplit defines the fees burned, paid to the block proposer and sent to the community pool.
+type feeSplit struct {
+	burn          sdk.Coins
+	proposer      sdk.Coins
+	communityPool sdk.Coins
+}
+
+// NewFeeSplitDecorator creates a new instance of the FeeSplitDecorator.
+func NewFeeSplitDecorator(
+	feeCollector string,
+	bankKeeper bankkeeper.Keeper,
+	distributionKeeper DistributionKeeper,
+	stakingKeeper StakingKeeper,
+	evmKeeper EVMKeeper,
+	feeMarketKeeper FeeMarketKeeper,
+) sdk.PostDecorator {
+	return &FeeSplitDecorator{
+		feeCollectorName:   feeCollector,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
+		stakingKeeper:      stakingKeeper,
+		evmKeeper:          evmKeeper,
+		feeMarketKeeper:    feeMarketKeeper,
+	}
+}
+
+// PostHandle splits the fees paid by Cosmos and Ethereum transactions. The base fee and the priority
+// fee of Ethereum transactions are split separately, using the base fee split and the priority fee
+// split parameters of the fee market module. The fees of Cosmos transactions are split using the
+// Cosmos fee split parameter. An event with the exact split is emitted.
+func (fsd FeeSplitDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
+	}
+
+	baseFee := fsd.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
+	}
+
+	var baseFees, priorityFees sdk.Coins
+	if isEthereumTx(tx) {
+		baseFees, priorityFees = fsd.ethereumTxFees(ctx, baseFee)
+	} else {
+		baseFees, priorityFees = fsd.cosmosTxFees(ctx, feeTx, baseFee)
+	}
+
+	if baseFees.IsZero() && priorityFees.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the fees of Cosmos transactions are split the same way, whether they pay
+	// for the base fee or for the priority fee
+	params := fsd.feeMarketKeeper.GetParams(ctx)
+	baseSplit, prioritySplit := params.BaseFeeSplit, params.PriorityFeeSplit
+	if !isEthereumTx(tx) {
+		baseSplit, prioritySplit = params.CosmosFeeSplit, params.CosmosFeeSplit
+	}
+
+	baseFeeSplit := splitFees(baseFees, baseSplit)
+	priorityFeeSplit := splitFees(priorityFees, prioritySplit)
+
+	burnedCoins := baseFeeSplit.burn.Add(priorityFeeSplit.burn...)
+	proposerCoins := baseFeeSplit.proposer.Add(priorityFeeSplit.proposer...)
+	communityPoolCoins := baseFeeSplit.communityPool.Add(priorityFeeSplit.communityPool...)
+
+	// NOTE: since all tx fees are pooled by the fee collector module account,
+	// we split them directly from it
+	if !burnedCoins.IsZero() {
+		if err := fsd.bankKeeper.BurnCoins(ctx, fsd.feeCollectorName, burnedCoins); err != nil {
+			return ctx, err
+		}
+	}
+
+	// the proposer fees are kept by the fee collector if the proposer cannot be found,
+	// e.g. when checking the transaction before it is included in a block
+	proposer, found := fsd.proposerAddress(ctx)
+	if !found {
+		proposerCoins = sdk.Coins{}
+	}
+
+	if !proposerCoins.IsZero() {
+		if err := fsd.bankKeeper.SendCoinsFromModuleToAccount(ctx, fsd.feeCollectorName, proposer, proposerCoins); err != nil {
+			return ctx, err
+		}
+	}
+
+	if !communityPoolCoins.IsZero() {
+		feeCollector := authtypes.NewModuleAddress(fsd.feeCollectorName)
+		if err := fsd.distributionKeeper.FundCommunityPool(ctx, communityPoolCoins, feeCollector); err != nil {
+			return ctx, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feemarkettypes.EventTypeFeeSplit,
+			sdk.NewAttribute(feemarkettypes.AttributeKeyBaseFee, baseFees.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyPriorityFee, priorityFees.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyBurned, burnedCoins.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyProposer, proposer.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyProposerReward, proposerCoins.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyCommunityPool, communityPoolCoins.String()),
+		),
+	)
+
+	defer func() {
+		if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+			return
+		}
+		for _, c := range burnedCoins {
+			// if fee amount is higher than uint64, skip the counter
+			if !c.Amount.IsUint64() {
+				continue
+			}
+			telemetry.IncrCounterWithLabels(
+				[]string{"burned", "tx", "fee", "amount"},
+				float32(c.Amount.Uint64()),
+				[]metrics.Label{
+					telemetry.NewLabel("denom", c.Denom),
+				},
+			)
+		}
+	}()
+
+	return next(ctx, tx, simulate, success)
+}
+
+// cosmosTxFees returns the base fee and priority fee paid by a Cosmos transaction. The base fee
+// is the part of the EVM denom fee that covers the base fee for the gas limit of the transaction,
+// while the remaining fees are priority fees.
+func (fsd FeeSplitDecorator) cosmosTxFees(ctx sdk.Context, feeTx sdk.FeeTx, baseFee *big.Int) (baseFees, priorityFees sdk.Coins) {
+	fees := feeTx.GetFee()
+
+	// safety check: ensure the fees are not empty and with positive amounts
+	// before splitting
+	if len(fees) == 0 || !fees.IsAllPositive() {
+		return nil, nil
+	}
+
+	// split min(balance, fee)
+	var collected []sdk.Coin
+	for _, fee := range fees {
+		balance := fsd.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(fsd.feeCollectorName), fee.Denom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		collected = append(collected, sdk.Coin{Denom: fee.Denom, Amount: sdkmath.MinInt(fee.Amount, balance.Amount)})
+	}
+
+	denom := evmtypes.GetEVMCoinDenom()
+	baseAmount := sdkmath.NewIntFromBigInt(baseFee).Mul(sdkmath.NewIntFromUint64(feeTx.GetGas()))
+
+	for _, coin := range collected {
+		if coin.Denom != denom {
+			priorityFees = append(priorityFees, coin)
+			continue
+		}
+
+		amount := sdkmath.MinInt(coin.Amount, baseAmount)
+		baseFees = append(baseFees, sdk.Coin{Denom: denom, Amount: amount})
+		priorityFees = append(priorityFees, sdk.Coin{Denom: denom, Amount: coin.Amount.Sub(amount)})
+	}
+
+	return removeZeroCoins(baseFees), removeZeroCoins(priorityFees)
+}
+
+// ethereumTxFees returns the base fee and priority fee paid by the Ethereum messages of a
+// transaction for the gas they used.
+func (fsd FeeSplitDecorator) ethereumTxFees(ctx sdk.Context, baseFee *big.Int) (baseFees, priorityFees sdk.Coins) {
+	paid := fsd.evmKeeper.GetTransientTxFees(ctx)
+	gasUsed := new(big.Int).SetUint64(fsd.evmKeeper.GetTransientGasUsed(ctx))
+
+	base := new(big.Int).Mul(baseFee, gasUsed)
+	if base.Cmp(paid) > 0 {
+		base = paid
+	}
+	priority := new(big.Int).Sub(paid, base)
+
+	denom := evmtypes.GetEVMCoinDenom()
+	baseFees = wrappers.ConvertCoinsFrom18Decimals(sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(base)}})
+	priorityFees = wrappers.ConvertCoinsFrom18Decimals(sdk.Coins{{Denom: denom, Amount: sdkmath.NewIntFromBigInt(priority)}})
+
+	return removeZeroCoins(baseFees), removeZeroCoins(priorityFees)
+}
+
+// proposerAddress returns the account address of the operator of the block proposer.
+func (fsd FeeSplitDecorator) proposerAddress(ctx sdk.Context) (sdk.AccAddress, bool) {
+	validator, err := fsd.stakingKeeper.GetValidatorByConsAddr(ctx, ctx.BlockHeader().ProposerAddress)
+	if err != nil {
+		return nil, false
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return nil, false
+	}
+
+	return sdk.AccAddress(valAddr), true
+}
+
+// splitFees splits the fees according to the fee split fractions. The amounts are rounded down,
+// so that the remainder is kept by the fee collector.
+func splitFees(fees sdk.Coins, split feemarkettypes.FeeSplit) feeSplit {
+	var s feeSplit
+	for _, fee := range fees {
+		s.burn = append(s.burn, sdk.Coin{Denom: fee.Denom, Amount: split.Burn.MulInt(fee.Amount).TruncateInt()})
+		s.proposer = append(s.proposer, sdk.Coin{Denom: fee.Denom, Amount: split.Proposer.MulInt(fee.Amount).TruncateInt()})
+		s.communityPool = append(s.communityPool, sdk.Coin{Denom: fee.Denom, Amount: split.CommunityPool.MulInt(fee.Amount).TruncateInt()})
+	}
+
+	s.burn = removeZeroCoins(s.burn)
+	s.proposer = removeZeroCoins(s.proposer)
+	s.communityPool = removeZeroCoins(s.communityPool)
+	return s
+}
+
+// removeZeroCoins removes the coins with a zero amount, keeping the order of the coins.
+func removeZeroCoins(coins sdk.Coins) sdk.Coins {
+	result := sdk.Coins{}
+	for _, coin := range coins {
+		if coin.IsPositive() {
+			result = append(result, coin)
+		}
+	}
+	return result
+}
+
+// isEthereumTx returns true if the transaction contains an Ethereum message.
+func isEthereumTx(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,346 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+func (s *PostTestSuite) TestPostHandle() {
+	testCases := []struct {
+		name        string
+		tx          func() sdk.Tx
+		expPass     bool
+		errContains string
+		postChecks  func()
+	}{
+		{
+			name: "pass - noop with Ethereum message",
+			tx: func() sdk.Tx {
+				return s.BuildEthTx()
+			},
+			expPass:    true,
+			postChecks: func() {},
+		},
+		{
+			name: "pass - burn fees of a single token with empty end balance",
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"}}
+				amount := feeAmount
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				expected := sdk.Coins{}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+			},
+		},
+		{
+			name: "pass - burn fees of a single token with non-empty end balance",
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"}}
+				amount := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "evmos"}}
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				expected := sdk.Coins{sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"}}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+			},
+		},
+		{
+			name: "pass - burn fees of multiple tokens with empty end balance",
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "eth"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"},
+				}
+				amount := feeAmount
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(sdk.Coins{}, balance)
+			},
+		},
+		{ //nolint:dupl
+			name: "pass - burn fees of multiple tokens with non-empty end balance",
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"},
+				}
+				amount := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"},
+					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
+				}
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				expected := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
+				}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+			},
+		},
+		{ //nolint:dupl
+			name: "pass - burn fees of multiple tokens, non-empty end balance, and multiple messages",
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"},
+				}
+				amount := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(20), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "evmos"},
+					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
+				}
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(100, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				expected := sdk.Coins{
+					sdk.Coin{Amount: sdkmath.NewInt(10), Denom: "btc"},
+					sdk.Coin{Amount: sdkmath.NewInt(3), Denom: "osmo"},
+				}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+			},
+		},
+		{
+			name: "pass - fees exceeds MaxUint64 (~18 EVMOS). Should not panic",
+			tx: func() sdk.Tx {
+				amt, ok := sdkmath.NewIntFromString("10000000000000000000000000000000000")
+				s.Require().True(ok)
+				feeAmount := sdk.Coins{sdk.Coin{Amount: amt, Denom: "evmos"}}
+				amount := sdk.Coins{sdk.Coin{Amount: amt, Denom: "evmos"}}
+				s.MintCoinsForFeeCollector(amount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expPass: true,
+			postChecks: func() {
+				expected := sdk.Coins{}
+				balance := s.GetFeeCollectorBalance()
+				s.Require().Equal(expected, balance)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		// Be sure to have a fresh new network before each test. It is not required for following
+		// test but it is still a good practice.
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			// start each test with a fresh new block.
+			err := s.unitNetwork.NextBlock()
+			s.Require().NoError(err)
+
+			feeSplitDecorator := s.NewFeeSplitDecorator()
+
+			// In the execution of the PostHandle method, simulate, success, and next have been
+			// hard-coded because they are not influencing the behavior of the FeeSplitDecorator.
+			terminator := sdk.ChainPostDecorators(sdk.Terminator{}) //nolint:staticcheck
+			_, err = feeSplitDecorator.PostHandle(
+				s.unitNetwork.GetContext(),
+				tc.tx(),
+				false,
+				false,
+				terminator,
+			)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err, "expected error during HandlerOptions validation")
+				s.Require().Contains(err.Error(), tc.errContains, "expected a different error")
+			}
+
+			tc.postChecks()
+		})
+	}
+}
+
+func (s *PostTestSuite) TestPostHandleFeeSplit() {
+	// the base fee covers 10 per unit of gas and the rest of the fees are priority fees
+	baseFee := sdkmath.NewInt(10)
+
+	testCases := []struct {
+		name             string
+		baseFeeSplit     feemarkettypes.FeeSplit
+		priorityFeeSplit feemarkettypes.FeeSplit
+		cosmosFeeSplit   feemarkettypes.FeeSplit
+		tx               func() sdk.Tx
+		expBaseFee       sdkmath.Int
+		expPriorityFee   sdkmath.Int
+		expBurned        sdkmath.Int
+		expProposer      sdkmath.Int
+		expCommunityPool sdkmath.Int
+		expFeeCollector  sdkmath.Int
+	}{
+		{
+			name:             "pass - default fee split burns the Cosmos transaction fees",
+			baseFeeSplit:     feemarkettypes.DefaultBaseFeeSplit,
+			priorityFeeSplit: feemarkettypes.DefaultPriorityFeeSplit,
+			cosmosFeeSplit:   feemarkettypes.DefaultCosmosFeeSplit,
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1_500_000))}
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expBaseFee:       sdkmath.NewInt(1_000_000),
+			expPriorityFee:   sdkmath.NewInt(500_000),
+			expBurned:        sdkmath.NewInt(1_500_000),
+			expProposer:      sdkmath.ZeroInt(),
+			expCommunityPool: sdkmath.ZeroInt(),
+			expFeeCollector:  sdkmath.ZeroInt(),
+		},
+		{
+			name:             "pass - split Cosmos transaction fees with the Cosmos fee split",
+			baseFeeSplit:     feemarkettypes.NewFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			priorityFeeSplit: feemarkettypes.NewFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			cosmosFeeSplit:   feemarkettypes.NewFeeSplit(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(3, 1), sdkmath.LegacyNewDecWithPrec(1, 1)),
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1_500_000))}
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			// base fee: 10 * 100_000 gas limit
+			expBaseFee:     sdkmath.NewInt(1_000_000),
+			expPriorityFee: sdkmath.NewInt(500_000),
+			// 500_000 of the base fee and 250_000 of the priority fee
+			expBurned:        sdkmath.NewInt(750_000),
+			expProposer:      sdkmath.NewInt(450_000),
+			expCommunityPool: sdkmath.NewInt(150_000),
+			expFeeCollector:  sdkmath.NewInt(150_000),
+		},
+		{
+			name:             "pass - split Ethereum transaction fees paid for the gas used",
+			baseFeeSplit:     feemarkettypes.NewFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			priorityFeeSplit: feemarkettypes.NewFeeSplit(sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDecWithPrec(3, 1), sdkmath.LegacyNewDecWithPrec(3, 1)),
+			cosmosFeeSplit:   feemarkettypes.DefaultCosmosFeeSplit,
+			tx: func() sdk.Tx {
+				// 21_000 gas used at an effective gas price of 15
+				fees := big.NewInt(315_000)
+				s.MintCoinsForFeeCollector(sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(fees))})
+
+				ctx := s.unitNetwork.GetContext()
+				s.unitNetwork.App.EvmKeeper.SetTransientGasUsed(ctx, 21_000)
+				s.unitNetwork.App.EvmKeeper.AddTransientTxFees(ctx, fees)
+
+				return s.BuildEthTx()
+			},
+			expBaseFee:       sdkmath.NewInt(210_000),
+			expPriorityFee:   sdkmath.NewInt(105_000),
+			expBurned:        sdkmath.NewInt(210_000),
+			expProposer:      sdkmath.NewInt(31_500),
+			expCommunityPool: sdkmath.NewInt(31_500),
+			expFeeCollector:  sdkmath.NewInt(42_000),
+		},
+		{
+			name:             "pass - rounded down amounts are kept by the fee collector",
+			baseFeeSplit:     feemarkettypes.DefaultBaseFeeSplit,
+			priorityFeeSplit: feemarkettypes.DefaultPriorityFeeSplit,
+			cosmosFeeSplit:   feemarkettypes.NewFeeSplit(sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyNewDecWithPrec(1, 1)),
+			tx: func() sdk.Tx {
+				feeAmount := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinDenom(), sdkmath.NewInt(1_000_009))}
+				s.MintCoinsForFeeCollector(feeAmount)
+
+				return s.BuildCosmosTxWithNSendMsg(1, feeAmount)
+			},
+			expBaseFee:       sdkmath.NewInt(1_000_000),
+			expPriorityFee:   sdkmath.NewInt(9),
+			expBurned:        sdkmath.NewInt(100_000),
+			expProposer:      sdkmath.NewInt(100_000),
+			expCommunityPool: sdkmath.NewInt(100_000),
+			expFeeCollector:  sdkmath.NewInt(700_009),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest()
+		s.Run(tc.name, func() {
+			err := s.unitNetwork.NextBlock()
+			s.Require().NoError(err)
+
+			ctx := s.unitNetwork.GetContext()
+			denom := evmtypes.GetEVMCoinDenom()
+
+			params := s.unitNetwork.App.FeeMarketKeeper.GetParams(ctx)
+			params.BaseFee = baseFee
+			params.BaseFeeSplit = tc.baseFeeSplit
+			params.PriorityFeeSplit = tc.priorityFeeSplit
+			params.CosmosFeeSplit = tc.cosmosFeeSplit
+			err = s.unitNetwork.App.FeeMarketKeeper.SetParams(ctx, params)
+			s.Require().NoError(err)
+
+			proposer := s.GetProposerAddress()
+			proposerBalance := s.unitNetwork.App.BankKeeper.GetBalance(ctx, proposer, denom)
+			communityPool := s.GetCommunityPool()
+
+			tx := tc.tx()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			terminator := sdk.ChainPostDecorators(sdk.Terminator{}) //nolint:staticcheck
+			_, err = s.NewFeeSplitDecorator().PostHandle(ctx, tx, false, true, terminator)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expFeeCollector, s.GetFeeCollectorBalance().AmountOf(denom))
+
+			newProposerBalance := s.unitNetwork.App.BankKeeper.GetBalance(ctx, proposer, denom)
+			s.Require().Equal(tc.expProposer, newProposerBalance.Amount.Sub(proposerBalance.Amount))
+
+			newCommunityPool := s.GetCommunityPool()
+			s.Require().Equal(tc.expCommunityPool, newCommunityPool.Sub(communityPool))
+
+			coinsString := func(amount sdkmath.Int) string {
+				return sdk.NewCoins(sdk.NewCoin(denom, amount)).String()
+			}
+			expAttributes := map[string]string{
+				feemarkettypes.AttributeKeyBaseFee:        coinsString(tc.expBaseFee),
+				feemarkettypes.AttributeKeyPriorityFee:    coinsString(tc.expPriorityFee),
+				feemarkettypes.AttributeKeyBurned:         coinsString(tc.expBurned),
+				feemarkettypes.AttributeKeyProposer:       proposer.String(),
+				feemarkettypes.AttributeKeyProposerReward: coinsString(tc.expProposer),
+				feemarkettypes.AttributeKeyCommunityPool:  coinsString(tc.expCommunityPool),
+			}
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != feemarkettypes.EventTypeFeeSplit {
+					continue
+				}
+				found = true
+				for _, attr := range event.Attributes {
+					s.Require().Equal(expAttributes[attr.Key], attr.Value, attr.Key)
+				}
+			}
+			s.Require().True(found, "expected fee split event")
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package post
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// EVMKeeper defines the expected keeper interface used on the PostHandler
+type EVMKeeper interface {
+	GetTransientGasUsed(ctx sdk.Context) uint64
+	GetTransientTxFees(ctx sdk.Context) *big.Int
+}
+
+// FeeMarketKeeper defines the expected fee market keeper interface used on the PostHandler
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
+
+// DistributionKeeper defines the expected distribution keeper interface used on the PostHandler
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// StakingKeeper defines the expected staking keeper interface used on the PostHandler
+type StakingKeeper interface {
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+}
@@ -12,8 +12,12 @@ import (
 
 // HandlerOptions are the options required for constructing a PostHandler.
 type HandlerOptions struct {
-	FeeCollectorName string
-	BankKeeper       bankkeeper.Keeper
+	FeeCollectorName   string
+	BankKeeper         bankkeeper.Keeper
+	DistributionKeeper DistributionKeeper
+	StakingKeeper      StakingKeeper
+	EvmKeeper          EVMKeeper
+	FeeMarketKeeper    FeeMarketKeeper
 }
 
 func (h HandlerOptions) Validate() error {
@@ -25,13 +29,36 @@ func (h HandlerOptions) Validate() error {
 		return errors.New("bank keeper cannot be nil")
 	}
 
+	if h.DistributionKeeper == nil {
+		return errors.New("distribution keeper cannot be nil")
+	}
+
+	if h.StakingKeeper == nil {
+		return errors.New("staking keeper cannot be nil")
+	}
+
+	if h.EvmKeeper == nil {
+		return errors.New("evm keeper cannot be nil")
+	}
+
+	if h.FeeMarketKeeper == nil {
+		return errors.New("fee market keeper cannot be nil")
+	}
+
 	return nil
 }
 
 // NewPostHandler returns a new PostHandler decorators chain.
 func NewPostHandler(ho HandlerOptions) sdk.PostHandler {
 	postDecorators := []sdk.PostDecorator{
-		NewBurnDecorator(ho.FeeCollectorName, ho.BankKeeper),
+		NewFeeSplitDecorator(
+			ho.FeeCollectorName,
+			ho.BankKeeper,
+			ho.DistributionKeeper,
+			ho.StakingKeeper,
+			ho.EvmKeeper,
+			ho.FeeMarketKeeper,
+		),
 	}
 
 	return sdk.ChainPostDecorators(postDecorators...)
@@ -5,40 +5,68 @@ package post_test
 
 import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/evmos/evmos/v20/app/post"
 )
 
 func (s *PostTestSuite) TestPostHandlerOptions() {
-	validBankKeeper := s.unitNetwork.App.BankKeeper
-	validFeeCollector := authtypes.FeeCollectorName
-
 	testCases := []struct {
-		name         string
-		feeCollector string
-		bankKeeper   bankkeeper.Keeper
-		expPass      bool
-		errContains  string
+		name        string
+		malleate    func(options *post.HandlerOptions)
+		expPass     bool
+		errContains string
 	}{
 		{
-			name:         "fail - empty fee collector name",
-			feeCollector: "",
-			bankKeeper:   validBankKeeper,
-			expPass:      false,
-			errContains:  "fee collector name cannot be empty",
+			name: "fail - empty fee collector name",
+			malleate: func(options *post.HandlerOptions) {
+				options.FeeCollectorName = ""
+			},
+			expPass:     false,
+			errContains: "fee collector name cannot be empty",
+		},
+		{
+			name: "fail - nil bank keeper",
+			malleate: func(options *post.HandlerOptions) {
+				options.BankKeeper = nil
+			},
+			expPass:     false,
+			errContains: "bank keeper cannot be nil",
+		},
+		{
+			name: "fail - nil distribution keeper",
+			malleate: func(options *post.HandlerOptions) {
+				options.DistributionKeeper = nil
+			},
+			expPass:     false,
+			errContains: "distribution keeper cannot be nil",
+		},
+		{
+			name: "fail - nil staking keeper",
+			malleate: func(options *post.HandlerOptions) {
+				options.StakingKeeper = nil
+			},
+			expPass:     false,
+			errContains: "staking keeper cannot be nil",
+		},
+		{
+			name: "fail - nil evm keeper",
+			malleate: func(options *post.HandlerOptions) {
+				options.EvmKeeper = nil
+			},
+			expPass:     false,
+			errContains: "evm keeper cannot be nil",
 		},
 		{
-			name:         "fail - nil bank keeper",
-			feeCollector: validFeeCollector,
-			bankKeeper:   nil,
-			expPass:      false,
-			errContains:  "bank keeper cannot be nil",
+			name: "fail - nil fee market keeper",
+			malleate: func(options *post.HandlerOptions) {
+				options.FeeMarketKeeper = nil
+			},
+			expPass:     false,
+			errContains: "fee market keeper cannot be nil",
 		},
 		{
-			name:         "pass - correct inputs",
-			feeCollector: validFeeCollector,
-			bankKeeper:   validBankKeeper,
-			expPass:      true,
+			name:     "pass - correct inputs",
+			malleate: func(*post.HandlerOptions) {},
+			expPass:  true,
 		},
 	}
 
@@ -52,9 +80,14 @@ func (s *PostTestSuite) TestPostHandlerOptions() {
 			s.Require().NoError(err)
 
 			handlerOptions := post.HandlerOptions{
-				FeeCollectorName: tc.feeCollector,
-				BankKeeper:       tc.bankKeeper,
+				FeeCollectorName:   authtypes.FeeCollectorName,
+				BankKeeper:         s.unitNetwork.App.BankKeeper,
+				DistributionKeeper: s.unitNetwork.App.DistrKeeper,
+				StakingKeeper:      s.unitNetwork.App.StakingKeeper,
+				EvmKeeper:          s.unitNetwork.App.EvmKeeper,
+				FeeMarketKeeper:    s.unitNetwork.App.FeeMarketKeeper,
 			}
+			tc.malleate(&handlerOptions)
 
 			err = handlerOptions.Validate()
 
@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,7 @@ import (
 	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/evmos/evmos/v20/app/post"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -146,3 +148,36 @@ func (s *PostTestSuite) GetFeeCollectorBalance() sdk.Coins {
 	)
 	return balance
 }
+
+// NewFeeSplitDecorator is an utility function to create a FeeSplitDecorator
+// with the keepers of the test network.
+func (s *PostTestSuite) NewFeeSplitDecorator() sdk.PostDecorator {
+	return post.NewFeeSplitDecorator(
+		authtypes.FeeCollectorName,
+		s.unitNetwork.App.BankKeeper,
+		s.unitNetwork.App.DistrKeeper,
+		s.unitNetwork.App.StakingKeeper,
+		s.unitNetwork.App.EvmKeeper,
+		s.unitNetwork.App.FeeMarketKeeper,
+	)
+}
+
+// GetProposerAddress is an utility function to query the account address
+// of the operator of the block proposer.
+func (s *PostTestSuite) GetProposerAddress() sdk.AccAddress {
+	ctx := s.unitNetwork.GetContext()
+	validator, err := s.unitNetwork.App.StakingKeeper.GetValidatorByConsAddr(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	s.Require().NoError(err)
+	return sdk.AccAddress(valAddr)
+}
+
+// GetCommunityPool is an utility function to query the amount of the
+// EVM denom in the community pool.
+func (s *PostTestSuite) GetCommunityPool() sdkmath.Int {
+	feePool, err := s.unitNetwork.App.DistrKeeper.FeePool.Get(s.unitNetwork.GetContext())
+	s.Require().NoError(err)
+	return feePool.CommunityPool.AmountOf(evmtypes.GetEVMCoinDenom()).TruncateInt()
+}
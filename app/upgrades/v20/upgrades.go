@@ -33,7 +33,17 @@ func CreateUpgradeHandler(
 
 		// run module migrations first.
 		// so we wont override erc20 params when running strv2 migration,
-		return mm.RunMigrations(ctx, configurator, vm)
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info("enabling token factory precompile")
+		if err := ek.EnableStaticPrecompiles(ctx, common.HexToAddress(evmtypes.TokenFactoryPrecompileAddress)); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
 	}
 }
 
@@ -186,3 +186,72 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerFailedBundle(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	gasLimits := []uint64{21000, 50000}
+	msgs := make([]*types.MsgEthereumTx, 0, len(gasLimits))
+	for i, gasLimit := range gasLimits {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    uint64(i), //nolint:gosec // G115
+			To:       &to,
+			Amount:   big.NewInt(1000),
+			GasLimit: gasLimit,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		msgs = append(msgs, tx)
+	}
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	// build the cosmos-sdk wrapper tx of the bundle
+	tmTx, err := types.BuildBundleTx(clientCtx.TxConfig.NewTxBuilder(), msgs, evmostypes.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code:      types.ErrBundleTxFailed.ABCICode(),
+			Codespace: types.ModuleName,
+			Log:       "transaction " + msgs[1].Hash + ": execution reverted: bundle transaction failed",
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgs[0].Hash},
+					{Key: "txIndex", Value: "0"},
+				}},
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: msgs[1].Hash},
+					{Key: "txIndex", Value: "1"},
+				}},
+			},
+		},
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(block, blockResult))
+
+	// every transaction of the bundle has a failed receipt, charged for its gas limit
+	var cumulativeGasUsed uint64
+	for i, msg := range msgs {
+		res, err := idxer.GetByTxHash(common.HexToHash(msg.Hash))
+		require.NoError(t, err)
+		require.True(t, res.Failed)
+		require.Equal(t, gasLimits[i], res.GasUsed)
+		cumulativeGasUsed += gasLimits[i]
+		require.Equal(t, cumulativeGasUsed, res.CumulativeGasUsed)
+		require.Equal(t, uint32(i), res.MsgIndex)  //nolint:gosec // G115
+		require.Equal(t, int32(i), res.EthTxIndex) //nolint:gosec // G115
+	}
+}
@@ -2,13 +2,18 @@
 pragma solidity >=0.8.18;
 
 import "./IERC20Metadata.sol";
+import "./IERC20Permit.sol";
+import "./IERC3009.sol";
+import "./IERC20MinterBurner.sol";
 
 /**
  * @author Evmos Team
  * @title ERC20 Metadata Allowance Interface
- * @dev Interface for the optional metadata and allowance functions from the ERC20 standard.
+ * @dev Interface for the optional metadata and allowance functions from the ERC20 standard,
+ * including the EIP-2612 permit and the EIP-3009 transfer with authorization extensions,
+ * and the minter methods of module owned token pairs.
  */
-interface IERC20MetadataAllowance is IERC20Metadata {
+interface IERC20MetadataAllowance is IERC20Metadata, IERC20Permit, IERC3009, IERC20MinterBurner {
     /** @dev Atomically increases the allowance granted to spender by the caller.
       * This is an alternative to approve that can be used as a mitigation for problems described in
       * IERC20.approve.
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/**
+ * @author Evmos Team
+ * @title ERC20 Minter Burner Interface
+ * @dev Interface to mint and burn the tokens of a module owned token pair. The methods can only
+ * be called by the token pair minter, which is set through the x/erc20 SetTokenPairMinter
+ * governance message or is the admin of a token factory denomination.
+ */
+interface IERC20MinterBurner {
+    /** @dev Creates amount tokens and assigns them to the to address, increasing the total supply.
+      * Emits a Transfer event with from set to the zero address.
+      * @param to The address which will receive the minted tokens.
+      * @param amount The amount of tokens to be minted.
+    */
+    function mint(address to, uint256 amount) external;
+
+    /** @dev Destroys amount tokens from the caller, reducing the total supply.
+      * Emits a Transfer event with to set to the zero address.
+      * @param amount The amount of tokens to be burned.
+    */
+    function burn(uint256 amount) external;
+
+    /** @dev Destroys amount tokens from account, deducting from the caller's allowance.
+      * Emits a Transfer event with to set to the zero address.
+      * @param account The address whose tokens will be burned.
+      * @param amount The amount of tokens to be burned.
+    */
+    function burnFrom(address account, uint256 amount) external;
+
+    /** @dev Returns the address allowed to mint and burn the tokens.
+      * @return The minter address, or the zero address if minting is disabled.
+    */
+    function minter() external view returns (address);
+
+    /** @dev Updates the name and symbol of a token factory denomination.
+      * @param name The new name of the token.
+      * @param symbol The new symbol of the token.
+    */
+    function setMetadata(string calldata name, string calldata symbol) external;
+}
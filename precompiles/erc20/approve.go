@@ -56,6 +56,21 @@ func (p Precompile) Approve(
 		return nil, ErrSpenderIsOwner
 	}
 
+	if err := p.approve(ctx, grantee, granter, amount); err != nil {
+		return nil, err
+	}
+
+	// TODO: check owner?
+	if err := p.EmitApprovalEvent(ctx, stateDB, p.Address(), spender, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// approve sets the given amount as the allowance of the grantee over the
+// granter's tokens, handling the cases described in Approve.
+func (p Precompile) approve(ctx sdk.Context, grantee, granter common.Address, amount *big.Int) (err error) {
 	// TODO: owner should be the owner of the contract
 	authorization, expiration, _ := auth.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, SendMsgURL) //#nosec:G703 -- we are handling the error case (authorization == nil) in the switch statement below
 
@@ -73,22 +88,13 @@ func (p Precompile) Approve(
 		// case 4: authorization exists, amount positive -> update authorization
 		sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
 		if !ok {
-			return nil, authz.ErrUnknownAuthorizationType
+			return authz.ErrUnknownAuthorizationType
 		}
 
 		err = p.updateAuthorization(ctx, grantee, granter, amount, sendAuthz, expiration)
 	}
 
-	if err != nil {
-		return nil, err
-	}
-
-	// TODO: check owner?
-	if err := p.EmitApprovalEvent(ctx, stateDB, p.Address(), spender, amount); err != nil {
-		return nil, err
-	}
-
-	return method.Outputs.Pack(true)
+	return err
 }
 
 // IncreaseAllowance increases the allowance of the spender address over
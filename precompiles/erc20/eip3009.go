@@ -0,0 +1,189 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// TransferWithAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 transferWithAuthorization transaction.
+	TransferWithAuthorizationMethod = "transferWithAuthorization"
+	// ReceiveWithAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 receiveWithAuthorization transaction.
+	ReceiveWithAuthorizationMethod = "receiveWithAuthorization"
+	// CancelAuthorizationMethod defines the ABI method name for the
+	// EIP-3009 cancelAuthorization transaction.
+	CancelAuthorizationMethod = "cancelAuthorization"
+	// AuthorizationStateMethod defines the ABI method name for the
+	// EIP-3009 authorizationState query.
+	AuthorizationStateMethod = "authorizationState"
+)
+
+var (
+	// transferWithAuthorizationTypeHash is the type hash of the EIP-3009 transferWithAuthorization message.
+	transferWithAuthorizationTypeHash = crypto.Keccak256([]byte("TransferWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// receiveWithAuthorizationTypeHash is the type hash of the EIP-3009 receiveWithAuthorization message.
+	receiveWithAuthorizationTypeHash = crypto.Keccak256([]byte("ReceiveWithAuthorization(address from,address to,uint256 value,uint256 validAfter,uint256 validBefore,bytes32 nonce)"))
+	// cancelAuthorizationTypeHash is the type hash of the EIP-3009 cancelAuthorization message.
+	cancelAuthorizationTypeHash = crypto.Keccak256([]byte("CancelAuthorization(address authorizer,bytes32 nonce)"))
+)
+
+// TransferWithAuthorization executes a transfer from the payer to the payee,
+// given the payer's EIP-712 signed authorization. It handles both the
+// transferWithAuthorization and receiveWithAuthorization methods. The latter
+// requires the caller to be the payee, to prevent front-running attacks.
+func (p *Precompile) TransferWithAuthorization(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseTransferWithAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	typeHash := transferWithAuthorizationTypeHash
+	if method.Name == ReceiveWithAuthorizationMethod {
+		if contract.CallerAddress != input.To {
+			return nil, ErrCallerMustBePayee
+		}
+		typeHash = receiveWithAuthorizationTypeHash
+	}
+
+	blockTime := big.NewInt(ctx.BlockTime().Unix())
+	if blockTime.Cmp(input.ValidAfter) <= 0 {
+		return nil, ErrAuthorizationNotYetValid
+	}
+	if blockTime.Cmp(input.ValidBefore) >= 0 {
+		return nil, ErrAuthorizationExpired
+	}
+
+	pairID := p.tokenPair.GetID()
+	if p.nonceKeeper.IsAuthorizationUsed(ctx, pairID, input.From, input.Nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := crypto.Keccak256(
+		typeHash,
+		common.LeftPadBytes(input.From.Bytes(), 32),
+		common.LeftPadBytes(input.To.Bytes(), 32),
+		common.BigToHash(input.Value).Bytes(),
+		common.BigToHash(input.ValidAfter).Bytes(),
+		common.BigToHash(input.ValidBefore).Bytes(),
+		input.Nonce[:],
+	)
+
+	signer, err := p.recoverTypedDataSigner(ctx, structHash, input.V, input.R, input.S)
+	if err != nil || signer != input.From {
+		return nil, ErrInvalidAuthSignature
+	}
+
+	p.nonceKeeper.SetAuthorizationUsed(ctx, pairID, input.From, input.Nonce)
+
+	if err := p.sendCoins(ctx, stateDB, input.From, input.To, input.Value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationUsed, input.From, input.Nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// CancelAuthorization cancels an unused EIP-3009 authorization, given the
+// authorizer's EIP-712 signed cancellation.
+func (p Precompile) CancelAuthorization(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseCancelAuthorizationArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	pairID := p.tokenPair.GetID()
+	if p.nonceKeeper.IsAuthorizationUsed(ctx, pairID, input.Authorizer, input.Nonce) {
+		return nil, ErrAuthorizationUsed
+	}
+
+	structHash := crypto.Keccak256(
+		cancelAuthorizationTypeHash,
+		common.LeftPadBytes(input.Authorizer.Bytes(), 32),
+		input.Nonce[:],
+	)
+
+	signer, err := p.recoverTypedDataSigner(ctx, structHash, input.V, input.R, input.S)
+	if err != nil || signer != input.Authorizer {
+		return nil, ErrInvalidAuthSignature
+	}
+
+	p.nonceKeeper.SetAuthorizationUsed(ctx, pairID, input.Authorizer, input.Nonce)
+
+	if err := p.EmitAuthorizationEvent(ctx, stateDB, EventTypeAuthorizationCanceled, input.Authorizer, input.Nonce); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// AuthorizationState returns true if the EIP-3009 authorization nonce of the
+// given authorizer has been used or canceled.
+func (p Precompile) AuthorizationState(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	authorizer, nonce, err := ParseAuthorizationStateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	used := p.nonceKeeper.IsAuthorizationUsed(ctx, p.tokenPair.GetID(), authorizer, nonce)
+	return method.Outputs.Pack(used)
+}
+
+// sendCoins sends the given amount of tokens from the payer to the payee and
+// emits the ERC-20 Transfer event. The authorization of the transfer must be
+// checked by the caller.
+func (p *Precompile) sendCoins(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, amount *big.Int) error {
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
+
+	msg := banktypes.NewMsgSend(from.Bytes(), to.Bytes(), coins)
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+
+	msgSrv := bankkeeper.NewMsgServerImpl(p.bankKeeper)
+	if _, err := msgSrv.Send(ctx, msg); err != nil {
+		return ConvertErrToERC20Error(err)
+	}
+
+	if p.tokenPair.Denom == evmtypes.GetEVMCoinDenom() {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(from, amount, cmn.Sub),
+			cmn.NewBalanceChangeEntry(to, amount, cmn.Add))
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, from, to, amount)
+}
@@ -10,6 +10,7 @@ import (
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 
 	storetypes "cosmossdk.io/store/types"
@@ -35,6 +36,20 @@ const (
 	GasTotalSupply       = 2_477
 	GasBalanceOf         = 2_851
 	GasAllowance         = 3_246
+
+	GasPermit                    = 60_000
+	GasNonces                    = 2_600
+	GasDomainSeparator           = 4_000
+	GasTransferWithAuthorization = GasTransfer
+	GasCancelAuthorization       = 35_000
+	GasAuthorizationState        = 2_600
+
+	GasMint     = 50_000
+	GasBurn     = 40_000
+	GasBurnFrom = 50_000
+	GasMinter   = 2_300
+
+	GasSetMetadata = 30_000
 )
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
@@ -44,12 +59,22 @@ var f embed.FS
 
 var _ vm.PrecompiledContract = &Precompile{}
 
+// NonceKeeper defines the expected ERC-20 module keeper used to store the
+// EIP-2612 permit nonces and the EIP-3009 authorization states of each token pair.
+type NonceKeeper interface {
+	GetPermitNonce(ctx sdk.Context, pairID []byte, owner common.Address) uint64
+	SetPermitNonce(ctx sdk.Context, pairID []byte, owner common.Address, nonce uint64)
+	IsAuthorizationUsed(ctx sdk.Context, pairID []byte, authorizer common.Address, nonce common.Hash) bool
+	SetAuthorizationUsed(ctx sdk.Context, pairID []byte, authorizer common.Address, nonce common.Hash)
+}
+
 // Precompile defines the precompiled contract for ERC-20.
 type Precompile struct {
 	cmn.Precompile
 	tokenPair      erc20types.TokenPair
 	bankKeeper     bankkeeper.Keeper
 	transferKeeper transferkeeper.Keeper
+	nonceKeeper    NonceKeeper
 }
 
 // NewPrecompile creates a new ERC-20 Precompile instance as a
@@ -59,6 +84,7 @@ func NewPrecompile(
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
+	nonceKeeper NonceKeeper,
 ) (*Precompile, error) {
 	newABI, err := cmn.LoadABI(f, abiPath)
 	if err != nil {
@@ -76,6 +102,7 @@ func NewPrecompile(
 		tokenPair:      tokenPair,
 		bankKeeper:     bankKeeper,
 		transferKeeper: transferKeeper,
+		nonceKeeper:    nonceKeeper,
 	}
 	// Address defines the address of the ERC-20 precompile contract.
 	p.SetAddress(p.tokenPair.GetERC20Contract())
@@ -110,6 +137,22 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasIncreaseAllowance
 	case auth.DecreaseAllowanceMethod:
 		return GasDecreaseAllowance
+	// EIP-2612 and EIP-3009 transactions
+	case PermitMethod:
+		return GasPermit
+	case TransferWithAuthorizationMethod, ReceiveWithAuthorizationMethod:
+		return GasTransferWithAuthorization
+	case CancelAuthorizationMethod:
+		return GasCancelAuthorization
+	// Minter transactions
+	case MintMethod:
+		return GasMint
+	case BurnMethod:
+		return GasBurn
+	case BurnFromMethod:
+		return GasBurnFrom
+	case SetMetadataMethod:
+		return GasSetMetadata
 	// ERC-20 queries
 	case NameMethod:
 		return GasName
@@ -123,6 +166,15 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasBalanceOf
 	case auth.AllowanceMethod:
 		return GasAllowance
+	// EIP-2612 and EIP-3009 queries
+	case NoncesMethod:
+		return GasNonces
+	case DomainSeparatorMethod:
+		return GasDomainSeparator
+	case AuthorizationStateMethod:
+		return GasAuthorizationState
+	case MinterMethod:
+		return GasMinter
 	default:
 		return 0
 	}
@@ -162,7 +214,15 @@ func (Precompile) IsTransaction(methodName string) bool {
 		TransferFromMethod,
 		auth.ApproveMethod,
 		auth.IncreaseAllowanceMethod,
-		auth.DecreaseAllowanceMethod:
+		auth.DecreaseAllowanceMethod,
+		PermitMethod,
+		TransferWithAuthorizationMethod,
+		ReceiveWithAuthorizationMethod,
+		CancelAuthorizationMethod,
+		MintMethod,
+		BurnMethod,
+		BurnFromMethod,
+		SetMetadataMethod:
 		return true
 	default:
 		return false
@@ -189,6 +249,22 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.IncreaseAllowance(ctx, contract, stateDB, method, args)
 	case auth.DecreaseAllowanceMethod:
 		bz, err = p.DecreaseAllowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 and EIP-3009 transactions
+	case PermitMethod:
+		bz, err = p.Permit(ctx, contract, stateDB, method, args)
+	case TransferWithAuthorizationMethod, ReceiveWithAuthorizationMethod:
+		bz, err = p.TransferWithAuthorization(ctx, contract, stateDB, method, args)
+	case CancelAuthorizationMethod:
+		bz, err = p.CancelAuthorization(ctx, contract, stateDB, method, args)
+	// Minter transactions
+	case MintMethod:
+		bz, err = p.Mint(ctx, contract, stateDB, method, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, contract, stateDB, method, args)
+	case BurnFromMethod:
+		bz, err = p.BurnFrom(ctx, contract, stateDB, method, args)
+	case SetMetadataMethod:
+		bz, err = p.SetMetadata(ctx, contract, stateDB, method, args)
 	// ERC-20 queries
 	case NameMethod:
 		bz, err = p.Name(ctx, contract, stateDB, method, args)
@@ -202,6 +278,15 @@ func (p *Precompile) HandleMethod(
 		bz, err = p.BalanceOf(ctx, contract, stateDB, method, args)
 	case auth.AllowanceMethod:
 		bz, err = p.Allowance(ctx, contract, stateDB, method, args)
+	// EIP-2612 and EIP-3009 queries
+	case NoncesMethod:
+		bz, err = p.Nonces(ctx, contract, stateDB, method, args)
+	case DomainSeparatorMethod:
+		bz, err = p.DomainSeparator(ctx, contract, stateDB, method, args)
+	case AuthorizationStateMethod:
+		bz, err = p.AuthorizationState(ctx, contract, stateDB, method, args)
+	case MinterMethod:
+		bz, err = p.Minter(ctx, contract, stateDB, method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
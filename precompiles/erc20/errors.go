@@ -39,6 +39,20 @@ var (
 	ErrDecreasedAllowanceBelowZero  = errors.New("ERC20: decreased allowance below zero")
 	ErrInsufficientAllowance        = errors.New("ERC20: insufficient allowance")
 	ErrTransferAmountExceedsBalance = errors.New("ERC20: transfer amount exceeds balance")
+	ErrCallerNotMinter              = errors.New("ERC20: caller is not the minter")
+	ErrTokenPairDisabled            = errors.New("ERC20: token pair is disabled")
+	ErrNotFactoryDenom              = errors.New("ERC20: token is not a token factory denomination")
+
+	// EIP-2612 errors
+	ErrPermitExpired          = errors.New("ERC20Permit: expired deadline")
+	ErrInvalidPermitSignature = errors.New("ERC20Permit: invalid signature")
+
+	// EIP-3009 errors
+	ErrAuthorizationNotYetValid = errors.New("EIP3009: authorization is not yet valid")
+	ErrAuthorizationExpired     = errors.New("EIP3009: authorization is expired")
+	ErrAuthorizationUsed        = errors.New("EIP3009: authorization is used or canceled")
+	ErrInvalidAuthSignature     = errors.New("EIP3009: invalid signature")
+	ErrCallerMustBePayee        = errors.New("EIP3009: caller must be the payee")
 )
 
 // BuildExecRevertedErr returns a mocked error that should align with the
@@ -20,6 +20,12 @@ import (
 const (
 	// EventTypeTransfer defines the event type for the ERC-20 Transfer and TransferFrom transactions.
 	EventTypeTransfer = "Transfer"
+	// EventTypeAuthorizationUsed defines the event type for the EIP-3009 transferWithAuthorization
+	// and receiveWithAuthorization transactions.
+	EventTypeAuthorizationUsed = "AuthorizationUsed"
+	// EventTypeAuthorizationCanceled defines the event type for the EIP-3009 cancelAuthorization
+	// transaction.
+	EventTypeAuthorizationCanceled = "AuthorizationCanceled"
 )
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
@@ -94,3 +100,31 @@ func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner
 
 	return nil
 }
+
+// EmitAuthorizationEvent creates a new AuthorizationUsed or AuthorizationCanceled
+// event emitted on the EIP-3009 transactions.
+func (p Precompile) EmitAuthorizationEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, authorizer common.Address, nonce common.Hash) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(authorizer)
+	if err != nil {
+		return err
+	}
+
+	topics[2] = nonce
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        nil,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
@@ -0,0 +1,230 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// MintMethod defines the ABI method name for the mint transaction.
+	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for the burn transaction.
+	BurnMethod = "burn"
+	// BurnFromMethod defines the ABI method name for the burnFrom transaction.
+	BurnFromMethod = "burnFrom"
+	// MinterMethod defines the ABI method name for the minter query.
+	MinterMethod = "minter"
+	// SetMetadataMethod defines the ABI method name for the setMetadata transaction.
+	SetMetadataMethod = "setMetadata"
+)
+
+// Mint creates the given amount of tokens and sends them to the destination
+// address. It can only be called by the minter of a module owned token pair.
+func (p *Precompile) Mint(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	to, amount, err := ParseTransferArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkMinter(contract.CallerAddress); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := p.bankKeeper.MintCoins(ctx, erc20types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := p.bankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, to.Bytes(), coins); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitTransferEvent(ctx, stateDB, common.Address{}, to, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Burn destroys the given amount of tokens from the caller. It can only be
+// called by the minter of a module owned token pair.
+func (p *Precompile) Burn(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %v", args[0])
+	}
+
+	if err := p.checkMinter(contract.CallerAddress); err != nil {
+		return nil, err
+	}
+
+	if err := p.burn(ctx, stateDB, contract.CallerAddress, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// BurnFrom destroys the given amount of tokens from the account, deducting
+// them from the caller's allowance. It can only be called by the minter of a
+// module owned token pair.
+func (p *Precompile) BurnFrom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, amount, err := ParseApproveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	spender := contract.CallerAddress
+	if err := p.checkMinter(spender); err != nil {
+		return nil, err
+	}
+
+	if account != spender {
+		authorization, expiration, allowance, err := GetAuthzExpirationAndAllowance(p.AuthzKeeper, ctx, spender, account, p.tokenPair.Denom)
+		if err != nil || allowance.Cmp(amount) < 0 {
+			return nil, ErrInsufficientAllowance
+		}
+
+		newAllowance := new(big.Int).Sub(allowance, amount)
+		if newAllowance.Sign() == 0 {
+			err = p.removeSpendLimitOrDeleteAuthorization(ctx, spender, account, authorization, expiration)
+		} else {
+			_, err = p.decreaseAllowance(ctx, spender, account, amount, authorization, expiration)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.EmitApprovalEvent(ctx, stateDB, account, spender, newAllowance); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.burn(ctx, stateDB, account, amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Minter returns the minter address of the token pair or the zero address if
+// minting is disabled.
+func (p Precompile) Minter(
+	_ sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(common.HexToAddress(p.tokenPair.Minter))
+}
+
+// SetMetadata updates the name and symbol of a token factory denomination. It
+// can only be called by the minter, which is the admin of the denomination.
+func (p *Precompile) SetMetadata(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	name, symbol, err := ParseSetMetadataArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkMinter(contract.CallerAddress); err != nil {
+		return nil, err
+	}
+
+	if !erc20types.IsFactoryDenom(p.tokenPair.Denom) {
+		return nil, ErrNotFactoryDenom
+	}
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
+	if !found {
+		return nil, fmt.Errorf("metadata not found for denom: %s", p.tokenPair.Denom)
+	}
+
+	metadata.Name = name
+	metadata.Symbol = symbol
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	p.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return method.Outputs.Pack()
+}
+
+// checkMinter returns an error if the given address is not allowed to mint
+// and burn the tokens of the token pair. The tokens of a disabled pair and the
+// EVM coin cannot be minted or burned.
+func (p Precompile) checkMinter(address common.Address) error {
+	if !p.tokenPair.Enabled {
+		return ErrTokenPairDisabled
+	}
+	if p.tokenPair.Denom == evmtypes.GetEVMCoinDenom() ||
+		!p.tokenPair.IsNativeCoin() ||
+		!p.tokenPair.IsMinter(address) {
+		return ErrCallerNotMinter
+	}
+	return nil
+}
+
+// burn destroys the given amount of tokens from the account and emits the
+// ERC-20 Transfer event to the zero address.
+func (p *Precompile) burn(ctx sdk.Context, stateDB vm.StateDB, account common.Address, amount *big.Int) error {
+	coins := sdk.Coins{{Denom: p.tokenPair.Denom, Amount: math.NewIntFromBigInt(amount)}}
+	if err := coins.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.bankKeeper.SendCoinsFromAccountToModule(ctx, account.Bytes(), erc20types.ModuleName, coins); err != nil {
+		return ConvertErrToERC20Error(err)
+	}
+
+	if err := p.bankKeeper.BurnCoins(ctx, erc20types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return p.EmitTransferEvent(ctx, stateDB, account, common.Address{}, amount)
+}
@@ -0,0 +1,427 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// setupMinterPrecompile sets up an ERC20 precompile for the suite's token denomination
+// with the given address as the minter of the token pair.
+func (s *PrecompileTestSuite) setupMinterPrecompile(minter common.Address) *erc20.Precompile {
+	tokenPair := erc20types.NewTokenPair(utiltx.GenerateAddress(), s.tokenDenom, erc20types.OWNER_MODULE)
+	tokenPair.Minter = minter.Hex()
+	s.network.App.Erc20Keeper.SetTokenPair(s.network.GetContext(), tokenPair)
+
+	precompile, err := setupERC20PrecompileForTokenPair(*s.network, tokenPair)
+	s.Require().NoError(err, "failed to set up %q erc20 precompile", tokenPair.Denom)
+
+	return precompile
+}
+
+func (s *PrecompileTestSuite) TestMint() {
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		callerIdx   int
+		args        []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			0,
+			[]interface{}{toAddr},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - caller is not the minter",
+			1,
+			[]interface{}{toAddr, amount},
+			true,
+			erc20.ErrCallerNotMinter.Error(),
+		},
+		{
+			"fail - negative amount",
+			0,
+			[]interface{}{toAddr, big.NewInt(-1)},
+			true,
+			"amount is not positive",
+		},
+		{
+			"pass",
+			0,
+			[]interface{}{toAddr, amount},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			precompile := s.setupMinterPrecompile(s.keyring.GetAddr(0))
+			method := precompile.Methods[erc20.MintMethod]
+			stateDB := s.network.GetStateDB()
+			caller := s.keyring.GetAddr(tc.callerIdx)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, precompile, 0)
+			supplyBefore := s.network.App.BankKeeper.GetSupply(ctx, s.tokenDenom).Amount
+
+			_, err := precompile.Mint(ctx, contract, stateDB, &method, tc.args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err, "expected mint to succeed")
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, toAddr.Bytes(), s.tokenDenom)
+			s.Require().Equal(amount, balance.Amount.BigInt(), "expected different balance")
+			supplyAfter := s.network.App.BankKeeper.GetSupply(ctx, s.tokenDenom).Amount
+			s.Require().Equal(amount, supplyAfter.Sub(supplyBefore).BigInt(), "expected supply to increase")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMintRestrictedPairs() {
+	minter := s.keyring.GetAddr(0)
+
+	testcases := []struct {
+		name        string
+		malleate    func(pair *erc20types.TokenPair)
+		errContains string
+	}{
+		{
+			"fail - token pair is disabled",
+			func(pair *erc20types.TokenPair) {
+				pair.Enabled = false
+			},
+			erc20.ErrTokenPairDisabled.Error(),
+		},
+		{
+			"fail - EVM coin",
+			func(pair *erc20types.TokenPair) {
+				pair.Denom = evmtypes.GetEVMCoinDenom()
+			},
+			erc20.ErrCallerNotMinter.Error(),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tokenPair := erc20types.NewTokenPair(utiltx.GenerateAddress(), s.tokenDenom, erc20types.OWNER_MODULE)
+			tokenPair.Minter = minter.Hex()
+			tc.malleate(&tokenPair)
+
+			precompile, err := setupERC20PrecompileForTokenPair(*s.network, tokenPair)
+			s.Require().NoError(err, "failed to set up %q erc20 precompile", tokenPair.Denom)
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), minter, precompile, 0)
+
+			method := precompile.Methods[erc20.MintMethod]
+			_, err = precompile.Mint(ctx, contract, stateDB, &method, []interface{}{toAddr, big.NewInt(100)})
+			s.Require().ErrorContains(err, tc.errContains)
+
+			method = precompile.Methods[erc20.BurnMethod]
+			_, err = precompile.Burn(ctx, contract, stateDB, &method, []interface{}{big.NewInt(100)})
+			s.Require().ErrorContains(err, tc.errContains)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBurn() {
+	testcases := []struct {
+		name        string
+		callerIdx   int
+		args        []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			0,
+			[]interface{}{},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - caller is not the minter",
+			1,
+			[]interface{}{big.NewInt(100)},
+			true,
+			erc20.ErrCallerNotMinter.Error(),
+		},
+		{
+			"fail - not enough balance",
+			0,
+			[]interface{}{big.NewInt(2e18)},
+			true,
+			erc20.ErrTransferAmountExceedsBalance.Error(),
+		},
+		{
+			"pass",
+			0,
+			[]interface{}{big.NewInt(100)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			precompile := s.setupMinterPrecompile(s.keyring.GetAddr(0))
+			method := precompile.Methods[erc20.BurnMethod]
+			stateDB := s.network.GetStateDB()
+			caller := s.keyring.GetAddr(tc.callerIdx)
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, precompile, 0)
+
+			err := s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, caller.Bytes(), XMPLCoin)
+			s.Require().NoError(err, "failed to send coins")
+
+			_, err = precompile.Burn(ctx, contract, stateDB, &method, tc.args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err, "expected burn to succeed")
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, caller.Bytes(), s.tokenDenom)
+			s.Require().Equal(big.NewInt(1e18-100), balance.Amount.BigInt(), "expected different balance")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestBurnFrom() {
+	var minter, owner testkeyring.Key
+	amount := big.NewInt(100)
+
+	testcases := []struct {
+		name        string
+		callerIdx   int
+		malleate    func()
+		expErr      bool
+		errContains string
+		postCheck   func()
+	}{
+		{
+			"fail - caller is not the minter",
+			1,
+			func() {},
+			true,
+			erc20.ErrCallerNotMinter.Error(),
+			func() {},
+		},
+		{
+			"fail - no allowance",
+			0,
+			func() {},
+			true,
+			erc20.ErrInsufficientAllowance.Error(),
+			func() {},
+		},
+		{
+			"fail - allowance too low",
+			0,
+			func() {
+				s.setupSendAuthz(minter.AccAddr, owner.Priv, sdk.NewCoins(sdk.NewInt64Coin(s.tokenDenom, 50)))
+			},
+			true,
+			erc20.ErrInsufficientAllowance.Error(),
+			func() {},
+		},
+		{
+			"pass - spends the whole allowance",
+			0,
+			func() {
+				s.setupSendAuthz(minter.AccAddr, owner.Priv, sdk.NewCoins(sdk.NewCoin(s.tokenDenom, math.NewIntFromBigInt(amount))))
+			},
+			false,
+			"",
+			func() {
+				authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(
+					s.network.GetContext(), minter.AccAddr, owner.AccAddr, erc20.SendMsgURL,
+				)
+				s.Require().Nil(authorization, "expected authorization to be deleted")
+			},
+		},
+		{
+			"pass - spends part of the allowance",
+			0,
+			func() {
+				s.setupSendAuthz(minter.AccAddr, owner.Priv, XMPLCoin)
+			},
+			false,
+			"",
+			func() {
+				_, _, allowance, err := erc20.GetAuthzExpirationAndAllowance(
+					s.network.App.AuthzKeeper, s.network.GetContext(), minter.Addr, owner.Addr, s.tokenDenom,
+				)
+				s.Require().NoError(err)
+				s.Require().Equal(big.NewInt(1e18-100), allowance, "expected allowance to decrease")
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			minter = s.keyring.GetKey(0)
+			owner = s.keyring.GetKey(1)
+			precompile := s.setupMinterPrecompile(minter.Addr)
+			method := precompile.Methods[erc20.BurnFromMethod]
+			stateDB := s.network.GetStateDB()
+
+			err := s.network.App.BankKeeper.MintCoins(s.network.GetContext(), erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(s.network.GetContext(), erc20types.ModuleName, owner.AccAddr, XMPLCoin)
+			s.Require().NoError(err, "failed to send coins")
+
+			tc.malleate()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.callerIdx), precompile, 0)
+
+			_, err = precompile.BurnFrom(ctx, contract, stateDB, &method, []interface{}{owner.Addr, amount})
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err, "expected burnFrom to succeed")
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, owner.AccAddr, s.tokenDenom)
+			s.Require().Equal(big.NewInt(1e18-100), balance.Amount.BigInt(), "expected different balance")
+			tc.postCheck()
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestMinter() {
+	minter := s.keyring.GetKey(0)
+
+	method := s.precompile.Methods[erc20.MinterMethod]
+	bz, err := s.precompile.Minter(s.network.GetContext(), nil, nil, &method, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(common.Address{}, out[0], "expected zero address without minter")
+
+	precompile := s.setupMinterPrecompile(minter.Addr)
+	bz, err = precompile.Minter(s.network.GetContext(), nil, nil, &method, nil)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(minter.Addr, out[0], "expected minter address")
+}
+
+func (s *PrecompileTestSuite) TestSetMetadata() {
+	testcases := []struct {
+		name        string
+		factory     bool
+		callerIdx   int
+		args        []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			true,
+			0,
+			[]interface{}{"Token"},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - caller is not the minter",
+			true,
+			1,
+			[]interface{}{"New Token", "NTKN"},
+			true,
+			erc20.ErrCallerNotMinter.Error(),
+		},
+		{
+			"fail - not a token factory denom",
+			false,
+			0,
+			[]interface{}{"New Token", "NTKN"},
+			true,
+			erc20.ErrNotFactoryDenom.Error(),
+		},
+		{
+			"fail - empty name",
+			true,
+			0,
+			[]interface{}{"", "NTKN"},
+			true,
+			"name field cannot be blank",
+		},
+		{
+			"pass",
+			true,
+			0,
+			[]interface{}{"New Token", "NTKN"},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			admin := s.keyring.GetKey(0)
+
+			var precompile *erc20.Precompile
+			if tc.factory {
+				pair, err := s.network.App.Erc20Keeper.CreateFactoryDenom(
+					s.network.GetContext(), admin.AccAddr, "token", "Token", "TKN", 18,
+				)
+				s.Require().NoError(err, "failed to create factory denom")
+
+				precompile, err = erc20.NewPrecompile(
+					pair,
+					s.network.App.BankKeeper,
+					s.network.App.AuthzKeeper,
+					s.network.App.TransferKeeper,
+					s.network.App.Erc20Keeper,
+				)
+				s.Require().NoError(err)
+			} else {
+				precompile = s.setupMinterPrecompile(admin.Addr)
+			}
+
+			method := precompile.Methods[erc20.SetMetadataMethod]
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.callerIdx), precompile, 0)
+
+			_, err := precompile.SetMetadata(ctx, contract, stateDB, &method, tc.args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err, "expected setMetadata to succeed")
+
+			nameMethod := precompile.Methods[erc20.NameMethod]
+			bz, err := precompile.Name(ctx, contract, stateDB, &nameMethod, nil)
+			s.Require().NoError(err)
+			out, err := nameMethod.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal("New Token", out[0])
+		})
+	}
+}
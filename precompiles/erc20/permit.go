@@ -0,0 +1,168 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// PermitMethod defines the ABI method name for the EIP-2612 permit
+	// transaction.
+	PermitMethod = "permit"
+	// NoncesMethod defines the ABI method name for the EIP-2612 nonces
+	// query.
+	NoncesMethod = "nonces"
+	// DomainSeparatorMethod defines the ABI method name for the EIP-2612
+	// DOMAIN_SEPARATOR query.
+	DomainSeparatorMethod = "DOMAIN_SEPARATOR"
+
+	// eip712DomainVersion is the version of the EIP-712 signing domain.
+	eip712DomainVersion = "1"
+)
+
+var (
+	// eip712DomainTypeHash is the type hash of the EIP-712 signing domain.
+	eip712DomainTypeHash = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	// permitTypeHash is the type hash of the EIP-2612 permit message.
+	permitTypeHash = crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+)
+
+// Permit sets the given value as the allowance of the spender over the owner's
+// tokens, given the owner's EIP-712 signed approval. The owner's nonce is
+// incremented, so that the signature cannot be replayed.
+func (p Precompile) Permit(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParsePermitArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Deadline.Cmp(big.NewInt(ctx.BlockTime().Unix())) < 0 {
+		return nil, ErrPermitExpired
+	}
+
+	pairID := p.tokenPair.GetID()
+	nonce := p.nonceKeeper.GetPermitNonce(ctx, pairID, input.Owner)
+
+	structHash := crypto.Keccak256(
+		permitTypeHash,
+		common.LeftPadBytes(input.Owner.Bytes(), 32),
+		common.LeftPadBytes(input.Spender.Bytes(), 32),
+		common.BigToHash(input.Value).Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(nonce)).Bytes(),
+		common.BigToHash(input.Deadline).Bytes(),
+	)
+
+	signer, err := p.recoverTypedDataSigner(ctx, structHash, input.V, input.R, input.S)
+	if err != nil || signer != input.Owner {
+		return nil, ErrInvalidPermitSignature
+	}
+
+	p.nonceKeeper.SetPermitNonce(ctx, pairID, input.Owner, nonce+1)
+
+	if err := p.approve(ctx, input.Spender, input.Owner, input.Value); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, input.Owner, input.Spender, input.Value); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// Nonces returns the current EIP-2612 permit nonce of the given owner.
+func (p Precompile) Nonces(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := p.nonceKeeper.GetPermitNonce(ctx, p.tokenPair.GetID(), owner)
+	return method.Outputs.Pack(new(big.Int).SetUint64(nonce))
+}
+
+// DomainSeparator returns the EIP-712 domain separator used to sign the
+// EIP-2612 and EIP-3009 messages of the token. The domain separator changes if
+// the token name is updated.
+func (p Precompile) DomainSeparator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	_ vm.StateDB,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(p.domainSeparator(ctx))
+}
+
+// domainSeparator computes the EIP-712 domain separator of the token, using
+// the token name, the EIP-155 chain ID of the chain and the precompile address.
+// If the token has no name (see Name), the token denomination is used instead.
+//
+// NOTE: the name is read from the bank metadata of the token, which can be
+// updated by governance. Updating the name changes the domain separator, so
+// the permits and authorizations signed for the previous name are no longer
+// valid and have to be signed again.
+func (p Precompile) domainSeparator(ctx sdk.Context) common.Hash {
+	name, err := p.tokenName(ctx)
+	if err != nil {
+		name = p.tokenPair.Denom
+	}
+
+	return crypto.Keccak256Hash(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(eip712DomainVersion)),
+		common.BigToHash(evmtypes.GetChainConfig().ChainID).Bytes(),
+		common.LeftPadBytes(p.Address().Bytes(), 32),
+	)
+}
+
+// recoverTypedDataSigner returns the address that signed the EIP-712 typed
+// data message with the given struct hash.
+func (p Precompile) recoverTypedDataSigner(ctx sdk.Context, structHash []byte, v uint8, r, s [32]byte) (common.Address, error) {
+	digest := crypto.Keccak256([]byte("\x19\x01"), p.domainSeparator(ctx).Bytes(), structHash)
+
+	// NOTE: Ethereum signatures use 27 and 28 as recovery identifiers
+	if v < 27 {
+		return common.Address{}, errors.New("invalid signature recovery id")
+	}
+	v -= 27
+
+	if !crypto.ValidateSignatureValues(v, new(big.Int).SetBytes(r[:]), new(big.Int).SetBytes(s[:]), true) {
+		return common.Address{}, errors.New("invalid signature values")
+	}
+
+	sig := make([]byte, 0, crypto.SignatureLength)
+	sig = append(sig, r[:]...)
+	sig = append(sig, s[:]...)
+	sig = append(sig, v)
+
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,449 @@
+package erc20_test
+
+import (
+	"math/big"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v20/precompiles/erc20"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var (
+	permitTypes = []apitypes.Type{
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	}
+	transferWithAuthorizationTypes = []apitypes.Type{
+		{Name: "from", Type: "address"},
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "validAfter", Type: "uint256"},
+		{Name: "validBefore", Type: "uint256"},
+		{Name: "nonce", Type: "bytes32"},
+	}
+	cancelAuthorizationTypes = []apitypes.Type{
+		{Name: "authorizer", Type: "address"},
+		{Name: "nonce", Type: "bytes32"},
+	}
+)
+
+// signTypedData signs the EIP-712 typed data message for the ERC-20 precompile
+// with the given key and returns the v, r and s values of the signature.
+func (s *PrecompileTestSuite) signTypedData(
+	key testkeyring.Key, primaryType string, fields []apitypes.Type, message apitypes.TypedDataMessage,
+) (uint8, [32]byte, [32]byte) {
+	// NOTE: the token has no metadata, so the denomination is used as name
+	return s.signTypedDataWithName(s.tokenDenom, key, primaryType, fields, message)
+}
+
+// signTypedDataWithName signs the EIP-712 typed data message for the ERC-20
+// precompile using the given token name in the signing domain.
+func (s *PrecompileTestSuite) signTypedDataWithName(
+	name string, key testkeyring.Key, primaryType string, fields []apitypes.Type, message apitypes.TypedDataMessage,
+) (uint8, [32]byte, [32]byte) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			primaryType: fields,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(evmtypes.GetChainConfig().ChainID.Int64()),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+		Message: message,
+	}
+
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	s.Require().NoError(err, "failed to hash typed data")
+
+	privKey, ok := key.Priv.(*ethsecp256k1.PrivKey)
+	s.Require().True(ok, "expected eth_secp256k1 private key")
+	ecdsaKey, err := privKey.ToECDSA()
+	s.Require().NoError(err)
+
+	sig, err := crypto.Sign(digest, ecdsaKey)
+	s.Require().NoError(err, "failed to sign typed data")
+
+	var r, ss [32]byte
+	copy(r[:], sig[:32])
+	copy(ss[:], sig[32:64])
+	return sig[64] + 27, r, ss
+}
+
+// tokenPairID returns the identifier of the token pair of the precompile.
+func (s *PrecompileTestSuite) tokenPairID() []byte {
+	pair := erc20types.TokenPair{Erc20Address: s.precompile.Address().Hex(), Denom: s.tokenDenom}
+	return pair.GetID()
+}
+
+func (s *PrecompileTestSuite) TestPermit() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	owner := s.keyring.GetKey(0)
+	spender := s.keyring.GetKey(1)
+	amount := big.NewInt(100)
+
+	permitArgs := func(signer testkeyring.Key, nonce, deadline *big.Int) []interface{} {
+		v, r, ss := s.signTypedData(signer, "Permit", permitTypes, apitypes.TypedDataMessage{
+			"owner":    owner.Addr.Hex(),
+			"spender":  spender.Addr.Hex(),
+			"value":    amount.String(),
+			"nonce":    nonce.String(),
+			"deadline": deadline.String(),
+		})
+		return []interface{}{owner.Addr, spender.Addr, amount, deadline, v, r, ss}
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func(deadline *big.Int) []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func(*big.Int) []interface{} {
+				return []interface{}{owner.Addr, spender.Addr, amount}
+			},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - expired deadline",
+			func(deadline *big.Int) []interface{} {
+				expired := new(big.Int).Sub(deadline, big.NewInt(7200))
+				return permitArgs(owner, big.NewInt(0), expired)
+			},
+			true,
+			erc20.ErrPermitExpired.Error(),
+		},
+		{
+			"fail - signed by another account",
+			func(deadline *big.Int) []interface{} {
+				return permitArgs(spender, big.NewInt(0), deadline)
+			},
+			true,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"fail - invalid nonce",
+			func(deadline *big.Int) []interface{} {
+				return permitArgs(owner, big.NewInt(1), deadline)
+			},
+			true,
+			erc20.ErrInvalidPermitSignature.Error(),
+		},
+		{
+			"pass",
+			func(deadline *big.Int) []interface{} {
+				return permitArgs(owner, big.NewInt(0), deadline)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), spender.Addr, s.precompile, 0)
+			deadline := big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+			args := tc.malleate(deadline)
+			_, err := s.precompile.Permit(ctx, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err, "expected permit to succeed")
+
+			authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(ctx, spender.AccAddr, owner.AccAddr, erc20.SendMsgURL)
+			sendAuthz, ok := authorization.(*banktypes.SendAuthorization)
+			s.Require().True(ok, "expected send authorization")
+			s.Require().Equal(amount, sendAuthz.SpendLimit.AmountOf(s.tokenDenom).BigInt(), "expected different spend limit")
+
+			pairID := s.tokenPairID()
+			s.Require().Equal(uint64(1), s.network.App.Erc20Keeper.GetPermitNonce(ctx, pairID, owner.Addr))
+
+			// the signature cannot be replayed
+			_, err = s.precompile.Permit(ctx, contract, stateDB, &method, args)
+			s.Require().ErrorContains(err, erc20.ErrInvalidPermitSignature.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestNoncesAndDomainSeparator() {
+	owner := s.keyring.GetKey(0)
+	ctx := s.network.GetContext()
+
+	pairID := s.tokenPairID()
+	s.network.App.Erc20Keeper.SetPermitNonce(ctx, pairID, owner.Addr, 5)
+
+	method := s.precompile.Methods[erc20.NoncesMethod]
+	bz, err := s.precompile.Nonces(ctx, nil, nil, &method, []interface{}{owner.Addr})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(big.NewInt(5), out[0])
+
+	method = s.precompile.Methods[erc20.DomainSeparatorMethod]
+	bz, err = s.precompile.DomainSeparator(ctx, nil, nil, &method, []interface{}{})
+	s.Require().NoError(err)
+
+	domain := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+		},
+		Domain: apitypes.TypedDataDomain{
+			Name:              s.tokenDenom,
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(evmtypes.GetChainConfig().ChainID.Int64()),
+			VerifyingContract: s.precompile.Address().Hex(),
+		},
+	}
+	expected, err := domain.HashStruct("EIP712Domain", domain.Domain.Map())
+	s.Require().NoError(err)
+	s.Require().Equal(common.BytesToHash(expected), common.BytesToHash(bz))
+}
+
+func (s *PrecompileTestSuite) TestPermitAfterNameUpdate() {
+	method := s.precompile.Methods[erc20.PermitMethod]
+	separatorMethod := s.precompile.Methods[erc20.DomainSeparatorMethod]
+	owner := s.keyring.GetKey(0)
+	spender := s.keyring.GetKey(1)
+	amount := big.NewInt(100)
+	newName := "Xmpl Token"
+
+	stateDB := s.network.GetStateDB()
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), spender.Addr, s.precompile, 0)
+	deadline := big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+	permitArgs := func(name string) []interface{} {
+		v, r, ss := s.signTypedDataWithName(name, owner, "Permit", permitTypes, apitypes.TypedDataMessage{
+			"owner":    owner.Addr.Hex(),
+			"spender":  spender.Addr.Hex(),
+			"value":    amount.String(),
+			"nonce":    "0",
+			"deadline": deadline.String(),
+		})
+		return []interface{}{owner.Addr, spender.Addr, amount, deadline, v, r, ss}
+	}
+
+	// sign the permit before the token name is updated
+	oldArgs := permitArgs(s.tokenDenom)
+	oldSeparator, err := s.precompile.DomainSeparator(ctx, nil, nil, &separatorMethod, []interface{}{})
+	s.Require().NoError(err)
+
+	s.network.App.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       s.tokenDenom,
+		Display:    s.tokenDenom,
+		Name:       newName,
+		Symbol:     "XMPL",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: s.tokenDenom, Exponent: 0}},
+	})
+
+	newSeparator, err := s.precompile.DomainSeparator(ctx, nil, nil, &separatorMethod, []interface{}{})
+	s.Require().NoError(err)
+	s.Require().NotEqual(oldSeparator, newSeparator, "expected the domain separator to change with the name")
+
+	// the permit signed for the previous name is no longer valid
+	_, err = s.precompile.Permit(ctx, contract, stateDB, &method, oldArgs)
+	s.Require().ErrorContains(err, erc20.ErrInvalidPermitSignature.Error())
+
+	_, err = s.precompile.Permit(ctx, contract, stateDB, &method, permitArgs(newName))
+	s.Require().NoError(err, "expected permit signed for the new name to succeed")
+}
+
+func (s *PrecompileTestSuite) TestTransferWithAuthorization() {
+	from := s.keyring.GetKey(0)
+	caller := s.keyring.GetKey(1)
+	to := utiltx.GenerateAddress()
+	amount := big.NewInt(100)
+	nonce := common.BytesToHash([]byte("nonce"))
+
+	var validAfter, validBefore *big.Int
+
+	authorizationArgs := func(signer testkeyring.Key, primaryType string) []interface{} {
+		v, r, ss := s.signTypedData(signer, primaryType, transferWithAuthorizationTypes, apitypes.TypedDataMessage{
+			"from":        from.Addr.Hex(),
+			"to":          to.Hex(),
+			"value":       amount.String(),
+			"validAfter":  validAfter.String(),
+			"validBefore": validBefore.String(),
+			"nonce":       hexutil.Encode(nonce.Bytes()),
+		})
+		return []interface{}{from.Addr, to, amount, validAfter, validBefore, [32]byte(nonce), v, r, ss}
+	}
+
+	testcases := []struct {
+		name        string
+		methodName  string
+		malleate    func() []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - authorization not yet valid",
+			erc20.TransferWithAuthorizationMethod,
+			func() []interface{} {
+				validAfter = new(big.Int).Add(validBefore, big.NewInt(-1))
+				return authorizationArgs(from, "TransferWithAuthorization")
+			},
+			true,
+			erc20.ErrAuthorizationNotYetValid.Error(),
+		},
+		{
+			"fail - authorization expired",
+			erc20.TransferWithAuthorizationMethod,
+			func() []interface{} {
+				validBefore = new(big.Int).Set(validAfter)
+				return authorizationArgs(from, "TransferWithAuthorization")
+			},
+			true,
+			erc20.ErrAuthorizationExpired.Error(),
+		},
+		{
+			"fail - signed by another account",
+			erc20.TransferWithAuthorizationMethod,
+			func() []interface{} {
+				return authorizationArgs(caller, "TransferWithAuthorization")
+			},
+			true,
+			erc20.ErrInvalidAuthSignature.Error(),
+		},
+		{
+			"fail - receive signature used for transfer",
+			erc20.TransferWithAuthorizationMethod,
+			func() []interface{} {
+				return authorizationArgs(from, "ReceiveWithAuthorization")
+			},
+			true,
+			erc20.ErrInvalidAuthSignature.Error(),
+		},
+		{
+			"fail - receive caller is not the payee",
+			erc20.ReceiveWithAuthorizationMethod,
+			func() []interface{} {
+				return authorizationArgs(from, "ReceiveWithAuthorization")
+			},
+			true,
+			erc20.ErrCallerMustBePayee.Error(),
+		},
+		{
+			"fail - authorization canceled",
+			erc20.TransferWithAuthorizationMethod,
+			func() []interface{} {
+				pairID := s.tokenPairID()
+				s.network.App.Erc20Keeper.SetAuthorizationUsed(s.network.GetContext(), pairID, from.Addr, nonce)
+				return authorizationArgs(from, "TransferWithAuthorization")
+			},
+			true,
+			erc20.ErrAuthorizationUsed.Error(),
+		},
+		{
+			"pass - transfer with authorization",
+			erc20.TransferWithAuthorizationMethod,
+			func() []interface{} {
+				return authorizationArgs(from, "TransferWithAuthorization")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller.Addr, s.precompile, 0)
+			validAfter = big.NewInt(ctx.BlockTime().Unix() - 1)
+			validBefore = big.NewInt(ctx.BlockTime().Unix() + 3600)
+
+			err := s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, XMPLCoin)
+			s.Require().NoError(err, "failed to mint coins")
+			err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, from.AccAddr, XMPLCoin)
+			s.Require().NoError(err, "failed to send coins from module to account")
+
+			method := s.precompile.Methods[tc.methodName]
+			args := tc.malleate()
+			_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err, "expected transfer with authorization to succeed")
+
+			balance := s.network.App.BankKeeper.GetBalance(ctx, to.Bytes(), s.tokenDenom)
+			s.Require().Equal(amount, balance.Amount.BigInt())
+
+			// the authorization cannot be reused
+			_, err = s.precompile.TransferWithAuthorization(ctx, contract, stateDB, &method, args)
+			s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelAuthorization() {
+	authorizer := s.keyring.GetKey(0)
+	nonce := common.BytesToHash([]byte("nonce"))
+
+	ctx := s.network.GetContext()
+	stateDB := s.network.GetStateDB()
+
+	cancelArgs := func(signer testkeyring.Key) []interface{} {
+		v, r, ss := s.signTypedData(signer, "CancelAuthorization", cancelAuthorizationTypes, apitypes.TypedDataMessage{
+			"authorizer": authorizer.Addr.Hex(),
+			"nonce":      hexutil.Encode(nonce.Bytes()),
+		})
+		return []interface{}{authorizer.Addr, [32]byte(nonce), v, r, ss}
+	}
+
+	stateMethod := s.precompile.Methods[erc20.AuthorizationStateMethod]
+	authorizationState := func() bool {
+		bz, err := s.precompile.AuthorizationState(ctx, nil, stateDB, &stateMethod, []interface{}{authorizer.Addr, [32]byte(nonce)})
+		s.Require().NoError(err)
+		out, err := stateMethod.Outputs.Unpack(bz)
+		s.Require().NoError(err)
+		return out[0].(bool)
+	}
+
+	method := s.precompile.Methods[erc20.CancelAuthorizationMethod]
+	_, err := s.precompile.CancelAuthorization(ctx, nil, stateDB, &method, cancelArgs(s.keyring.GetKey(1)))
+	s.Require().ErrorContains(err, erc20.ErrInvalidAuthSignature.Error())
+	s.Require().False(authorizationState())
+
+	_, err = s.precompile.CancelAuthorization(ctx, nil, stateDB, &method, cancelArgs(authorizer))
+	s.Require().NoError(err)
+	s.Require().True(authorizationState())
+
+	_, err = s.precompile.CancelAuthorization(ctx, nil, stateDB, &method, cancelArgs(authorizer))
+	s.Require().ErrorContains(err, erc20.ErrAuthorizationUsed.Error())
+}
@@ -9,7 +9,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
 )
 
 const (
@@ -140,6 +142,104 @@ func ParseBalanceOfArgs(args []interface{}) (common.Address, error) {
 	return account, nil
 }
 
+// PermitInput defines the input arguments of the EIP-2612 permit method.
+type PermitInput struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+// TransferWithAuthorizationInput defines the input arguments of the EIP-3009
+// transferWithAuthorization and receiveWithAuthorization methods.
+type TransferWithAuthorizationInput struct {
+	From        common.Address
+	To          common.Address
+	Value       *big.Int
+	ValidAfter  *big.Int
+	ValidBefore *big.Int
+	Nonce       [32]byte
+	V           uint8
+	R           [32]byte
+	S           [32]byte
+}
+
+// CancelAuthorizationInput defines the input arguments of the EIP-3009
+// cancelAuthorization method.
+type CancelAuthorizationInput struct {
+	Authorizer common.Address
+	Nonce      [32]byte
+	V          uint8
+	R          [32]byte
+	S          [32]byte
+}
+
+// ParsePermitArgs parses the arguments of the permit method.
+func ParsePermitArgs(method *abi.Method, args []interface{}) (*PermitInput, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	var input PermitInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to PermitInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseTransferWithAuthorizationArgs parses the arguments of the
+// transferWithAuthorization and receiveWithAuthorization methods.
+func ParseTransferWithAuthorizationArgs(method *abi.Method, args []interface{}) (*TransferWithAuthorizationInput, error) {
+	if len(args) != 9 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 9, len(args))
+	}
+
+	var input TransferWithAuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to TransferWithAuthorizationInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseCancelAuthorizationArgs parses the arguments of the cancelAuthorization method.
+func ParseCancelAuthorizationArgs(method *abi.Method, args []interface{}) (*CancelAuthorizationInput, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input CancelAuthorizationInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to CancelAuthorizationInput struct: %s", err)
+	}
+
+	return &input, nil
+}
+
+// ParseAuthorizationStateArgs parses the arguments of the authorizationState
+// method and returns the authorizer address and the authorization nonce.
+func ParseAuthorizationStateArgs(args []interface{}) (common.Address, common.Hash, error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Hash{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	authorizer, ok := args[0].(common.Address)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid authorizer address: %v", args[0])
+	}
+
+	nonce, ok := args[1].([32]byte)
+	if !ok {
+		return common.Address{}, common.Hash{}, fmt.Errorf("invalid nonce: %v", args[1])
+	}
+
+	return authorizer, nonce, nil
+}
+
 // updateOrAddCoin replaces the coin of the given denomination in the coins slice or adds it if it
 // does not exist yet.
 //
@@ -157,3 +257,22 @@ func updateOrAddCoin(coins sdk.Coins, coin sdk.Coin) sdk.Coins {
 	// add it here.
 	return coins.Add(coin)
 }
+
+// ParseSetMetadataArgs parses the arguments of the setMetadata method.
+func ParseSetMetadataArgs(args []interface{}) (name, symbol string, err error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf("invalid number of arguments; expected 2; got: %d", len(args))
+	}
+
+	name, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf("invalid name: %v", args[0])
+	}
+
+	symbol, ok = args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf("invalid symbol: %v", args[1])
+	}
+
+	return name, symbol, nil
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @author Evmos Team
+/// @title ICS20 Callbacks Interface
+/// @dev The interface that contracts sending ICS20 transfers through the ICS20 precompile
+/// must implement to be notified about the packet lifecycle.
+///
+/// To request a callback, the contract must be the sender of the transfer and include a
+/// source callback in the transfer memo:
+///
+///     {"src_callback": {"address": "<contract hex address>", "gas_limit": "200000"}}
+///
+/// The callbacks are called from the ICS20 precompile address
+/// (0x0000000000000000000000000000000000000802). The gas limit defaults to 200000 and
+/// is capped at 1000000. A reverted callback does not affect the packet refund logic.
+///
+/// Incoming transfers can call a contract with the received funds by setting the
+/// contract as the transfer receiver and including a destination callback in the memo:
+///
+///     {"dest_callback": {"address": "<contract hex address>", "calldata": "0x...", "gas_limit": "200000"}}
+///
+/// The destination call is executed from the address
+/// keccak256("ibc-callback/" + destinationChannel + "/" + sender)[12:].
+/// If it reverts, the transfer is rejected and the funds are refunded on the source chain.
+interface ICS20CallbacksI {
+    /// @dev Called when an ICS20 packet sent by the contract is acknowledged.
+    /// @param sourcePort The source port of the packet.
+    /// @param sourceChannel The source channel of the packet.
+    /// @param sequence The sequence number of the packet.
+    /// @param success Whether the acknowledgement is successful.
+    /// @param acknowledgement The JSON encoded acknowledgement.
+    function onAck(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence,
+        bool success,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev Called when an ICS20 packet sent by the contract times out.
+    /// @param sourcePort The source port of the packet.
+    /// @param sourceChannel The source channel of the packet.
+    /// @param sequence The sequence number of the packet.
+    function onTimeout(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence
+    ) external;
+}
@@ -22,6 +22,8 @@ const (
 	ErrNoMatchingAllocation = "no matching allocation found for source port: %s, source channel: %s, and denom: %s"
 	// ErrDifferentOriginFromSender is raised when the origin address is not the same as the sender address.
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
+	// ErrInvalidSourceCallback is raised when the source callback contract is not the sender.
+	ErrInvalidSourceCallback = "source callback contract %s is not the sender %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
 )
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 
@@ -53,6 +54,15 @@ func (p *Precompile) Transfer(
 		return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
 	}
 
+	// only the sender can be notified about the packet acknowledgement or timeout
+	callbackMemo, err := erc20types.ParseCallbackMemo(msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+	if callbackMemo.SrcCallback != nil && callbackMemo.SrcCallback.ContractAddress() != sender {
+		return nil, fmt.Errorf(ErrInvalidSourceCallback, callbackMemo.SrcCallback.Address, sender.String())
+	}
+
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
 	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
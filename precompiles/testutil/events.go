@@ -23,8 +23,14 @@ import (
 // If the amount is nil, it will not check for the amount in the event, which should be used for any generic approvals.
 func CheckAuthorizationEvents(event abi.Event, precompileAddr, granter, grantee common.Address, res abci.ExecTxResult, height int64, msgTypes []string, amount *big.Int) {
 	var log evmtypes.Log
-	// Tx log is always the second last event
-	txLogAttributes := res.Events[len(res.Events)-2].Attributes
+	// Tx log is the last tx log event, the events emitted by the post handler follow it
+	var txLogAttributes []abci.EventAttribute
+	for _, event := range res.Events {
+		if event.Type == evmtypes.EventTypeTxLog {
+			txLogAttributes = event.Attributes
+		}
+	}
+	Expect(txLogAttributes).NotTo(BeEmpty(), "expected tx log event")
 	attr := txLogAttributes[0]
 
 	err := json.Unmarshal([]byte(attr.Value), &log)
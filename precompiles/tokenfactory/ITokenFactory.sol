@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ITokenFactory contract's address.
+address constant TOKEN_FACTORY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The ITokenFactory contract's instance.
+ITokenFactory constant TOKEN_FACTORY_CONTRACT = ITokenFactory(TOKEN_FACTORY_PRECOMPILE_ADDRESS);
+
+/**
+ * @author Evmos Team
+ * @title Token Factory Precompiled Contract
+ * @dev The interface through which solidity contracts create token factory denominations.
+ * The created denomination has the format factory/{creator}/{subdenom} and is exposed as an
+ * ERC20 precompile. The creator is the admin of the denomination and can mint, burn and update
+ * its metadata through the IERC20MinterBurner methods of the ERC20 precompile.
+ */
+interface ITokenFactory {
+    /// @dev Emitted when a new token factory denomination is created.
+    /// @param creator The address of the creator and admin of the denomination.
+    /// @param token The address of the ERC20 precompile of the denomination.
+    /// @param denom The created denomination.
+    event CreateDenom(address indexed creator, address indexed token, string denom);
+
+    /// @dev Creates a new token factory denomination owned by the caller.
+    /// @param subdenom The suffix of the denomination.
+    /// @param name The name of the token.
+    /// @param symbol The symbol of the token.
+    /// @param decimals The decimals of the token.
+    /// @return token The address of the ERC20 precompile of the denomination.
+    function createDenom(
+        string calldata subdenom,
+        string calldata name,
+        string calldata symbol,
+        uint8 decimals
+    ) external returns (address token);
+}
@@ -0,0 +1,53 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// CreateDenomMethod defines the ABI method name for the token factory CreateDenom transaction.
+	CreateDenomMethod = "createDenom"
+)
+
+// CreateDenom creates a new token factory denomination with the caller as
+// creator and admin, and returns the address of its ERC20 precompile. The
+// denom creation fee is paid by the caller.
+func (p *Precompile) CreateDenom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgCreateDenom(contract.CallerAddress, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.erc20Keeper.CreateDenom(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the fee paid in the EVM coin is mirrored to the EVM stateDB,
+	// so that the stateDB does not overwrite the balance in the bank keeper when it is committed.
+	fee := p.erc20Keeper.GetParams(ctx).DenomCreationFee.AmountOf(evmtypes.GetEVMCoinDenom())
+	if fee.IsPositive() {
+		p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(contract.CallerAddress, fee.BigInt(), cmn.Sub))
+	}
+
+	token := common.HexToAddress(res.Erc20Address)
+	if err = p.EmitCreateDenomEvent(ctx, stateDB, contract.CallerAddress, token, res.Denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(token)
+}
@@ -0,0 +1,84 @@
+package tokenfactory_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/precompiles/testutil"
+	"github.com/evmos/evmos/v20/precompiles/tokenfactory"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (s *PrecompileTestSuite) TestCreateDenom() {
+	method := s.precompile.Methods[tokenfactory.CreateDenomMethod]
+
+	testcases := []struct {
+		name        string
+		args        []interface{}
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			[]interface{}{"token", "Token", "TKN"},
+			true,
+			"invalid number of arguments",
+		},
+		{
+			"fail - invalid decimals",
+			[]interface{}{"token", "Token", "TKN", "18"},
+			true,
+			"invalid decimals",
+		},
+		{
+			"fail - empty subdenom",
+			[]interface{}{"", "Token", "TKN", uint8(18)},
+			true,
+			erc20types.ErrInvalidFactoryDenom.Error(),
+		},
+		{
+			"pass",
+			[]interface{}{"token", "Token", "TKN", uint8(18)},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			creator := s.keyring.GetAddr(0)
+			stateDB := s.network.GetStateDB()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), creator, s.precompile, 200_000)
+
+			bz, err := s.precompile.CreateDenom(ctx, contract, stateDB, &method, tc.args)
+			if tc.expErr {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			token, ok := out[0].(common.Address)
+			s.Require().True(ok)
+
+			denom := "factory/" + sdk.AccAddress(creator.Bytes()).String() + "/token"
+			s.Require().Equal(erc20types.GetFactoryDenomAddress(denom), token)
+
+			pair, found := s.network.App.Erc20Keeper.GetTokenPair(ctx, s.network.App.Erc20Keeper.GetTokenPairID(ctx, denom))
+			s.Require().True(found)
+			s.Require().Equal(creator.Hex(), pair.Minter)
+
+			// check the emitted event
+			log := stateDB.Logs()[0]
+			s.Require().Equal(s.precompile.Address(), log.Address)
+			s.Require().Equal(s.precompile.ABI.Events[tokenfactory.EventTypeCreateDenom].ID, log.Topics[0])
+			s.Require().Equal(common.BytesToHash(token.Bytes()), log.Topics[2])
+
+			var event tokenfactory.EventCreateDenom
+			err = s.precompile.UnpackIntoInterface(&event, tokenfactory.EventTypeCreateDenom, log.Data)
+			s.Require().NoError(err)
+			s.Require().Equal(denom, event.Denom)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package tokenfactory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// EventCreateDenom defines the event data for the CreateDenom transaction.
+type EventCreateDenom struct {
+	Creator common.Address
+	Token   common.Address
+	Denom   string
+}
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance for the given creator.
+func NewMsgCreateDenom(creator common.Address, args []interface{}) (*erc20types.MsgCreateDenom, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	subdenom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid subdenom: %v", args[0])
+	}
+
+	name, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid name: %v", args[1])
+	}
+
+	symbol, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid symbol: %v", args[2])
+	}
+
+	decimals, ok := args[3].(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimals: %v", args[3])
+	}
+
+	msg := &erc20types.MsgCreateDenom{
+		Sender:   sdk.AccAddress(creator.Bytes()).String(),
+		Subdenom: subdenom,
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: uint32(decimals),
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
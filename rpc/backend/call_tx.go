@@ -98,27 +98,8 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.logger.Error("transaction decoding failed", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-	}
-
-	ethereumTx := &evmtypes.MsgEthereumTx{}
-	if err := ethereumTx.FromEthereumTx(tx); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, err
-	}
-
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.logger.Debug("tx failed basic validation", "error", err.Error())
+	ethereumTx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
@@ -152,6 +133,99 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	return txHash, nil
 }
 
+// SendBundle sends a bundle of raw Ethereum transactions, wrapped in a single
+// Cosmos transaction, so that either all of them are executed or none of them.
+// It returns the hashes of the Ethereum transactions, which can be used to query
+// their receipts.
+func (b *Backend) SendBundle(args rpctypes.SendBundleArgs) ([]common.Hash, error) {
+	txs := args.Txs
+	if len(txs) == 0 {
+		return nil, errors.New("empty bundle")
+	}
+
+	if len(args.RevertingTxHashes) > 0 {
+		return nil, errors.New("reverting transactions are not supported, the bundle transactions are executed atomically")
+	}
+
+	// Ethereum transactions cannot set a timeout height, so the block targeted
+	// by the bundle cannot be enforced once it is broadcast
+	if args.BlockNumber != 0 {
+		return nil, errors.New("bundle block number is not supported")
+	}
+
+	if args.MinTimestamp != nil || args.MaxTimestamp != nil {
+		return nil, errors.New("bundle timestamps are not supported")
+	}
+
+	ethereumTxs := make([]*evmtypes.MsgEthereumTx, 0, len(txs))
+	txHashes := make([]common.Hash, 0, len(txs))
+	for i, data := range txs {
+		ethereumTx, err := b.decodeRawTransaction(data)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid bundle transaction %d", i)
+		}
+		ethereumTxs = append(ethereumTxs, ethereumTx)
+		txHashes = append(txHashes, ethereumTx.AsTransaction().Hash())
+	}
+
+	baseDenom := evmtypes.GetEVMCoinDenom()
+
+	cosmosTx, err := evmtypes.BuildBundleTx(b.clientCtx.TxConfig.NewTxBuilder(), ethereumTxs, baseDenom)
+	if err != nil {
+		b.logger.Error("failed to build cosmos tx", "error", err.Error())
+		return nil, err
+	}
+
+	// Encode transaction by default Tx encoder
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		b.logger.Error("failed to encode eth bundle using default encoder", "error", err.Error())
+		return nil, err
+	}
+
+	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		b.logger.Error("failed to broadcast bundle", "error", err.Error())
+		return txHashes, err
+	}
+
+	return txHashes, nil
+}
+
+// decodeRawTransaction decodes a raw Ethereum transaction into a MsgEthereumTx
+// and performs the stateless checks of the transaction.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*evmtypes.MsgEthereumTx, error) {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.logger.Error("transaction decoding failed", "error", err.Error())
+		return nil, err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() && !tx.Protected() {
+		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+		return nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	if err := ethereumTx.FromEthereumTx(tx); err != nil {
+		b.logger.Error("transaction converting failed", "error", err.Error())
+		return nil, err
+	}
+
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, err
+	}
+
+	return ethereumTx, nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
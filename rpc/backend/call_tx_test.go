@@ -368,6 +368,112 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSendBundle() {
+	ethTx, bz := suite.buildEthereumTx()
+
+	// Sign the ethTx
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	err := ethTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+	baseDenom := evmtypes.GetEVMCoinDenom()
+	cosmosTx, _ := evmtypes.BuildBundleTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), []*evmtypes.MsgEthereumTx{ethTx}, baseDenom)
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+	timestamp := uint64(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         rpctypes.SendBundleArgs
+		expHashes    []common.Hash
+		expPass      bool
+	}{
+		{
+			"fail - empty bundle",
+			func() {},
+			rpctypes.SendBundleArgs{},
+			nil,
+			false,
+		},
+		{
+			"fail - no RLP encoded bytes",
+			func() {},
+			rpctypes.SendBundleArgs{Txs: []hexutil.Bytes{rlpEncodedBz, bz}},
+			nil,
+			false,
+		},
+		{
+			"fail - reverting transactions are not supported",
+			func() {},
+			rpctypes.SendBundleArgs{
+				Txs:               []hexutil.Bytes{rlpEncodedBz},
+				RevertingTxHashes: []common.Hash{common.HexToHash(ethTx.Hash)},
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - timestamps are not supported",
+			func() {},
+			rpctypes.SendBundleArgs{
+				Txs:          []hexutil.Bytes{rlpEncodedBz},
+				MinTimestamp: &timestamp,
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block number is not supported",
+			func() {},
+			rpctypes.SendBundleArgs{
+				Txs:         []hexutil.Bytes{rlpEncodedBz},
+				BlockNumber: 2,
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - failed to broadcast bundle",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterBroadcastTxError(client, txBytes)
+			},
+			rpctypes.SendBundleArgs{Txs: []hexutil.Bytes{rlpEncodedBz}},
+			[]common.Hash{common.HexToHash(ethTx.Hash)},
+			false,
+		},
+		{
+			"pass - Gets the transaction hashes of the bundle",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterBroadcastTx(client, txBytes)
+			},
+			rpctypes.SendBundleArgs{Txs: []hexutil.Bytes{rlpEncodedBz}},
+			[]common.Hash{common.HexToHash(ethTx.Hash)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			hashes, err := suite.backend.SendBundle(tc.args)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHashes, hashes)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -186,6 +186,14 @@ func (b *Backend) FeeHistory(
 	// rewards should only be calculated if reward percentiles were included
 	calculateRewards := rewardCount != 0
 
+	// without rewards, the fee history kept by the feemarket module can be
+	// fetched with a single query instead of processing each block
+	if !calculateRewards {
+		if feeHistory, ok := b.feeHistoryFromStore(blockStart, blockEnd); ok {
+			return feeHistory, nil
+		}
+	}
+
 	// fetch block
 	for blockID := blockStart; blockID <= blockEnd; blockID++ {
 		index := int32(blockID - blockStart) // #nosec G701 G115
@@ -241,15 +249,101 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// feeHistoryFromStore returns the fee history of the blocks between the start
+// and end heights from the fee history kept by the feemarket module. It returns
+// false if any of the blocks is not kept in the store, in which case the fee
+// history has to be computed from the block results.
+func (b *Backend) feeHistoryFromStore(blockStart, blockEnd int64) (*rpctypes.FeeHistoryResult, bool) {
+	// the base fee of the block following the last one is included if available
+	res, err := b.queryClient.FeeMarket.BlockFeeHistory(b.ctx, &feemarkettypes.QueryBlockFeeHistoryRequest{
+		StartHeight: blockStart,
+		EndHeight:   blockEnd + 1,
+	})
+	if err != nil {
+		b.logger.Debug("failed to query fee history", "start", blockStart, "end", blockEnd, "error", err.Error())
+		return nil, false
+	}
+
+	blocks := blockEnd - blockStart + 1
+	if blocks <= 0 || int64(len(res.History)) < blocks {
+		return nil, false
+	}
+
+	baseFees := make([]*hexutil.Big, blocks+1)
+	gasUsedRatios := make([]float64, blocks)
+	for i, history := range res.History[:blocks] {
+		// the fee history must be contiguous and the base fee enabled
+		if history.Height != blockStart+int64(i) || !history.BaseFee.IsPositive() || history.GasLimit == 0 {
+			return nil, false
+		}
+
+		baseFees[i] = (*hexutil.Big)(history.BaseFee.BigInt())
+		gasUsedRatios[i] = float64(history.GasUsed) / float64(history.GasLimit)
+	}
+
+	if int64(len(res.History)) > blocks {
+		baseFees[blocks] = (*hexutil.Big)(res.History[blocks].BaseFee.BigInt())
+	} else {
+		nextBaseFee, err := b.nextBaseFee(blockEnd, baseFees[blocks-1].ToInt())
+		if err != nil {
+			return nil, false
+		}
+		baseFees[blocks] = (*hexutil.Big)(nextBaseFee)
+	}
+
+	return &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      baseFees,
+		GasUsedRatio: gasUsedRatios,
+	}, true
+}
+
+// SuggestGasTipCap returns the suggested tip cap. The tip is sampled by the gas
+// price oracle from the transactions included in the recent blocks, and cached
+// until the next block. If the recent blocks have no transactions, we return the
+// maximum base fee delta of the current block to help client to mitigate the
+// base fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
 	}
 
+	head, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	headHash := head.Hash()
+	if tip, ok := b.gasPriceOracle.cachedTip(headHash); ok {
+		return tip, nil
+	}
+
+	tips, err := b.sampleRecentTips(head.Number.Int64())
+	if err != nil {
+		return nil, err
+	}
+
+	var tip *big.Int
+	if len(tips) > 0 {
+		tip = b.tipAtPercentile(tips)
+	} else {
+		tip, err = b.maxBaseFeeDelta(baseFee)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if maxPrice := new(big.Int).SetUint64(b.cfg.JSONRPC.GasPriceOracleMaxPrice); tip.Cmp(maxPrice) > 0 {
+		tip = maxPrice
+	}
+
+	b.gasPriceOracle.setCachedTip(headHash, tip)
+	return tip, nil
+}
+
+// maxBaseFeeDelta returns the maximum base fee delta in the current block.
+func (b *Backend) maxBaseFeeDelta(baseFee *big.Int) (*big.Int, error) {
 	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feemarkettypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
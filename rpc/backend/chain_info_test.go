@@ -414,6 +414,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				var header metadata.MD
 				baseFee := math.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
 				_, err := RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
@@ -424,6 +425,7 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				RegisterValidatorAccount(queryClient, validator)
 				RegisterConsensusParams(client, 1)
 				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketParams(feeMarketClient, 1)
 			},
 			1,
 			1,
@@ -453,3 +455,64 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestFeeHistoryFromStore() {
+	history := []feemarkettypes.BlockFeeHistory{
+		{Height: 1, BaseFee: math.NewInt(10), GasUsed: 25, GasLimit: 100},
+		{Height: 2, BaseFee: math.NewInt(12), GasUsed: 50, GasLimit: 100},
+		{Height: 3, BaseFee: math.NewInt(11), GasUsed: 0, GasLimit: 100},
+	}
+
+	testCases := []struct {
+		name          string
+		registerMock  func()
+		expFeeHistory *rpc.FeeHistoryResult
+		expPass       bool
+	}{
+		{
+			"pass - next base fee from the fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterBlockFeeHistory(feeMarketClient, 1, 3, history)
+			},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(10)), (*hexutil.Big)(big.NewInt(12)), (*hexutil.Big)(big.NewInt(11))},
+				GasUsedRatio: []float64{0.25, 0.5},
+			},
+			true,
+		},
+		{
+			"fail - block missing in the fee history",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterBlockFeeHistory(feeMarketClient, 1, 3, history[1:])
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - base fee disabled",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterBlockFeeHistory(feeMarketClient, 1, 3, []feemarkettypes.BlockFeeHistory{
+					{Height: 1, BaseFee: math.ZeroInt(), GasLimit: 100},
+					{Height: 2, BaseFee: math.ZeroInt(), GasLimit: 100},
+				})
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			feeHistory, ok := suite.backend.feeHistoryFromStore(1, 2)
+			suite.Require().Equal(tc.expPass, ok)
+			suite.Require().Equal(tc.expFeeHistory, feeHistory)
+		})
+	}
+}
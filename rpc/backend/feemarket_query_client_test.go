@@ -19,3 +19,9 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// BlockFeeHistory
+func RegisterBlockFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, startHeight, endHeight int64, history []feemarkettypes.BlockFeeHistory) {
+	feeMarketClient.On("BlockFeeHistory", rpc.ContextWithHeight(1), &feemarkettypes.QueryBlockFeeHistoryRequest{StartHeight: startHeight, EndHeight: endHeight}).
+		Return(&feemarkettypes.QueryBlockFeeHistoryResponse{History: history}, nil)
+}
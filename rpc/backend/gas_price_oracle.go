@@ -0,0 +1,147 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// gasPriceOracleSampleNumber is the number of lowest tips sampled from each block.
+const gasPriceOracleSampleNumber = 3
+
+// gasPriceOracle caches the tip suggested for the latest block, so that the
+// recent blocks are only sampled once per block.
+// NOTE: This is inspired from the go-ethereum gas price oracle. For the canonical
+// code refer to: https://github.com/ethereum/go-ethereum/blob/master/eth/gasprice/gasprice.go
+type gasPriceOracle struct {
+	mu       sync.Mutex
+	lastHead common.Hash
+	lastTip  *big.Int
+}
+
+// cachedTip returns the tip suggested for the given head, if any.
+func (o *gasPriceOracle) cachedTip(head common.Hash) (*big.Int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.lastTip == nil || o.lastHead != head {
+		return nil, false
+	}
+	return new(big.Int).Set(o.lastTip), true
+}
+
+// setCachedTip caches the tip suggested for the given head.
+func (o *gasPriceOracle) setCachedTip(head common.Hash, tip *big.Int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.lastHead = head
+	o.lastTip = new(big.Int).Set(tip)
+}
+
+// sampleRecentTips returns the lowest effective tips of the Ethereum
+// transactions included in the recent blocks up to the given height. The
+// transactions sent by the block proposer are ignored, as the proposer can
+// include its own transactions without paying a competitive tip.
+func (b *Backend) sampleRecentTips(height int64) ([]*big.Int, error) {
+	oldest := height - int64(b.cfg.JSONRPC.GasPriceOracleBlocks) + 1
+	if oldest < 1 {
+		oldest = 1
+	}
+
+	var tips []*big.Int
+	for blockHeight := height; blockHeight >= oldest; blockHeight-- {
+		blockTips, err := b.sampleBlockTips(blockHeight)
+		if err != nil {
+			return nil, err
+		}
+		tips = append(tips, blockTips...)
+	}
+
+	return tips, nil
+}
+
+// sampleBlockTips returns the lowest effective tips of the Ethereum
+// transactions included in the block at the given height.
+func (b *Backend) sampleBlockTips(height int64) ([]*big.Int, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil || resBlock == nil {
+		return nil, err
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	proposer := b.proposerAddress(resBlock.Block.Height, resBlock.Block.ProposerAddress)
+
+	var tips []*big.Int
+	for _, msg := range msgs {
+		sender, err := msg.GetSender(b.chainID)
+		if err != nil || sender == proposer {
+			continue
+		}
+
+		tx := msg.AsTransaction()
+		tip := tx.EffectiveGasTipValue(baseFee)
+		if tip == nil || tip.Sign() < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	if len(tips) > gasPriceOracleSampleNumber {
+		tips = tips[:gasPriceOracleSampleNumber]
+	}
+
+	return tips, nil
+}
+
+// proposerAddress returns the Ethereum address of the validator that proposed
+// the block. It returns the zero address if the validator account cannot be
+// queried.
+func (b *Backend) proposerAddress(height int64, consAddress []byte) common.Address {
+	req := &evmtypes.QueryValidatorAccountRequest{
+		ConsAddress: sdk.ConsAddress(consAddress).String(),
+	}
+
+	res, err := b.queryClient.ValidatorAccount(rpctypes.ContextWithHeight(height), req)
+	if err != nil {
+		b.logger.Debug("failed to query validator operator address", "height", height, "cons-address", req.ConsAddress, "error", err.Error())
+		return common.Address{}
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(res.AccountAddress)
+	if err != nil {
+		return common.Address{}
+	}
+
+	return common.BytesToAddress(accAddr)
+}
+
+// tipAtPercentile returns the tip at the configured percentile of the sampled
+// tips. The tips must not be empty.
+func (b *Backend) tipAtPercentile(tips []*big.Int) *big.Int {
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	index := (len(tips) - 1) * int(b.cfg.JSONRPC.GasPriceOraclePercentile) / 100
+	return new(big.Int).Set(tips[index])
+}
@@ -0,0 +1,143 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"google.golang.org/grpc/metadata"
+)
+
+func (suite *BackendTestSuite) TestSuggestGasTipCapFromRecentBlocks() {
+	var msgEthTx *evmtypes.MsgEthereumTx
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expGasTipCap *big.Int
+	}{
+		{
+			"pass - tip sampled from the recent blocks",
+			func() {
+				suite.registerBlockWithTx(msgEthTx, sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+			},
+			big.NewInt(4),
+		},
+		{
+			"pass - tip capped by the max price",
+			func() {
+				suite.backend.cfg.JSONRPC.GasPriceOracleMaxPrice = 2
+				suite.registerBlockWithTx(msgEthTx, sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+			},
+			big.NewInt(2),
+		},
+		{
+			"pass - proposer txs are ignored",
+			func() {
+				txBz := suite.signAndEncodeEthTxForChainID(msgEthTx)
+				sender, err := msgEthTx.GetSender(suite.backend.chainID)
+				suite.Require().NoError(err)
+				proposer := sdk.AccAddress(sender.Bytes())
+				suite.registerBlockWithTxBytes(txBz, proposer)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+			},
+			// max base fee delta: 1 * (2 - 1) / 8
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			msgEthTx = evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:  suite.backend.chainID,
+				Nonce:    uint64(0),
+				To:       &common.Address{},
+				Amount:   big.NewInt(0),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(5),
+			})
+			tc.registerMock()
+
+			tipCap, err := suite.backend.SuggestGasTipCap(big.NewInt(1))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasTipCap, tipCap)
+
+			// the tip is cached until the next block
+			cached, ok := suite.backend.gasPriceOracle.cachedTip(suite.currentHeaderHash())
+			suite.Require().True(ok)
+			suite.Require().Equal(tc.expGasTipCap, cached)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTipAtPercentile() {
+	tips := []*big.Int{big.NewInt(5), big.NewInt(1), big.NewInt(4), big.NewInt(2), big.NewInt(3)}
+
+	testCases := []struct {
+		percentile int32
+		expTip     *big.Int
+	}{
+		{0, big.NewInt(1)},
+		{60, big.NewInt(3)},
+		{100, big.NewInt(5)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case percentile %d", tc.percentile), func() {
+			suite.backend.cfg.JSONRPC.GasPriceOraclePercentile = tc.percentile
+			suite.Require().Equal(tc.expTip, suite.backend.tipAtPercentile(tips))
+		})
+	}
+}
+
+// registerBlockWithTx signs the transaction and registers the mocks of a
+// latest block at height 1 including it.
+func (suite *BackendTestSuite) registerBlockWithTx(msgEthTx *evmtypes.MsgEthereumTx, proposer sdk.AccAddress) {
+	suite.registerBlockWithTxBytes(suite.signAndEncodeEthTxForChainID(msgEthTx), proposer)
+}
+
+// signAndEncodeEthTxForChainID signs the transaction for the chain ID of the
+// backend, so that the sender can be recovered, and returns its encoding.
+func (suite *BackendTestSuite) signAndEncodeEthTxForChainID(msgEthTx *evmtypes.MsgEthereumTx) []byte {
+	from, priv := utiltx.NewAddrKey()
+	msgEthTx.From = from.String()
+	err := msgEthTx.Sign(ethtypes.LatestSignerForChainID(suite.backend.chainID), utiltx.NewSigner(priv))
+	suite.Require().NoError(err)
+
+	tx, err := msgEthTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
+
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return txBz
+}
+
+// registerBlockWithTxBytes registers the mocks of a latest block at height 1
+// including the encoded transaction and proposed by the given account.
+func (suite *BackendTestSuite) registerBlockWithTxBytes(txBz []byte, proposer sdk.AccAddress) {
+	var header metadata.MD
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParams(queryClient, &header, 1)
+	_, err := RegisterBlock(client, 1, txBz)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, 1)
+	suite.Require().NoError(err)
+	RegisterBaseFee(queryClient, math.NewInt(1))
+	RegisterValidatorAccount(queryClient, proposer)
+}
+
+// currentHeaderHash returns the hash of the latest block header.
+func (suite *BackendTestSuite) currentHeaderHash() common.Hash {
+	head, err := suite.backend.CurrentHeader()
+	suite.Require().NoError(err)
+	return head.Hash()
+}
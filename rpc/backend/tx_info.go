@@ -390,7 +390,7 @@ func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpctypes
 
 	var tx sdk.Tx
 	if txResult.TxResult.Code != 0 {
-		// it's only needed when the tx exceeds block gas limit
+		// it's only needed when the tx exceeds block gas limit or when a bundle fails
 		tx, err = b.clientCtx.TxConfig.TxDecoder()(txResult.Tx)
 		if err != nil {
 			return nil, fmt.Errorf("invalid ethereum tx")
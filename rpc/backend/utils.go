@@ -26,6 +26,7 @@ import (
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 	"github.com/evmos/evmos/v20/rpc/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
 type txGasAndReward struct {
@@ -103,6 +104,30 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 	return nonce, nil
 }
 
+// nextBaseFee returns the base fee of the block following the given block.
+func (b *Backend) nextBaseFee(blockHeight int64, blockBaseFee *big.Int) (*big.Int, error) {
+	cfg := b.ChainConfig()
+	if !cfg.IsLondon(big.NewInt(blockHeight + 1)) {
+		return new(big.Int), nil
+	}
+
+	header, err := b.CurrentHeader()
+	if err != nil {
+		return nil, err
+	}
+	params, err := b.queryClient.FeeMarket.Params(types.ContextWithHeight(blockHeight), &feemarkettypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	// only the EIP-1559 base fee can be derived from the header, the other
+	// algorithms depend on the feemarket state so the base fee of the block
+	// is reported until the next block is committed
+	if params.Params.BaseFeeAlgorithm == feemarkettypes.BASE_FEE_ALGORITHM_EIP1559 {
+		return misc.CalcBaseFee(cfg, header), nil
+	}
+	return blockBaseFee, nil
+}
+
 // output: targetOneFeeHistory
 func (b *Backend) processBlock(
 	tendermintBlock *tmrpctypes.ResultBlock,
@@ -119,15 +144,9 @@ func (b *Backend) processBlock(
 
 	// set basefee
 	targetOneFeeHistory.BaseFee = blockBaseFee
-	cfg := b.ChainConfig()
-	if cfg.IsLondon(big.NewInt(blockHeight + 1)) {
-		header, err := b.CurrentHeader()
-		if err != nil {
-			return err
-		}
-		targetOneFeeHistory.NextBaseFee = misc.CalcBaseFee(cfg, header)
-	} else {
-		targetOneFeeHistory.NextBaseFee = new(big.Int)
+	targetOneFeeHistory.NextBaseFee, err = b.nextBaseFee(blockHeight, blockBaseFee)
+	if err != nil {
+		return err
 	}
 	// set gas used ratio
 	gasLimitUint64, ok := (*ethBlock)["gasLimit"].(hexutil.Uint64)
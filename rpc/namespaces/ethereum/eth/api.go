@@ -54,6 +54,7 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendBundle(args rpctypes.SendBundleArgs) ([]common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -217,6 +218,13 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendBundle sends a bundle of raw Ethereum transactions that are executed
+// atomically: if any of them fails, all of them are reverted.
+func (e *PublicAPI) SendBundle(args rpctypes.SendBundleArgs) ([]common.Hash, error) {
+	e.logger.Debug("eth_sendBundle", "txs", len(args.Txs))
+	return e.backend.SendBundle(args)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args.String())
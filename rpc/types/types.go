@@ -84,6 +84,25 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// SendBundleArgs represents the bundle object of eth_sendBundle, as defined by
+// the Flashbots RPC. The transactions of a bundle are executed atomically, so
+// reverting transactions are not supported. The bundle is included in the next
+// block it fits in, so target blocks and timestamp bounds are not supported.
+type SendBundleArgs struct {
+	// Txs are the raw signed Ethereum transactions of the bundle
+	Txs []hexutil.Bytes `json:"txs"`
+	// BlockNumber is the block the bundle targets. It is not supported and must
+	// be omitted or zero.
+	BlockNumber hexutil.Uint64 `json:"blockNumber,omitempty"`
+	// MinTimestamp and MaxTimestamp bound the timestamp of the block including
+	// the bundle. They are not supported.
+	MinTimestamp *uint64 `json:"minTimestamp,omitempty"`
+	MaxTimestamp *uint64 `json:"maxTimestamp,omitempty"`
+	// RevertingTxHashes are the transactions allowed to revert. They are not
+	// supported.
+	RevertingTxHashes []common.Hash `json:"revertingTxHashes,omitempty"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
@@ -281,8 +281,16 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 	return strings.Contains(res.Log, StateDBCommitError)
 }
 
+// TxBundleFailed returns true if a transaction of a bundle failed, which reverts
+// the whole bundle. The fees are deducted in the ante handler, so the bundle
+// transactions shouldn't be ignored in JSON-RPC API.
+func TxBundleFailed(res *abci.ExecTxResult) bool {
+	return res.Codespace == evmtypes.ModuleName && res.Code == evmtypes.ErrBundleTxFailed.ABCICode()
+}
+
 // TxSucessOrExpectedFailure returns true if the transaction was successful
-// or if it failed with an ExceedBlockGasLimit error or TxStateDBCommitError error
+// or if it failed with an ExceedBlockGasLimit error, TxStateDBCommitError error
+// or a bundle transaction error
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
-	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
+	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res) || TxBundleFailed(res)
 }
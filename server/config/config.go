@@ -82,6 +82,15 @@ const (
 	// DefaultFeeHistoryCap is the default cap for total number of blocks that can be fetched
 	DefaultFeeHistoryCap int32 = 100
 
+	// DefaultGasPriceOracleBlocks is the default number of recent blocks sampled by the gas price oracle
+	DefaultGasPriceOracleBlocks int32 = 20
+
+	// DefaultGasPriceOraclePercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGasPriceOraclePercentile int32 = 60
+
+	// DefaultGasPriceOracleMaxPrice is the default maximum tip (in wei) suggested by the gas price oracle
+	DefaultGasPriceOracleMaxPrice uint64 = 500_000_000_000
+
 	// DefaultLogsCap is the default cap of results returned from single 'eth_getLogs' query
 	DefaultLogsCap int32 = 10000
 
@@ -198,6 +207,12 @@ type JSONRPCConfig struct {
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
 	FeeHistoryCap int32 `mapstructure:"feehistory-cap"`
+	// GasPriceOracleBlocks is the number of recent blocks sampled by the gas price oracle
+	GasPriceOracleBlocks int32 `mapstructure:"gpo-blocks"`
+	// GasPriceOraclePercentile is the percentile of the sampled tips suggested by the gas price oracle
+	GasPriceOraclePercentile int32 `mapstructure:"gpo-percentile"`
+	// GasPriceOracleMaxPrice is the maximum tip (in wei) suggested by the gas price oracle
+	GasPriceOracleMaxPrice uint64 `mapstructure:"gpo-max-price"`
 	// Enable defines if the EVM RPC server should be enabled.
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
@@ -339,6 +354,9 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		TxFeeCap:                 DefaultTxFeeCap,
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
+		GasPriceOracleBlocks:     DefaultGasPriceOracleBlocks,
+		GasPriceOraclePercentile: DefaultGasPriceOraclePercentile,
+		GasPriceOracleMaxPrice:   DefaultGasPriceOracleMaxPrice,
 		BlockRangeCap:            DefaultBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
@@ -365,6 +383,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC feehistory-cap cannot be negative or 0")
 	}
 
+	if c.GasPriceOracleBlocks <= 0 {
+		return errors.New("JSON-RPC gpo-blocks cannot be negative or 0")
+	}
+
+	if c.GasPriceOraclePercentile < 0 || c.GasPriceOraclePercentile > 100 {
+		return errors.New("JSON-RPC gpo-percentile must be between 0 and 100")
+	}
+
+	if c.GasPriceOracleMaxPrice == 0 {
+		return errors.New("JSON-RPC gpo-max-price cannot be 0")
+	}
+
 	if c.TxFeeCap < 0 {
 		return errors.New("JSON-RPC tx fee cap cannot be negative")
 	}
@@ -18,6 +18,49 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
 
+func TestJSONRPCConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCConfig)
+		expErr   string
+	}{
+		{
+			"pass - default config",
+			func(*JSONRPCConfig) {},
+			"",
+		},
+		{
+			"fail - zero gpo blocks",
+			func(cfg *JSONRPCConfig) { cfg.GasPriceOracleBlocks = 0 },
+			"gpo-blocks cannot be negative or 0",
+		},
+		{
+			"fail - gpo percentile above 100",
+			func(cfg *JSONRPCConfig) { cfg.GasPriceOraclePercentile = 101 },
+			"gpo-percentile must be between 0 and 100",
+		},
+		{
+			"fail - zero gpo max price caps all tips to 0",
+			func(cfg *JSONRPCConfig) { cfg.GasPriceOracleMaxPrice = 0 },
+			"gpo-max-price cannot be 0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestGetConfig(t *testing.T) {
 	tests := []struct {
 		name    string
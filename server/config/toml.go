@@ -55,6 +55,18 @@ filter-cap = {{ .JSONRPC.FilterCap }}
 # FeeHistoryCap sets the global cap for total number of blocks that can be fetched
 feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 
+# GasPriceOracleBlocks sets the number of recent blocks sampled by the gas price oracle
+# for eth_gasPrice and eth_maxPriorityFeePerGas.
+gpo-blocks = {{ .JSONRPC.GasPriceOracleBlocks }}
+
+# GasPriceOraclePercentile sets the percentile of the sampled transaction tips suggested
+# by the gas price oracle.
+gpo-percentile = {{ .JSONRPC.GasPriceOraclePercentile }}
+
+# GasPriceOracleMaxPrice sets the maximum tip (in wei) suggested by the gas price oracle.
+# It must be greater than 0.
+gpo-max-price = {{ .JSONRPC.GasPriceOracleMaxPrice }}
+
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
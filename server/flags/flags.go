@@ -46,6 +46,9 @@ const (
 	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
 	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
 	JSONRPCFilterCap           = "json-rpc.filter-cap"
+	JSONRPCGPOBlocks           = "json-rpc.gpo-blocks"
+	JSONRPCGPOPercentile       = "json-rpc.gpo-percentile"
+	JSONRPCGPOMaxPrice         = "json-rpc.gpo-max-price"
 	JSONRPCLogsCap             = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
 	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
@@ -194,6 +194,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, config.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
+	cmd.Flags().Int32(srvflags.JSONRPCGPOBlocks, config.DefaultGasPriceOracleBlocks, "Sets the number of recent blocks sampled by the gas price oracle")
+	cmd.Flags().Int32(srvflags.JSONRPCGPOPercentile, config.DefaultGasPriceOraclePercentile, "Sets the percentile of the sampled tips suggested by the gas price oracle") //nolint:lll
+	cmd.Flags().Uint64(srvflags.JSONRPCGPOMaxPrice, config.DefaultGasPriceOracleMaxPrice, "Sets the maximum tip (in wei) suggested by the gas price oracle")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
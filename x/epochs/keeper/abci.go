@@ -23,8 +23,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
-		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-		shouldEpochEnd := ctx.BlockTime().After(epochEndTime) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
+		shouldEpochEnd := epochInfo.ShouldEpochEnd(ctx.BlockTime(), ctx.BlockHeight()) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
 
 		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
@@ -35,6 +34,10 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 			logger.Info("starting epoch", "identifier", epochInfo.Identifier)
 		case shouldEpochEnd:
 			epochInfo.EndEpoch()
+			// epochs measured in blocks start on the block they are ended
+			if epochInfo.IsBlockEpoch() {
+				epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+			}
 
 			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
 
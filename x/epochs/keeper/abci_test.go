@@ -227,3 +227,46 @@ func TestEpochStartingOneMonthAfterInitGenesis(t *testing.T) {
 	require.Equal(t, nowPlusMonth.UTC().String(), epochInfo.CurrentEpochStartTime.UTC().String(), "expected a different start time for the epoch")
 	require.Equal(t, true, epochInfo.EpochCountingStarted, "expected epoch counting started")
 }
+
+func TestBlockIntervalEpoch(t *testing.T) {
+	now := time.Now().UTC()
+	blockIdentifier := "ten_blocks"
+
+	epochsInfo := []types.EpochInfo{
+		{
+			Identifier:              blockIdentifier,
+			StartTime:               now.Add(time.Second),
+			BlockInterval:           10,
+			CurrentEpoch:            0,
+			CurrentEpochStartHeight: 0,
+			CurrentEpochStartTime:   now,
+			EpochCountingStarted:    false,
+		},
+	}
+	suite := SetupTest(epochsInfo)
+	ctx := suite.network.GetContext()
+
+	// first epoch starts on the first block after the start time
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(now.Add(time.Second))
+	require.NoError(t, suite.network.App.EpochsKeeper.BeginBlocker(ctx))
+	epochInfo, found := suite.network.App.EpochsKeeper.GetEpochInfo(ctx, blockIdentifier)
+	require.True(t, found)
+	require.True(t, epochInfo.EpochCountingStarted, "expected epoch counting started")
+	require.Equal(t, int64(1), epochInfo.CurrentEpoch, "expected first epoch")
+	require.Equal(t, int64(2), epochInfo.CurrentEpochStartHeight)
+
+	// epochs measured in blocks do not depend on the block time
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(now.Add(month))
+	require.NoError(t, suite.network.App.EpochsKeeper.BeginBlocker(ctx))
+	epochInfo, found = suite.network.App.EpochsKeeper.GetEpochInfo(ctx, blockIdentifier)
+	require.True(t, found)
+	require.Equal(t, int64(1), epochInfo.CurrentEpoch, "expected still first epoch")
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(now.Add(month).Add(time.Second))
+	require.NoError(t, suite.network.App.EpochsKeeper.BeginBlocker(ctx))
+	epochInfo, found = suite.network.App.EpochsKeeper.GetEpochInfo(ctx, blockIdentifier)
+	require.True(t, found)
+	require.Equal(t, int64(2), epochInfo.CurrentEpoch, "expected second epoch")
+	require.Equal(t, int64(12), epochInfo.CurrentEpochStartHeight)
+	require.Equal(t, ctx.BlockTime().String(), epochInfo.CurrentEpochStartTime.UTC().String())
+}
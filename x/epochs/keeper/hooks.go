@@ -8,7 +8,12 @@ import (
 	"github.com/evmos/evmos/v20/x/epochs/types"
 )
 
-var _ types.EpochHooks = MultiEpochHooks{}
+var (
+	_ types.EpochHooks           = MultiEpochHooks{}
+	_ types.EpochHooks           = IdentifierEpochHooks{}
+	_ types.EpochIdentifierHooks = MultiEpochHooks{}
+	_ types.EpochIdentifierHooks = IdentifierEpochHooks{}
+)
 
 // combine multiple epoch hooks, all hook functions are run in array sequence
 type MultiEpochHooks []types.EpochHooks
@@ -33,12 +38,82 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
+// EpochIdentifiers returns the identifiers of the epochs all the hooks depend
+// on
+func (mh MultiEpochHooks) EpochIdentifiers(ctx sdk.Context) []string {
+	var identifiers []string
+	for i := range mh {
+		if ih, ok := mh[i].(types.EpochIdentifierHooks); ok {
+			identifiers = append(identifiers, ih.EpochIdentifiers(ctx)...)
+		}
+	}
+	return identifiers
+}
+
+// IdentifierEpochHooks runs the wrapped hooks only for the epochs with the
+// given identifier, so receivers don't need to filter on it
+type IdentifierEpochHooks struct {
+	identifier string
+	hooks      types.EpochHooks
+}
+
+// NewIdentifierEpochHooks returns hooks that are only run for the epochs with
+// the given identifier
+func NewIdentifierEpochHooks(identifier string, hooks types.EpochHooks) IdentifierEpochHooks {
+	return IdentifierEpochHooks{
+		identifier: identifier,
+		hooks:      hooks,
+	}
+}
+
+// AfterEpochEnd runs the wrapped hook if the ending epoch has the identifier
+// of the hooks
+func (ih IdentifierEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier == ih.identifier {
+		ih.hooks.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart runs the wrapped hook if the starting epoch has the
+// identifier of the hooks
+func (ih IdentifierEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier == ih.identifier {
+		ih.hooks.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+// EpochIdentifiers returns the identifier of the hooks, as the wrapped hooks
+// are only run for its epochs
+func (ih IdentifierEpochHooks) EpochIdentifiers(_ sdk.Context) []string {
+	return []string{ih.identifier}
+}
+
 // AfterEpochEnd executes the indicated hook after epochs ends
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	}
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	if k.hooks != nil {
+		k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
+
+// IsEpochRequired returns true if the registered hooks depend on the epoch
+// with the given identifier.
+func (k Keeper) IsEpochRequired(ctx sdk.Context, identifier string) bool {
+	ih, ok := k.hooks.(types.EpochIdentifierHooks)
+	if !ok {
+		return false
+	}
+
+	for _, required := range ih.EpochIdentifiers(ctx) {
+		if required == identifier {
+			return true
+		}
+	}
+	return false
 }
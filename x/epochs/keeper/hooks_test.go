@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/epochs/keeper"
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+// countingHooks records the epochs the hooks are run for
+type countingHooks struct {
+	ended   []string
+	started []string
+}
+
+func (h *countingHooks) AfterEpochEnd(_ sdk.Context, epochIdentifier string, _ int64) {
+	h.ended = append(h.ended, epochIdentifier)
+}
+
+func (h *countingHooks) BeforeEpochStart(_ sdk.Context, epochIdentifier string, _ int64) {
+	h.started = append(h.started, epochIdentifier)
+}
+
+func TestIdentifierEpochHooks(t *testing.T) {
+	dayHooks := &countingHooks{}
+	allHooks := &countingHooks{}
+	hooks := keeper.NewMultiEpochHooks(
+		keeper.NewIdentifierEpochHooks(types.DayEpochID, dayHooks),
+		allHooks,
+	)
+
+	ctx := sdk.Context{}
+	for _, identifier := range []string{types.DayEpochID, types.WeekEpochID} {
+		hooks.BeforeEpochStart(ctx, identifier, 1)
+		hooks.AfterEpochEnd(ctx, identifier, 1)
+	}
+
+	require.Equal(t, []string{types.DayEpochID}, dayHooks.started)
+	require.Equal(t, []string{types.DayEpochID}, dayHooks.ended)
+	require.Equal(t, []string{types.DayEpochID, types.WeekEpochID}, allHooks.started)
+	require.Equal(t, []string{types.DayEpochID, types.WeekEpochID}, allHooks.ended)
+}
+
+func TestEpochIdentifiers(t *testing.T) {
+	hooks := keeper.NewMultiEpochHooks(
+		keeper.NewIdentifierEpochHooks(types.DayEpochID, &countingHooks{}),
+		keeper.NewMultiEpochHooks(keeper.NewIdentifierEpochHooks(types.WeekEpochID, &countingHooks{})),
+		&countingHooks{},
+	)
+
+	require.Equal(t, []string{types.DayEpochID, types.WeekEpochID}, hooks.EpochIdentifiers(sdk.Context{}))
+}
@@ -0,0 +1,87 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateEpoch defines a method for creating a new epoch. Epochs without a
+// start time start on the block of the message execution.
+func (k Keeper) CreateEpoch(goCtx context.Context, req *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "epoch with identifier %s already exists", req.Identifier)
+	}
+
+	epoch := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               req.StartTime,
+		Duration:                req.Duration,
+		BlockInterval:           req.BlockInterval,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		EpochCountingStarted:    false,
+	}
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+	}
+
+	if err := epoch.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+		),
+	)
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// DeleteEpoch defines a method for deleting an epoch. The hooks registered for
+// the epoch are not run anymore, so the epochs the hooks depend on cannot be
+// deleted.
+func (k Keeper) DeleteEpoch(goCtx context.Context, req *types.MsgDeleteEpoch) (*types.MsgDeleteEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "epoch with identifier %s does not exist", req.Identifier)
+	}
+
+	if k.IsEpochRequired(ctx, req.Identifier) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "epoch with identifier %s is required by the epoch hooks", req.Identifier)
+	}
+
+	k.DeleteEpochInfo(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
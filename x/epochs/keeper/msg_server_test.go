@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/epochs/types"
+)
+
+func TestCreateEpoch(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgCreateEpoch
+		expectErr bool
+	}{
+		{
+			name: "fail - invalid authority",
+			request: &types.MsgCreateEpoch{
+				Authority:  "foobar",
+				Identifier: monthIdentifier,
+				Duration:   month,
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - epoch already exists",
+			request: &types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: types.DayEpochID,
+				Duration:   day,
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - block epoch with duration",
+			request: &types.MsgCreateEpoch{
+				Authority:     authority,
+				Identifier:    monthIdentifier,
+				Duration:      month,
+				BlockInterval: 10,
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - time epoch",
+			request: &types.MsgCreateEpoch{
+				Authority:  authority,
+				Identifier: monthIdentifier,
+				Duration:   month,
+			},
+			expectErr: false,
+		},
+		{
+			name: "pass - block epoch",
+			request: &types.MsgCreateEpoch{
+				Authority:     authority,
+				Identifier:    monthIdentifier,
+				BlockInterval: 10,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTest([]types.EpochInfo{})
+			ctx := suite.network.GetContext()
+
+			_, err := suite.network.App.EpochsKeeper.CreateEpoch(ctx, tc.request)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			epochInfo, found := suite.network.App.EpochsKeeper.GetEpochInfo(ctx, tc.request.Identifier)
+			require.True(t, found)
+			require.Equal(t, tc.request.Duration, epochInfo.Duration)
+			require.Equal(t, tc.request.BlockInterval, epochInfo.BlockInterval)
+			require.Equal(t, ctx.BlockTime().UTC().String(), epochInfo.StartTime.UTC().String(), "expected epoch to start at the block time")
+			require.False(t, epochInfo.EpochCountingStarted)
+		})
+	}
+}
+
+func TestDeleteEpoch(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgDeleteEpoch
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgDeleteEpoch{Authority: "foobar", Identifier: types.WeekEpochID},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch does not exist",
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: monthIdentifier},
+			expectErr: true,
+		},
+		{
+			name:      "fail - epoch required by the inflation hooks",
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: types.DayEpochID},
+			expectErr: true,
+		},
+		{
+			name:      "pass - delete epoch",
+			request:   &types.MsgDeleteEpoch{Authority: authority, Identifier: types.WeekEpochID},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			suite := SetupTest([]types.EpochInfo{})
+			ctx := suite.network.GetContext()
+
+			_, err := suite.network.App.EpochsKeeper.DeleteEpoch(ctx, tc.request)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, found := suite.network.App.EpochsKeeper.GetEpochInfo(ctx, tc.request.Identifier)
+			require.False(t, found)
+
+			// deleted epochs are not advanced anymore
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(2 * week))
+			require.NoError(t, suite.network.App.EpochsKeeper.BeginBlocker(ctx))
+			_, found = suite.network.App.EpochsKeeper.GetEpochInfo(ctx, tc.request.Identifier)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestCreateEpochAfterGenesis(t *testing.T) {
+	suite := SetupTest([]types.EpochInfo{})
+	ctx := suite.network.GetContext()
+	startTime := ctx.BlockTime().Add(time.Hour)
+
+	_, err := suite.network.App.EpochsKeeper.CreateEpoch(ctx, &types.MsgCreateEpoch{
+		Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Identifier: monthIdentifier,
+		StartTime:  startTime,
+		Duration:   month,
+	})
+	require.NoError(t, err)
+
+	// epoch does not start before its start time
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startTime.Add(-time.Second))
+	require.NoError(t, suite.network.App.EpochsKeeper.BeginBlocker(ctx))
+	epochInfo, found := suite.network.App.EpochsKeeper.GetEpochInfo(ctx, monthIdentifier)
+	require.True(t, found)
+	require.False(t, epochInfo.EpochCountingStarted)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(startTime)
+	require.NoError(t, suite.network.App.EpochsKeeper.BeginBlocker(ctx))
+	epochInfo, found = suite.network.App.EpochsKeeper.GetEpochInfo(ctx, monthIdentifier)
+	require.True(t, found)
+	require.True(t, epochInfo.EpochCountingStarted)
+	require.Equal(t, int64(1), epochInfo.CurrentEpoch)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // StartInitialEpoch sets the epoch info fields to their start values
@@ -22,12 +23,34 @@ func (ei *EpochInfo) EndEpoch() {
 	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
 }
 
+// IsBlockEpoch returns true if the epoch is measured in blocks instead of time
+func (ei EpochInfo) IsBlockEpoch() bool {
+	return ei.BlockInterval > 0
+}
+
+// ShouldEpochEnd returns true if the current epoch has ended at the given
+// block time and height
+func (ei EpochInfo) ShouldEpochEnd(blockTime time.Time, blockHeight int64) bool {
+	if ei.IsBlockEpoch() {
+		return blockHeight >= ei.CurrentEpochStartHeight+ei.BlockInterval
+	}
+
+	epochEndTime := ei.CurrentEpochStartTime.Add(ei.Duration)
+	return blockTime.After(epochEndTime)
+}
+
 // Validate performs a stateless validation of the epoch info fields
 func (ei EpochInfo) Validate() error {
 	if strings.TrimSpace(ei.Identifier) == "" {
 		return errors.New("epoch identifier cannot be blank")
 	}
-	if ei.Duration == 0 {
+	if ei.BlockInterval < 0 {
+		return fmt.Errorf("epoch block interval cannot be negative: %d", ei.BlockInterval)
+	}
+	if ei.IsBlockEpoch() && ei.Duration != 0 {
+		return errors.New("epoch duration must be 0 for epochs measured in blocks")
+	}
+	if !ei.IsBlockEpoch() && ei.Duration == 0 {
 		return errors.New("epoch duration cannot be 0")
 	}
 	if ei.CurrentEpoch < 0 {
@@ -46,6 +46,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			false,
 		},
@@ -59,6 +60,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			false,
 		},
@@ -72,6 +74,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			false,
 		},
@@ -85,9 +88,52 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				0,
 			},
 			false,
 		},
+		{
+			"invalid - negative block interval",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				-1,
+			},
+			false,
+		},
+		{
+			"invalid - block epoch with duration",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				10,
+			},
+			false,
+		},
+		{
+			"pass - block epoch",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				10,
+			},
+			true,
+		},
 		{
 			"pass",
 			EpochInfo{
@@ -98,6 +144,7 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
 			},
 			true,
 		},
@@ -5,9 +5,12 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd    = "epoch_end"
+	EventTypeEpochStart  = "epoch_start"
+	EventTypeCreateEpoch = "create_epoch"
+	EventTypeDeleteEpoch = "delete_epoch"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochIdentifier = "identifier"
 )
@@ -46,6 +46,9 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// block_interval is the number of blocks after which the epoch ends. If set,
+	// the epoch is measured in blocks and the duration must be zero.
+	BlockInterval int64 `protobuf:"varint,8,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -130,6 +133,13 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
@@ -184,38 +194,39 @@ func init() {
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0x49, 0x09, 0x8d, 0x29, 0xaa, 0x6a, 0x15, 0x38, 0x22, 0x71, 0x77, 0x3a, 0x96, 0x80,
-	0xd0, 0xb9, 0x2d, 0x88, 0x01, 0xc4, 0x92, 0x82, 0x80, 0x81, 0x25, 0x61, 0x42, 0x42, 0xd1, 0x25,
-	0x71, 0x1c, 0x4b, 0xb5, 0x7d, 0xba, 0x7b, 0x17, 0x91, 0x1f, 0xc0, 0xde, 0x11, 0xfe, 0x01, 0x23,
-	0x3f, 0xa3, 0x63, 0x47, 0xa6, 0x80, 0x92, 0x01, 0x89, 0xb1, 0xbf, 0x00, 0x9d, 0xed, 0x0b, 0xa1,
-	0x05, 0xb1, 0x9c, 0x7c, 0xef, 0x7b, 0xdf, 0xf7, 0xf9, 0x7d, 0x7a, 0xc6, 0xb7, 0xd9, 0x54, 0xea,
-	0x9c, 0xb2, 0x54, 0x0f, 0x27, 0x39, 0x9d, 0xee, 0x53, 0xce, 0x14, 0xcb, 0x45, 0x1e, 0xa7, 0x99,
-	0x06, 0x4d, 0xb6, 0x0d, 0x1c, 0x5b, 0x38, 0x9e, 0xee, 0xb7, 0x76, 0x12, 0x29, 0x94, 0xa6, 0xe6,
-	0x6b, 0x7b, 0x5a, 0xbb, 0x5c, 0x73, 0x6d, 0x8e, 0xb4, 0x3c, 0xb9, 0xaa, 0xcf, 0xb5, 0xe6, 0x47,
-	0x8c, 0x9a, 0xbf, 0x41, 0x31, 0xa6, 0xa3, 0x22, 0x4b, 0x40, 0x68, 0xe5, 0xf0, 0xe0, 0x3c, 0x0e,
-	0x42, 0xb2, 0x1c, 0x12, 0x99, 0xda, 0x86, 0xe8, 0xd3, 0x06, 0x6e, 0x3e, 0x2f, 0x7d, 0x5f, 0xa9,
-	0xb1, 0x26, 0x3e, 0xc6, 0x62, 0xc4, 0x14, 0x88, 0xb1, 0x60, 0x99, 0x87, 0x42, 0xd4, 0x6e, 0x76,
-	0xd7, 0x2a, 0xe4, 0x1d, 0xc6, 0x39, 0x24, 0x19, 0xf4, 0x4b, 0x19, 0xef, 0x52, 0x88, 0xda, 0x57,
-	0x0f, 0x5a, 0xb1, 0xf5, 0x88, 0x2b, 0x8f, 0xf8, 0x4d, 0xe5, 0xd1, 0x89, 0x4e, 0xe6, 0x41, 0xed,
-	0x6c, 0x1e, 0xec, 0xcc, 0x12, 0x79, 0xf4, 0x38, 0xfa, 0xcd, 0x8d, 0x8e, 0xbf, 0x05, 0xe8, 0xf3,
-	0x8f, 0x2f, 0xf7, 0x50, 0xb7, 0x69, 0xaa, 0x25, 0x87, 0x48, 0xbc, 0x59, 0xdd, 0xdf, 0xab, 0x1b,
-	0xf1, 0x5b, 0x17, 0xc4, 0x9f, 0xb9, 0x86, 0xce, 0xa3, 0x52, 0xfb, 0xe7, 0x3c, 0x20, 0x15, 0xe5,
-	0xbe, 0x96, 0x02, 0x98, 0x4c, 0x61, 0x76, 0x36, 0x0f, 0xb6, 0xad, 0x63, 0x85, 0x45, 0x1f, 0x57,
-	0x7e, 0x2b, 0x0b, 0x72, 0x07, 0x5f, 0x1b, 0x16, 0x59, 0xc6, 0x14, 0xf4, 0x4d, 0xf4, 0xde, 0x46,
-	0x88, 0xda, 0xf5, 0xee, 0x96, 0x2b, 0x9a, 0x58, 0xc8, 0x07, 0x84, 0xbd, 0x3f, 0xba, 0xfa, 0x6b,
-	0x09, 0x5c, 0xfe, 0x6f, 0x02, 0x7b, 0x2e, 0x81, 0xc0, 0xde, 0xe7, 0x5f, 0x4a, 0x6b, 0x79, 0x5c,
-	0x5f, 0xb7, 0xef, 0xad, 0xb2, 0x79, 0x88, 0x6f, 0x58, 0xd2, 0x50, 0x17, 0x0a, 0x84, 0xe2, 0x96,
-	0xcd, 0x46, 0x5e, 0x23, 0x44, 0xed, 0xcd, 0xee, 0xae, 0x41, 0x0f, 0x1d, 0xd8, 0xb3, 0x18, 0x79,
-	0x82, 0x5b, 0x7f, 0xb3, 0x9c, 0x30, 0xc1, 0x27, 0xe0, 0x5d, 0x31, 0xf3, 0xde, 0xbc, 0x60, 0xf8,
-	0xd2, 0xc0, 0xd1, 0x6b, 0xbc, 0xf5, 0xc2, 0xee, 0x69, 0x0f, 0x12, 0x60, 0xe4, 0x29, 0x6e, 0xd8,
-	0x15, 0xf5, 0x50, 0x58, 0x37, 0x73, 0x9f, 0xdb, 0xdb, 0x78, 0xb5, 0x49, 0x9d, 0x66, 0x39, 0xb7,
-	0x1d, 0xc8, 0x91, 0x3a, 0x87, 0x27, 0x0b, 0x1f, 0x9d, 0x2e, 0x7c, 0xf4, 0x7d, 0xe1, 0xa3, 0xe3,
-	0xa5, 0x5f, 0x3b, 0x5d, 0xfa, 0xb5, 0xaf, 0x4b, 0xbf, 0xf6, 0xf6, 0x2e, 0x17, 0x30, 0x29, 0x06,
-	0xf1, 0x50, 0x4b, 0xea, 0x5e, 0x8a, 0xf9, 0x4e, 0x0f, 0xf6, 0xe8, 0xfb, 0xea, 0xd5, 0xc0, 0x2c,
-	0x65, 0xf9, 0xa0, 0x61, 0x32, 0x7e, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xf1, 0x62, 0x17,
-	0x52, 0x03, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xad, 0xff, 0xdd, 0xbf, 0xb4, 0x66, 0x63, 0x9a, 0x35, 0xc0, 0x54, 0x22, 0x89, 0x82, 0x90,
+	0x0a, 0x42, 0xf1, 0x36, 0x10, 0x07, 0x10, 0x97, 0x0e, 0x04, 0x3b, 0x70, 0x69, 0x39, 0x21, 0xa1,
+	0x2a, 0x4d, 0xdd, 0xd4, 0xa2, 0xb6, 0xa3, 0xc4, 0x89, 0xe8, 0x07, 0xe0, 0xbe, 0x23, 0x1f, 0x81,
+	0x23, 0x1f, 0x81, 0xe3, 0x8e, 0x3b, 0x72, 0x2a, 0xa8, 0x3d, 0x20, 0x71, 0xdc, 0x27, 0x40, 0xb1,
+	0x9d, 0x52, 0x36, 0x10, 0x17, 0xcb, 0x79, 0xef, 0xf7, 0x7e, 0xcf, 0x7e, 0xf9, 0x19, 0xde, 0xa4,
+	0x05, 0x97, 0x19, 0xa1, 0x89, 0x8c, 0x26, 0x19, 0x29, 0xf6, 0x49, 0x4c, 0x05, 0xcd, 0x58, 0x16,
+	0x24, 0xa9, 0x54, 0x12, 0x6d, 0x6b, 0x3a, 0x30, 0x74, 0x50, 0xec, 0xb7, 0x77, 0x42, 0xce, 0x84,
+	0x24, 0x7a, 0x35, 0x35, 0xed, 0xdd, 0x58, 0xc6, 0x52, 0x6f, 0x49, 0xb9, 0xb3, 0xa8, 0x13, 0x4b,
+	0x19, 0x4f, 0x29, 0xd1, 0x5f, 0xc3, 0x7c, 0x4c, 0x46, 0x79, 0x1a, 0x2a, 0x26, 0x85, 0xe5, 0xdd,
+	0xf3, 0xbc, 0x62, 0x9c, 0x66, 0x2a, 0xe4, 0x89, 0x29, 0xf0, 0x3f, 0x6f, 0xc0, 0xd6, 0xb3, 0xd2,
+	0xf7, 0x48, 0x8c, 0x25, 0x72, 0x20, 0x64, 0x23, 0x2a, 0x14, 0x1b, 0x33, 0x9a, 0x62, 0xe0, 0x81,
+	0x4e, 0xab, 0xb7, 0x86, 0xa0, 0x37, 0x10, 0x66, 0x2a, 0x4c, 0xd5, 0xa0, 0x6c, 0x83, 0xff, 0xf3,
+	0x40, 0xe7, 0xf2, 0x41, 0x3b, 0x30, 0x1e, 0x41, 0xe5, 0x11, 0xbc, 0xaa, 0x3c, 0xba, 0xfe, 0xc9,
+	0xdc, 0xad, 0x9d, 0xcd, 0xdd, 0x9d, 0x59, 0xc8, 0xa7, 0x8f, 0xfc, 0x5f, 0x5a, 0xff, 0xf8, 0xab,
+	0x0b, 0x3e, 0x7e, 0xff, 0x74, 0x17, 0xf4, 0x5a, 0x1a, 0x2d, 0x35, 0x88, 0xc3, 0x66, 0x75, 0x7e,
+	0x5c, 0xd7, 0xcd, 0x6f, 0x5c, 0x68, 0xfe, 0xd4, 0x16, 0x74, 0x1f, 0x96, 0xbd, 0x7f, 0xcc, 0x5d,
+	0x54, 0x49, 0xee, 0x49, 0xce, 0x14, 0xe5, 0x89, 0x9a, 0x9d, 0xcd, 0xdd, 0x6d, 0xe3, 0x58, 0x71,
+	0xfe, 0x87, 0x95, 0xdf, 0xca, 0x02, 0xdd, 0x82, 0x5b, 0x51, 0x9e, 0xa6, 0x54, 0xa8, 0x81, 0x8e,
+	0x1e, 0x6f, 0x78, 0xa0, 0x53, 0xef, 0x6d, 0x5a, 0x50, 0xc7, 0x82, 0xde, 0x03, 0x88, 0x7f, 0xab,
+	0x1a, 0xac, 0x25, 0xf0, 0xff, 0x3f, 0x13, 0xd8, 0xb3, 0x09, 0xb8, 0xe6, 0x3c, 0x7f, 0xeb, 0xb4,
+	0x96, 0xc7, 0xd5, 0x75, 0xfb, 0xfe, 0x2a, 0x9b, 0x07, 0xf0, 0x9a, 0x11, 0x45, 0x32, 0x17, 0x8a,
+	0x89, 0xd8, 0xa8, 0xe9, 0x08, 0x37, 0x3c, 0xd0, 0x69, 0xf6, 0x76, 0x35, 0x7b, 0x68, 0xc9, 0xbe,
+	0xe1, 0xd0, 0x63, 0xd8, 0xfe, 0x93, 0xe5, 0x84, 0xb2, 0x78, 0xa2, 0xf0, 0x25, 0x7d, 0xdf, 0xeb,
+	0x17, 0x0c, 0x5f, 0x68, 0x1a, 0xdd, 0x86, 0x57, 0x86, 0x53, 0x19, 0xbd, 0x1d, 0x30, 0xa1, 0x68,
+	0x5a, 0x84, 0x53, 0xdc, 0xd4, 0x82, 0x2d, 0x8d, 0x1e, 0x59, 0xd0, 0x7f, 0x09, 0x37, 0x9f, 0x9b,
+	0x71, 0xee, 0xab, 0x50, 0x51, 0xf4, 0x04, 0x36, 0xcc, 0x24, 0x63, 0xe0, 0xd5, 0x75, 0x3c, 0xe7,
+	0xc6, 0x3b, 0x58, 0x0d, 0x5c, 0xb7, 0x55, 0xc6, 0x63, 0xee, 0x6d, 0x45, 0xdd, 0xc3, 0x93, 0x85,
+	0x03, 0x4e, 0x17, 0x0e, 0xf8, 0xb6, 0x70, 0xc0, 0xf1, 0xd2, 0xa9, 0x9d, 0x2e, 0x9d, 0xda, 0x97,
+	0xa5, 0x53, 0x7b, 0x7d, 0x27, 0x66, 0x6a, 0x92, 0x0f, 0x83, 0x48, 0x72, 0x62, 0x1f, 0x94, 0x5e,
+	0x8b, 0x83, 0x3d, 0xf2, 0xae, 0x7a, 0x5c, 0x6a, 0x96, 0xd0, 0x6c, 0xd8, 0xd0, 0xbf, 0xe2, 0xfe,
+	0xcf, 0x01, 0x00, 0x4f, 0x15, 0x35, 0xaf, 0x79, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -238,6 +249,11 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -365,6 +381,9 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockInterval))
+	}
 	return n
 }
 
@@ -607,6 +626,25 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
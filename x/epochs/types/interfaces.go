@@ -12,3 +12,11 @@ type EpochHooks interface {
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
 }
+
+// EpochIdentifierHooks is implemented by the epoch hooks that depend on the
+// epochs with the given identifiers, which must not be deleted.
+type EpochIdentifierHooks interface {
+	// EpochIdentifiers returns the identifiers of the epochs the hooks
+	// depend on
+	EpochIdentifiers(ctx sdk.Context) []string
+}
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg              = &MsgCreateEpoch{}
+	_ sdk.Msg              = &MsgDeleteEpoch{}
+	_ sdk.HasValidateBasic = &MsgCreateEpoch{}
+	_ sdk.HasValidateBasic = &MsgDeleteEpoch{}
+)
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	epoch := EpochInfo{
+		Identifier:    m.Identifier,
+		StartTime:     m.StartTime,
+		Duration:      m.Duration,
+		BlockInterval: m.BlockInterval,
+	}
+	return epoch.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
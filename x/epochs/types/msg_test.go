@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgCreateEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgCreateEpoch{Authority: "invalid", Identifier: WeekEpochID, Duration: time.Hour * 24 * 7},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			&MsgCreateEpoch{Authority: authority, Identifier: " ", Duration: time.Hour * 24 * 7},
+			false,
+		},
+		{
+			"fail - no duration nor block interval",
+			&MsgCreateEpoch{Authority: authority, Identifier: WeekEpochID},
+			false,
+		},
+		{
+			"fail - duration and block interval",
+			&MsgCreateEpoch{Authority: authority, Identifier: WeekEpochID, Duration: time.Hour, BlockInterval: 10},
+			false,
+		},
+		{
+			"pass - time epoch",
+			&MsgCreateEpoch{Authority: authority, Identifier: WeekEpochID, Duration: time.Hour * 24 * 7},
+			true,
+		},
+		{
+			"pass - block epoch",
+			&MsgCreateEpoch{Authority: authority, Identifier: WeekEpochID, BlockInterval: 10},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeleteEpochValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgDeleteEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgDeleteEpoch{Authority: "invalid", Identifier: WeekEpochID},
+			false,
+		},
+		{
+			"fail - empty identifier",
+			&MsgDeleteEpoch{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgDeleteEpoch{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Identifier: WeekEpochID},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -5,14 +5,38 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/evmos/evmos/v20/x/erc20/types"
 )
 
+const (
+	flagOwner        = "owner"
+	flagStatus       = "status"
+	flagContractType = "contract-type"
+)
+
+var (
+	owners = map[string]types.Owner{
+		"module":   types.OWNER_MODULE,
+		"external": types.OWNER_EXTERNAL,
+	}
+	statuses = map[string]types.TokenPairStatus{
+		"enabled":  types.TOKEN_PAIR_STATUS_ENABLED,
+		"disabled": types.TOKEN_PAIR_STATUS_DISABLED,
+	}
+	contractTypes = map[string]types.ContractType{
+		"native-precompile":  types.CONTRACT_TYPE_NATIVE_PRECOMPILE,
+		"dynamic-precompile": types.CONTRACT_TYPE_DYNAMIC_PRECOMPILE,
+		"deployed":           types.CONTRACT_TYPE_DEPLOYED,
+	}
+)
+
 // GetQueryCmd returns the parent command for all erc20 CLI query commands
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +51,12 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetReconcileCmd(),
+		GetInvariantsCmd(),
+		GetFilteredTokenPairsCmd(),
+		GetConversionStatsCmd(),
+		GetFeeTokensCmd(),
+		GetFeeTokenRateCmd(),
 	)
 	return cmd
 }
@@ -129,3 +159,259 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetReconcileCmd queries the escrow and supply comparison of the registered
+// token pairs
+func GetReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Compares the escrowed balance with the converted supply of each token pair",
+		Long:  "Compares the balance escrowed by the erc20 module account with the supply of the converted representation of each registered token pair. A negative difference means the converted supply is not fully backed.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryReconcileRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Reconcile(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetInvariantsCmd runs the erc20 module invariants
+func GetInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Runs the erc20 module invariants",
+		Long:  "Runs the erc20 module invariants, which check that the converted supply of each token pair is backed by the balance escrowed by the module account, and returns the broken ones.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(context.Background(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFilteredTokenPairsCmd queries the registered token pairs matching the
+// given filters
+func GetFilteredTokenPairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filtered-token-pairs",
+		Short: "Gets registered token pairs matching the given filters",
+		Long:  "Gets registered token pairs filtered by owner (module|external), status (enabled|disabled) and contract type (native-precompile|dynamic-precompile|deployed). Omitted filters match every token pair.",
+		Example: fmt.Sprintf(
+			"%s query %s filtered-token-pairs --%s=external --%s=enabled --%s=deployed",
+			version.AppName, types.ModuleName, flagOwner, flagStatus, flagContractType,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFilteredTokenPairsRequest{
+				Pagination: pageReq,
+			}
+
+			if owner, _ := cmd.Flags().GetString(flagOwner); owner != "" {
+				var ok bool
+				if req.ContractOwner, ok = owners[owner]; !ok {
+					return fmt.Errorf("invalid owner %s, expected module or external", owner)
+				}
+			}
+
+			if status, _ := cmd.Flags().GetString(flagStatus); status != "" {
+				var ok bool
+				if req.Status, ok = statuses[status]; !ok {
+					return fmt.Errorf("invalid status %s, expected enabled or disabled", status)
+				}
+			}
+
+			if contractType, _ := cmd.Flags().GetString(flagContractType); contractType != "" {
+				var ok bool
+				if req.ContractType, ok = contractTypes[contractType]; !ok {
+					return fmt.Errorf(
+						"invalid contract type %s, expected native-precompile, dynamic-precompile or deployed", contractType,
+					)
+				}
+			}
+
+			res, err := queryClient.FilteredTokenPairs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagOwner, "", "filter by contract owner (module|external)")
+	cmd.Flags().String(flagStatus, "", "filter by conversion status (enabled|disabled)")
+	cmd.Flags().String(flagContractType, "", "filter by contract type (native-precompile|dynamic-precompile|deployed)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "filtered-token-pairs")
+	return cmd
+}
+
+// GetConversionStatsCmd queries the cumulative conversion volume of a token
+// pair, or of all token pairs if no token is given
+func GetConversionStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-stats [TOKEN]",
+		Short: "Gets the cumulative conversion volume of a token pair or of all token pairs",
+		Long:  "Gets the cumulative conversion volume of the token pair with the given ERC20 contract address or Cosmos denomination. Returns the volumes of all token pairs if no token is given.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				req := &types.QueryConversionStatsRequest{
+					Token: args[0],
+				}
+
+				res, err := queryClient.ConversionStats(context.Background(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllConversionStatsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.AllConversionStats(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "conversion-stats")
+	return cmd
+}
+
+// GetFeeTokensCmd queries the tokens whitelisted to pay Ethereum transaction fees
+func GetFeeTokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tokens",
+		Short: "Gets the tokens whitelisted to pay Ethereum transaction fees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeTokensRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FeeTokens(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee-tokens")
+	return cmd
+}
+
+// GetFeeTokenRateCmd queries the current conversion rate of a fee token
+func GetFeeTokenRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-rate DENOM",
+		Short: "Gets the current conversion rate of a fee token",
+		Long:  "Gets the amount of EVM coin, in its 18 decimals representation, worth one base unit of the fee token.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeTokenRateRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.FeeTokenRate(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,8 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -33,6 +36,12 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewConvertERC20Cmd(),
+		NewCreateDenomCmd(),
+		NewMintCmd(),
+		NewBurnCmd(),
+		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+		NewRegisterERC20Cmd(),
 	)
 	return txCmd
 }
@@ -83,3 +92,188 @@ func NewConvertERC20Cmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewRegisterERC20Cmd returns a CLI command handler for registering an ERC20 contract without a governance proposal
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 CONTRACT_ADDRESS",
+		Short: "Register a token pair for an ERC20 contract by posting the registration deposit. The sender must hold a balance of the token.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPermissionlessRegisterERC20{
+				Sender:       cliCtx.GetFromAddress().String(),
+				Erc20Address: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCreateDenomCmd returns a CLI command handler for creating a token factory denomination
+func NewCreateDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom SUBDENOM NAME SYMBOL DECIMALS",
+		Short: "Create a new token factory denomination factory/{sender}/{subdenom} with its ERC20 extension. The sender becomes the denom admin.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			decimals, err := strconv.ParseUint(args[3], 10, 8)
+			if err != nil {
+				return fmt.Errorf("invalid decimals %s: %w", args[3], err)
+			}
+
+			msg := &types.MsgCreateDenom{
+				Sender:   cliCtx.GetFromAddress().String(),
+				Subdenom: args[0],
+				Name:     args[1],
+				Symbol:   args[2],
+				Decimals: uint32(decimals),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMintCmd returns a CLI command handler for minting tokens of a token factory denomination
+func NewMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint COIN [RECEIVER]",
+		Short: "Mint tokens of a token factory denomination. When the receiver [optional] is omitted, the tokens are minted to the sender.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMint{
+				Sender: cliCtx.GetFromAddress().String(),
+				Amount: coin,
+			}
+			if len(args) == 2 {
+				msg.MintToAddress = args[1]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewBurnCmd returns a CLI command handler for burning tokens of a token factory denomination
+func NewBurnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn COIN",
+		Short: "Burn tokens of a token factory denomination from the sender balance.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBurn{
+				Sender: cliCtx.GetFromAddress().String(),
+				Amount: coin,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewChangeAdminCmd returns a CLI command handler for changing the admin of a token factory denomination
+func NewChangeAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin DENOM [NEW_ADMIN]",
+		Short: "Change the admin of a token factory denomination. When the new admin [optional] is omitted, the admin permissions are renounced.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgChangeAdmin{
+				Sender: cliCtx.GetFromAddress().String(),
+				Denom:  args[0],
+			}
+			if len(args) == 2 {
+				msg.NewAdmin = args[1]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomMetadataCmd returns a CLI command handler for updating the bank metadata
+// of a token factory denomination
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata METADATA_FILE",
+		Short: "Set the bank metadata of a token factory denomination from a JSON file.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := cliCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return fmt.Errorf("invalid metadata file: %w", err)
+			}
+
+			msg := &types.MsgSetDenomMetadata{
+				Sender:   cliCtx.GetFromAddress().String(),
+				Metadata: metadata,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
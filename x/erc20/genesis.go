@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/erc20/types"
@@ -34,12 +35,55 @@ func InitGenesis(
 	for _, pair := range data.TokenPairs {
 		k.SetToken(ctx, pair)
 	}
+
+	for _, deposit := range data.RegistrationDeposits {
+		k.SetRegistrationDeposit(ctx, deposit)
+	}
+
+	for _, deposit := range data.FailedRegistrationDeposits {
+		k.SetFailedRegistrationDeposit(ctx, deposit)
+	}
+
+	for _, stats := range data.ConversionStats {
+		k.SetConversionStats(ctx, stats)
+	}
+
+	for _, feeToken := range data.FeeTokens {
+		k.SetFeeToken(ctx, feeToken)
+	}
+
+	for _, price := range data.FeeTokenPrices {
+		k.SetFeeTokenPrice(ctx, price)
+	}
+
+	// the permit nonces and authorizations are keyed by the ID of their token pair
+	pairIDs := make(map[common.Address][]byte, len(data.TokenPairs))
+	for _, pair := range data.TokenPairs {
+		pairIDs[pair.GetERC20Contract()] = pair.GetID()
+	}
+
+	for _, nonce := range data.PermitNonces {
+		pairID := pairIDs[common.HexToAddress(nonce.Erc20Address)]
+		k.SetPermitNonce(ctx, pairID, common.HexToAddress(nonce.Owner), nonce.Nonce)
+	}
+
+	for _, state := range data.AuthorizationStates {
+		pairID := pairIDs[common.HexToAddress(state.Erc20Address)]
+		k.SetAuthorizationUsed(ctx, pairID, common.HexToAddress(state.Authorizer), common.HexToHash(state.Nonce))
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		TokenPairs: k.GetTokenPairs(ctx),
+		Params:                     k.GetParams(ctx),
+		TokenPairs:                 k.GetTokenPairs(ctx),
+		RegistrationDeposits:       k.GetRegistrationDeposits(ctx),
+		ConversionStats:            k.GetAllConversionStats(ctx),
+		FeeTokens:                  k.GetFeeTokens(ctx),
+		FeeTokenPrices:             k.GetAllFeeTokenPrices(ctx),
+		PermitNonces:               k.GetAllPermitNonces(ctx),
+		AuthorizationStates:        k.GetAllAuthorizationStates(ctx),
+		FailedRegistrationDeposits: k.GetFailedRegistrationDeposits(ctx),
 	}
 }
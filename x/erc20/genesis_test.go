@@ -14,6 +14,7 @@ import (
 	"github.com/cometbft/cometbft/version"
 
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
@@ -171,3 +172,27 @@ func (suite *GenesisTestSuite) TestErc20ExportGenesis() {
 		})
 	}
 }
+
+func (suite *GenesisTestSuite) TestErc20GenesisNoncesRoundTrip() {
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "acoin", types.OWNER_MODULE)
+	owner := utiltx.GenerateAddress()
+	authorizer := utiltx.GenerateAddress()
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), append([]types.TokenPair{pair}, types.DefaultTokenPairs...))
+	genesisState.PermitNonces = []types.PermitNonce{
+		{Erc20Address: pair.Erc20Address, Owner: owner.Hex(), Nonce: 3},
+	}
+	genesisState.AuthorizationStates = []types.AuthorizationState{
+		{Erc20Address: pair.Erc20Address, Authorizer: authorizer.Hex(), Nonce: common.HexToHash("0x01").Hex()},
+	}
+	suite.Require().NoError(genesisState.Validate())
+
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, genesisState)
+
+	suite.Require().Equal(uint64(3), suite.app.Erc20Keeper.GetPermitNonce(suite.ctx, pair.GetID(), owner))
+	suite.Require().True(suite.app.Erc20Keeper.IsAuthorizationUsed(suite.ctx, pair.GetID(), authorizer, common.HexToHash("0x01")))
+
+	genesisExported := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().Equal(genesisState.PermitNonces, genesisExported.PermitNonces)
+	suite.Require().Equal(genesisState.AuthorizationStates, genesisExported.AuthorizationStates)
+}
@@ -4,6 +4,8 @@
 package erc20
 
 import (
+	"reflect"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -15,6 +17,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/evmos/v20/ibc"
 	"github.com/evmos/evmos/v20/x/erc20/keeper"
+	"github.com/evmos/evmos/v20/x/erc20/types"
 )
 
 var _ porttypes.IBCModule = &IBCMiddleware{}
@@ -23,21 +26,35 @@ var _ porttypes.IBCModule = &IBCMiddleware{}
 // the erc20 keeper and the underlying application.
 type IBCMiddleware struct {
 	*ibc.Module
-	keeper keeper.Keeper
+	keeper              keeper.Keeper
+	packetForwardKeeper types.PacketForwardKeeper
 }
 
-// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
-func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+// NewIBCMiddleware creates a new IBCMiddleware given the keepers and underlying application.
+// The packet forward keeper is used to skip the packets forwarded through Evmos,
+// which are refunded to the sender on the source chain. It panics if the packet
+// forward keeper is not set.
+func NewIBCMiddleware(
+	k keeper.Keeper,
+	packetForwardKeeper types.PacketForwardKeeper,
+	app porttypes.IBCModule,
+) IBCMiddleware {
+	// a nil keeper pointer wrapped in the interface is not equal to nil
+	if v := reflect.ValueOf(packetForwardKeeper); !v.IsValid() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		panic("packet forward keeper cannot be nil")
+	}
+
 	return IBCMiddleware{
-		Module: ibc.NewModule(app),
-		keeper: k,
+		Module:              ibc.NewModule(app),
+		keeper:              k,
+		packetForwardKeeper: packetForwardKeeper,
 	}
 }
 
 // OnRecvPacket implements the IBCModule interface.
-// It receives the tokens through the default ICS20 OnRecvPacket callback logic
-// and then automatically converts the Cosmos Coin to their ERC20 token
-// representation.
+// It receives the tokens through the default ICS20 OnRecvPacket callback logic,
+// automatically converts the Cosmos Coin to their ERC20 token representation
+// and then executes the destination contract callback defined in the packet memo.
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
 func (im IBCMiddleware) OnRecvPacket(
@@ -47,17 +64,30 @@ func (im IBCMiddleware) OnRecvPacket(
 ) exported.Acknowledgement {
 	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
 
+	// return if the acknowledgement is asynchronous. This is the case for the
+	// packets routed through the packet forward middleware, where the funds
+	// are forwarded to the next chain instead of being received on Evmos.
+	if ack == nil {
+		return nil
+	}
+
 	// return if the acknowledgement is an error ACK
 	if !ack.Success() {
 		return ack
 	}
 
-	return im.keeper.OnRecvPacket(ctx, packet, ack)
+	ack = im.keeper.OnRecvPacket(ctx, packet, ack)
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.OnRecvPacketCallback(ctx, packet, ack)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
-// It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// It refunds the token transferred, automatically converts the Cosmos Coin to
+// their ERC20 token representation and then notifies the sender contract if a
+// source callback was defined in the packet memo.
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -74,16 +104,32 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
+	// the in-flight state is cleared by the packet forward middleware, so it has
+	// to be checked before the acknowledgement is passed to the underlying app
+	forwarded := im.isForwardedPacket(ctx, packet)
+
 	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
 		return err
 	}
 
-	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+	// the refund of a forwarded packet is routed back to the original sender on
+	// the source chain, so no tokens are refunded on Evmos
+	if forwarded {
+		return nil
+	}
+
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
+		return err
+	}
+
+	im.keeper.OnAcknowledgementPacketCallback(ctx, packet, data, ack)
+	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
-// It refunds the token transferred and then automatically converts the
-// Cosmos Coin to their ERC20 token representation.
+// It refunds the token transferred, automatically converts the Cosmos Coin to
+// their ERC20 token representation and then notifies the sender contract if a
+// source callback was defined in the packet memo.
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -94,9 +140,35 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
 	}
 
+	forwarded := im.isForwardedPacket(ctx, packet)
+
 	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
 		return err
 	}
 
-	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+	if forwarded {
+		return nil
+	}
+
+	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacketCallback(ctx, packet, data)
+	return nil
+}
+
+// isForwardedPacket returns true if the packet was sent by the packet forward
+// middleware on behalf of a sender on another chain. The in-flight packet is
+// looked up on a cached context that is discarded, so that the state is left
+// for the packet forward middleware to handle.
+func (im IBCMiddleware) isForwardedPacket(ctx sdk.Context, packet channeltypes.Packet) bool {
+	cacheCtx, _ := ctx.CacheContext()
+	inFlightPacket := im.packetForwardKeeper.GetAndClearInFlightPacket(
+		cacheCtx,
+		packet.SourceChannel,
+		packet.SourcePort,
+		packet.Sequence,
+	)
+	return inFlightPacket != nil
 }
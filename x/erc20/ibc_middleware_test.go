@@ -0,0 +1,116 @@
+package erc20_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/keeper"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20"
+	"github.com/evmos/evmos/v20/x/erc20/keeper"
+)
+
+// mockPacketForwardKeeper stores the in-flight packets keyed by the source
+// channel, port and sequence, like the packet forward middleware keeper.
+type mockPacketForwardKeeper struct {
+	storeKey storetypes.StoreKey
+}
+
+func (k mockPacketForwardKeeper) GetAndClearInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64) *pfmtypes.InFlightPacket {
+	key := pfmtypes.RefundPacketKey(channel, port, sequence)
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return nil
+	}
+
+	store.Delete(key)
+	return &pfmtypes.InFlightPacket{RefundChannelId: channel, RefundPortId: port, RefundSequence: sequence}
+}
+
+// mockForwardApp mocks the packet forward middleware, which clears the
+// in-flight packets on acknowledgements and timeouts.
+type mockForwardApp struct {
+	porttypes.IBCModule
+	keeper        mockPacketForwardKeeper
+	foundInFlight bool
+}
+
+func (app *mockForwardApp) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	app.foundInFlight = app.keeper.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence) != nil
+	return nil
+}
+
+func (app *mockForwardApp) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	app.foundInFlight = app.keeper.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence) != nil
+	return nil
+}
+
+func TestIBCMiddlewareForwardedPacket(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData(
+		"aevmos", "10", sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(), "receiver", "",
+	)
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.ZeroHeight(), 100,
+	)
+	errAck := channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+
+	testCases := []struct {
+		name     string
+		callback func(im erc20.IBCMiddleware, ctx sdk.Context) error
+	}{
+		{
+			"acknowledgement",
+			func(im erc20.IBCMiddleware, ctx sdk.Context) error {
+				return im.OnAcknowledgementPacket(ctx, packet, errAck, nil)
+			},
+		},
+		{
+			"timeout",
+			func(im erc20.IBCMiddleware, ctx sdk.Context) error {
+				return im.OnTimeoutPacket(ctx, packet, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			storeKey := storetypes.NewKVStoreKey(pfmtypes.StoreKey)
+			ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+			pfmKeeper := mockPacketForwardKeeper{storeKey: storeKey}
+			ctx.KVStore(storeKey).Set(pfmtypes.RefundPacketKey(packet.SourceChannel, packet.SourcePort, packet.Sequence), []byte{1})
+
+			// the erc20 keeper is not set, so the test panics if the refund of
+			// the forwarded packet is handled on Evmos
+			app := &mockForwardApp{keeper: pfmKeeper}
+			im := erc20.NewIBCMiddleware(keeper.Keeper{}, pfmKeeper, app)
+
+			require.NoError(t, tc.callback(im, ctx))
+			// the in-flight packet is left for the packet forward middleware
+			require.True(t, app.foundInFlight)
+		})
+	}
+}
+
+func TestNewIBCMiddlewarePacketForwardKeeper(t *testing.T) {
+	app := &mockForwardApp{}
+
+	require.Panics(t, func() {
+		erc20.NewIBCMiddleware(keeper.Keeper{}, nil, app)
+	})
+	require.Panics(t, func() {
+		erc20.NewIBCMiddleware(keeper.Keeper{}, (*packetforwardkeeper.Keeper)(nil), app)
+	})
+	require.NotPanics(t, func() {
+		erc20.NewIBCMiddleware(keeper.Keeper{}, mockPacketForwardKeeper{}, app)
+	})
+}
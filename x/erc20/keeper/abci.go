@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock releases the permissionless registration deposits whose deposit
+// period ended.
+func (k Keeper) EndBlock(ctx sdk.Context) error {
+	k.ReleaseRegistrationDeposits(ctx)
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/ibc"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// OnRecvPacketCallback executes the destination callback defined on the ICS20
+// packet memo, if any. The callback contract MUST be the receiver of the
+// transferred funds, so that the contract call can use them.
+//
+// The contract is called from an address derived from the destination channel
+// and the original sender (see types.GenerateCallbackSender). If the callback
+// fails, an error acknowledgement is returned so that the whole packet receipt
+// is reverted and the funds are refunded on the source chain.
+func (k Keeper) OnRecvPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: shouldn't happen as the packet has already
+		// been decoded on ICS20 transfer logic
+		err = errorsmod.Wrapf(errortypes.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	memo, err := types.ParseCallbackMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// no-op if there is no callback to execute
+	if memo.DestCallback == nil {
+		return ack
+	}
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	contract := memo.DestCallback.ContractAddress()
+	if common.BytesToAddress(recipient) != contract {
+		err = errorsmod.Wrapf(
+			types.ErrInvalidCallback,
+			"callback contract %s is not the packet receiver %s", contract, data.Receiver,
+		)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	from := types.GenerateCallbackSender(packet.DestinationChannel, data.Sender)
+	if _, err := k.callContractWithGasLimit(
+		ctx, from, contract, memo.DestCallback.Input(), memo.DestCallback.GetGasLimit(),
+	); err != nil {
+		k.Logger(ctx).Error(
+			"failed to execute destination callback",
+			"contract", contract.String(),
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	emitCallbackEvent(ctx, types.CallbackTypeReceive, contract, packet.Sequence, nil)
+
+	return ack
+}
+
+// OnAcknowledgementPacketCallback executes the source callback defined on the
+// ICS20 packet memo, if any, by calling the `onAck` method of the contract that
+// sent the packet.
+func (k Keeper) OnAcknowledgementPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) {
+	callback, found := k.getSourceCallback(ctx, data)
+	if !found {
+		return
+	}
+
+	input, err := types.CallbacksABI.Pack(
+		types.OnAckCallbackMethod,
+		packet.SourcePort,
+		packet.SourceChannel,
+		packet.Sequence,
+		ack.Success(),
+		ack.Acknowledgement(),
+	)
+	if err != nil {
+		k.Logger(ctx).Error("failed to pack acknowledgement callback", "error", err.Error())
+		return
+	}
+
+	k.executeSourceCallback(ctx, types.CallbackTypeAcknowledgement, packet, callback, input)
+}
+
+// OnTimeoutPacketCallback executes the source callback defined on the ICS20
+// packet memo, if any, by calling the `onTimeout` method of the contract that
+// sent the packet.
+func (k Keeper) OnTimeoutPacketCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) {
+	callback, found := k.getSourceCallback(ctx, data)
+	if !found {
+		return
+	}
+
+	input, err := types.CallbacksABI.Pack(
+		types.OnTimeoutCallbackMethod,
+		packet.SourcePort,
+		packet.SourceChannel,
+		packet.Sequence,
+	)
+	if err != nil {
+		k.Logger(ctx).Error("failed to pack timeout callback", "error", err.Error())
+		return
+	}
+
+	k.executeSourceCallback(ctx, types.CallbackTypeTimeout, packet, callback, input)
+}
+
+// getSourceCallback returns the source callback of the packet. The callback is
+// only returned if the callback contract is the sender of the packet, which
+// prevents arbitrary contracts from being notified about other packets.
+func (k Keeper) getSourceCallback(
+	ctx sdk.Context,
+	data transfertypes.FungibleTokenPacketData,
+) (types.CallbackData, bool) {
+	memo, err := types.ParseCallbackMemo(data.Memo)
+	if err != nil || memo.SrcCallback == nil {
+		return types.CallbackData{}, false
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return types.CallbackData{}, false
+	}
+
+	if common.BytesToAddress(sender) != memo.SrcCallback.ContractAddress() {
+		k.Logger(ctx).Debug(
+			"skipping source callback, contract is not the packet sender",
+			"contract", memo.SrcCallback.Address,
+			"sender", data.Sender,
+		)
+		return types.CallbackData{}, false
+	}
+
+	return *memo.SrcCallback, true
+}
+
+// executeSourceCallback calls the callback contract from the ICS20 precompile
+// address. A failed callback does not return an error, since the packet
+// lifecycle on the source chain must be completed regardless of the contract
+// logic. Any state changes of a failed callback are discarded.
+func (k Keeper) executeSourceCallback(
+	ctx sdk.Context,
+	callbackType string,
+	packet channeltypes.Packet,
+	callback types.CallbackData,
+	input []byte,
+) {
+	contract := callback.ContractAddress()
+	from := common.HexToAddress(evmtypes.ICS20PrecompileAddress)
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if _, err := k.callContractWithGasLimit(cacheCtx, from, contract, input, callback.GetGasLimit()); err != nil {
+		k.Logger(ctx).Error(
+			"failed to execute source callback",
+			"type", callbackType,
+			"contract", contract.String(),
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		emitCallbackEvent(ctx, callbackType, contract, packet.Sequence, err)
+		return
+	}
+
+	writeFn()
+	emitCallbackEvent(ctx, callbackType, contract, packet.Sequence, nil)
+}
+
+// callContractWithGasLimit calls the contract with the given input and gas
+// limit. The call runs with its own gas meter limited to the callback gas limit,
+// so that running out of gas fails the callback instead of aborting the packet
+// processing. The gas used by the callback is then consumed from the context gas
+// meter, up to the gas remaining on it.
+func (k Keeper) callContractWithGasLimit(
+	ctx sdk.Context,
+	from, contract common.Address,
+	input []byte,
+	gasLimit uint64,
+) (res *evmtypes.MsgEthereumTxResponse, err error) {
+	callbackCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res = nil
+			err = errorsmod.Wrapf(types.ErrCallbackFailed, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+
+		gasUsed := callbackCtx.GasMeter().GasConsumedToLimit()
+		ctx.GasMeter().ConsumeGas(min(gasUsed, ctx.GasMeter().GasRemaining()), "erc20 ibc callback")
+	}()
+
+	account := k.evmKeeper.GetAccountWithoutBalance(callbackCtx, contract)
+	if account == nil || !account.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCallback, "address %s is not a contract", contract)
+	}
+
+	nonce := uint64(0)
+	if fromAccount := k.evmKeeper.GetAccountWithoutBalance(callbackCtx, from); fromAccount != nil {
+		nonce = fromAccount.Nonce
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		input,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err = k.evmKeeper.ApplyMessage(callbackCtx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrCallbackFailed, err.Error())
+	}
+
+	// the EVM tracks its gas separately from the gas meter, so the gas used by
+	// the execution is consumed from the callback gas meter
+	callbackCtx.GasMeter().ConsumeGas(res.GasUsed, "erc20 ibc callback")
+
+	if res.Failed() {
+		return nil, errorsmod.Wrap(types.ErrCallbackFailed, res.VmError)
+	}
+
+	return res, nil
+}
+
+// emitCallbackEvent emits an event with the result of a contract callback.
+func emitCallbackEvent(ctx sdk.Context, callbackType string, contract common.Address, sequence uint64, err error) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCCallback, attrs...))
+}
@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketCallback() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		memo     string
+		receiver string
+	)
+
+	sender := "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+
+	transferInput := func() string {
+		input, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", utiltx.GenerateAddress(), big.NewInt(0))
+		suite.Require().NoError(err)
+		return hexutil.Encode(input)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		ackSuccess bool
+		expEvent   bool
+	}{
+		{
+			"no-op - empty memo",
+			func() {
+				memo = ""
+			},
+			true,
+			false,
+		},
+		{
+			"no-op - memo is not a callback",
+			func() {
+				memo = `{"forward": {"receiver": "cosmos1"}}`
+			},
+			true,
+			false,
+		},
+		{
+			"fail - invalid callback address",
+			func() {
+				memo = `{"dest_callback": {"address": "invalid"}}`
+			},
+			false,
+			false,
+		},
+		{
+			"fail - callback contract is not the receiver",
+			func() {
+				receiver = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+				memo = fmt.Sprintf(`{"dest_callback": {"address": "%s", "calldata": "%s"}}`, contract.Hex(), transferInput())
+			},
+			false,
+			false,
+		},
+		{
+			"fail - callback address is not a contract",
+			func() {
+				addr := utiltx.GenerateAddress()
+				receiver = sdk.AccAddress(addr.Bytes()).String()
+				memo = fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, addr.Hex())
+			},
+			false,
+			false,
+		},
+		{
+			"fail - contract call reverts",
+			func() {
+				// the callback sender doesn't have the minter role
+				input, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("mint", utiltx.GenerateAddress(), big.NewInt(100))
+				suite.Require().NoError(err)
+				memo = fmt.Sprintf(`{"dest_callback": {"address": "%s", "calldata": "%s"}}`, contract.Hex(), hexutil.Encode(input))
+			},
+			false,
+			false,
+		},
+		{
+			"fail - out of gas",
+			func() {
+				memo = fmt.Sprintf(`{"dest_callback": {"address": "%s", "calldata": "%s", "gas_limit": "21500"}}`, contract.Hex(), transferInput())
+			},
+			false,
+			false,
+		},
+		{
+			"pass - callback executed",
+			func() {
+				memo = fmt.Sprintf(`{"dest_callback": {"address": "%s", "calldata": "%s"}}`, contract.Hex(), transferInput())
+			},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			contract, err = suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			receiver = sdk.AccAddress(contract.Bytes()).String()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			transfer := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, receiver, memo)
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", timeoutHeight, 0)
+			ack := channeltypes.NewResultAcknowledgement([]byte{1})
+
+			res := suite.network.App.Erc20Keeper.OnRecvPacketCallback(ctx, packet, ack)
+			suite.Require().Equal(tc.ackSuccess, res.Success())
+			suite.Require().Equal(tc.expEvent, utils.ContainsEventType(ctx.EventManager().ABCIEvents(), types.EventTypeIBCCallback))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSourceCallbacks() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		sender   string
+		memo     string
+		gasMeter storetypes.GasMeter
+	)
+
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expEvent bool
+	}{
+		{
+			"no-op - no source callback",
+			func() {
+				memo = ""
+			},
+			false,
+		},
+		{
+			"no-op - callback contract is not the sender",
+			func() {
+				sender = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+			},
+			false,
+		},
+		{
+			"failed callback - contract does not implement the callbacks",
+			func() {},
+			true,
+		},
+		{
+			"failed callback - callback runs out of gas",
+			func() {
+				memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "100"}}`, contract.Hex())
+			},
+			true,
+		},
+		{
+			"failed callback - packet gas meter runs out of gas",
+			func() {
+				gasMeter = storetypes.NewGasMeter(1_000)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			contract, err = suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			sender = sdk.AccAddress(contract.Bytes()).String()
+			memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "100000"}}`, contract.Hex())
+			gasMeter = storetypes.NewInfiniteGasMeter()
+
+			tc.malleate()
+
+			data := transfertypes.NewFungibleTokenPacketData("aevmos", "100", sender, "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc", memo)
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&data)
+			packet := channeltypes.NewPacket(bz, 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", timeoutHeight, 0)
+
+			ctx = suite.network.GetContext().WithGasMeter(gasMeter)
+			suite.network.App.Erc20Keeper.OnAcknowledgementPacketCallback(ctx, packet, data, channeltypes.NewResultAcknowledgement([]byte{1}))
+			suite.Require().Equal(tc.expEvent, utils.ContainsEventType(ctx.EventManager().ABCIEvents(), types.EventTypeIBCCallback))
+
+			ctx = suite.network.GetContext().WithGasMeter(gasMeter)
+			suite.network.App.Erc20Keeper.OnTimeoutPacketCallback(ctx, packet, data)
+			suite.Require().Equal(tc.expEvent, utils.ContainsEventType(ctx.EventManager().ABCIEvents(), types.EventTypeIBCCallback))
+		})
+	}
+}
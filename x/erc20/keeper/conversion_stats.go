@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// GetConversionStats returns the cumulative conversion volume of the token
+// pair with the given denom. Empty stats are returned if no conversion was
+// recorded yet.
+func (k Keeper) GetConversionStats(ctx sdk.Context, denom string) types.ConversionStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionStats)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.NewConversionStats(denom)
+	}
+
+	var stats types.ConversionStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// GetAllConversionStats returns the recorded conversion volumes of all token
+// pairs.
+func (k Keeper) GetAllConversionStats(ctx sdk.Context) []types.ConversionStats {
+	allStats := []types.ConversionStats{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionStats)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ConversionStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		allStats = append(allStats, stats)
+	}
+
+	return allStats
+}
+
+// SetConversionStats stores the conversion volume of a token pair
+func (k Keeper) SetConversionStats(ctx sdk.Context, stats types.ConversionStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionStats)
+	store.Set([]byte(stats.Denom), k.cdc.MustMarshal(&stats))
+}
+
+// DeleteConversionStats removes the conversion volume of a token pair
+func (k Keeper) DeleteConversionStats(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionStats)
+	store.Delete([]byte(denom))
+}
+
+// trackConversion adds the converted amount to the conversion volume of the
+// token pair with the given denom.
+func (k Keeper) trackConversion(ctx sdk.Context, denom string, amount math.Int, toCoin bool) {
+	stats := k.GetConversionStats(ctx, denom)
+	if toCoin {
+		stats.Erc20ToCoin = stats.Erc20ToCoin.Add(amount)
+	} else {
+		stats.CoinToErc20 = stats.CoinToErc20.Add(amount)
+	}
+	stats.Conversions++
+	k.SetConversionStats(ctx, stats)
+}
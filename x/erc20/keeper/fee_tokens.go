@@ -0,0 +1,213 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// GetFeeToken returns the whitelisted fee token with the given denom
+func (k Keeper) GetFeeToken(ctx sdk.Context, denom string) (types.FeeToken, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.FeeToken{}, false
+	}
+
+	var feeToken types.FeeToken
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return feeToken, true
+}
+
+// GetFeeTokens returns all the whitelisted fee tokens, sorted by denom
+func (k Keeper) GetFeeTokens(ctx sdk.Context) []types.FeeToken {
+	feeTokens := []types.FeeToken{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var feeToken types.FeeToken
+		k.cdc.MustUnmarshal(iterator.Value(), &feeToken)
+		feeTokens = append(feeTokens, feeToken)
+	}
+
+	return feeTokens
+}
+
+// SetFeeToken stores a whitelisted fee token
+func (k Keeper) SetFeeToken(ctx sdk.Context, feeToken types.FeeToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	store.Set([]byte(feeToken.Denom), k.cdc.MustMarshal(&feeToken))
+}
+
+// DeleteFeeToken removes a fee token from the whitelist together with its
+// recorded prices
+func (k Keeper) DeleteFeeToken(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	store.Delete([]byte(denom))
+
+	priceStore := k.feeTokenPriceStore(ctx, denom)
+	iterator := priceStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		priceStore.Delete(key)
+	}
+}
+
+// GetFeeTokenPrices returns the prices recorded for a fee token, sorted by time
+func (k Keeper) GetFeeTokenPrices(ctx sdk.Context, denom string) []types.FeeTokenPrice {
+	prices := []types.FeeTokenPrice{}
+
+	iterator := k.feeTokenPriceStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.FeeTokenPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// GetAllFeeTokenPrices returns the prices recorded for all fee tokens
+func (k Keeper) GetAllFeeTokenPrices(ctx sdk.Context) []types.FeeTokenPrice {
+	prices := []types.FeeTokenPrice{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPrice)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.FeeTokenPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// SetFeeTokenPrice records a price of a fee token. A price recorded at the
+// same time as an existing one replaces it.
+func (k Keeper) SetFeeTokenPrice(ctx sdk.Context, price types.FeeTokenPrice) {
+	store := k.feeTokenPriceStore(ctx, price.Denom)
+	store.Set(sdk.FormatTimeBytes(price.Time), k.cdc.MustMarshal(&price))
+}
+
+// GetFeeTokenRate returns the amount of EVM coin, in its 18 decimals
+// representation, worth one base unit of the fee token. The token pair of the
+// fee token must be enabled.
+func (k Keeper) GetFeeTokenRate(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	feeToken, found := k.GetFeeToken(ctx, denom)
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom '%s'", denom)
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, denom))
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "denom '%s'", denom)
+	}
+
+	if !pair.Enabled {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrERC20TokenPairDisabled, "denom '%s'", denom)
+	}
+
+	switch feeToken.RateSource {
+	case types.FEE_TOKEN_RATE_SOURCE_FIXED:
+		return feeToken.FixedRate, nil
+	case types.FEE_TOKEN_RATE_SOURCE_TWAP:
+		rate, err := types.TWAP(k.GetFeeTokenPrices(ctx, denom), ctx.BlockTime(), feeToken.TwapWindow)
+		if err != nil {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrFeeTokenPriceNotFound, "denom '%s': %s", denom, err)
+		}
+		return rate, nil
+	default:
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "invalid rate source %s", feeToken.RateSource)
+	}
+}
+
+// setFeeToken whitelists a fee token after checking that it is the
+// denomination of a registered token pair other than the EVM coin.
+func (k Keeper) setFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
+	if feeToken.Denom == evmtypes.GetEVMCoinDenom() {
+		return errorsmod.Wrapf(types.ErrInvalidFeeToken, "fees are already paid in the EVM coin %s", feeToken.Denom)
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, feeToken.Denom))
+	if !found || pair.Denom != feeToken.Denom {
+		return errorsmod.Wrapf(types.ErrTokenPairNotFound, "denom '%s'", feeToken.Denom)
+	}
+
+	k.SetFeeToken(ctx, feeToken)
+	k.pruneFeeTokenPrices(ctx, feeToken)
+	return nil
+}
+
+// recordFeeTokenPrice records a price of a whitelisted fee token at the
+// current block time.
+func (k Keeper) recordFeeTokenPrice(ctx sdk.Context, denom string, price math.LegacyDec) error {
+	feeToken, found := k.GetFeeToken(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom '%s'", denom)
+	}
+
+	k.SetFeeTokenPrice(ctx, types.NewFeeTokenPrice(denom, price, ctx.BlockTime()))
+	k.pruneFeeTokenPrices(ctx, feeToken)
+	return nil
+}
+
+// pruneFeeTokenPrices deletes the prices of a fee token that are no longer
+// needed to compute its TWAP. The last price recorded before the window is
+// kept, since it is the price at the window start.
+func (k Keeper) pruneFeeTokenPrices(ctx sdk.Context, feeToken types.FeeToken) {
+	var window time.Duration
+	if feeToken.RateSource == types.FEE_TOKEN_RATE_SOURCE_TWAP {
+		window = feeToken.TwapWindow
+	}
+	start := sdk.FormatTimeBytes(ctx.BlockTime().Add(-window))
+
+	store := k.feeTokenPriceStore(ctx, feeToken.Denom)
+	iterator := store.ReverseIterator(nil, start)
+	defer iterator.Close()
+
+	// skip the last price recorded before the window start
+	if !iterator.Valid() {
+		return
+	}
+	iterator.Next()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// feeTokenPriceStore returns the prefix store of the prices of a fee token
+func (k Keeper) feeTokenPriceStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixFeeTokenPrice, address.MustLengthPrefix([]byte(denom))...),
+	)
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterFeeToken() {
+	var (
+		ctx      sdk.Context
+		feeToken types.FeeToken
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				feeToken = types.NewFixedRateFeeToken("unregistered", math.LegacyOneDec())
+			},
+			false,
+		},
+		{
+			"fail - EVM coin",
+			func() {
+				feeToken = types.NewFixedRateFeeToken(evmtypes.GetEVMCoinDenom(), math.LegacyOneDec())
+			},
+			false,
+		},
+		{
+			"pass - fixed rate",
+			func() {
+				pair := suite.createFeeTokenPair(ctx)
+				feeToken = types.NewFixedRateFeeToken(pair.Denom, math.LegacyNewDec(2))
+			},
+			true,
+		},
+		{
+			"pass - TWAP",
+			func() {
+				pair := suite.createFeeTokenPair(ctx)
+				feeToken = types.NewTWAPFeeToken(pair.Denom, time.Hour)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			_, err := suite.network.App.Erc20Keeper.RegisterFeeToken(ctx, &types.MsgRegisterFeeToken{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				FeeToken:  feeToken,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stored, found := suite.network.App.Erc20Keeper.GetFeeToken(ctx, feeToken.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(feeToken, stored)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeTokenRate() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	erc20Keeper := suite.network.App.Erc20Keeper
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	fixed := suite.createFeeTokenPair(ctx)
+	_, err := erc20Keeper.RegisterFeeToken(ctx, &types.MsgRegisterFeeToken{
+		Authority: authority,
+		FeeToken:  types.NewFixedRateFeeToken(fixed.Denom, math.LegacyNewDec(2)),
+	})
+	suite.Require().NoError(err)
+
+	rate, err := erc20Keeper.GetFeeTokenRate(ctx, fixed.Denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(2), rate)
+
+	twap := suite.createFeeTokenPair(ctx)
+	_, err = erc20Keeper.RegisterFeeToken(ctx, &types.MsgRegisterFeeToken{
+		Authority: authority,
+		FeeToken:  types.NewTWAPFeeToken(twap.Denom, 20*time.Second),
+	})
+	suite.Require().NoError(err)
+
+	// no price recorded yet
+	_, err = erc20Keeper.GetFeeTokenRate(ctx, twap.Denom)
+	suite.Require().ErrorIs(err, types.ErrFeeTokenPriceNotFound)
+
+	start := ctx.BlockTime()
+	for i, price := range []int64{2, 4} {
+		priceCtx := ctx.WithBlockTime(start.Add(time.Duration(i) * 10 * time.Second))
+		_, err = erc20Keeper.UpdateFeeTokenPrice(priceCtx, &types.MsgUpdateFeeTokenPrice{
+			Authority: authority,
+			Denom:     twap.Denom,
+			Price:     math.LegacyNewDec(price),
+		})
+		suite.Require().NoError(err)
+	}
+
+	rate, err = erc20Keeper.GetFeeTokenRate(ctx.WithBlockTime(start.Add(20*time.Second)), twap.Denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(3), rate)
+
+	res, err := erc20Keeper.FeeTokenRate(ctx.WithBlockTime(start.Add(20*time.Second)), &types.QueryFeeTokenRateRequest{Denom: twap.Denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(3), res.Rate)
+
+	// the prices outside the window are pruned, except for the price at the window start
+	_, err = erc20Keeper.UpdateFeeTokenPrice(ctx.WithBlockTime(start.Add(time.Minute)), &types.MsgUpdateFeeTokenPrice{
+		Authority: authority,
+		Denom:     twap.Denom,
+		Price:     math.LegacyNewDec(6),
+	})
+	suite.Require().NoError(err)
+
+	prices := erc20Keeper.GetFeeTokenPrices(ctx, twap.Denom)
+	suite.Require().Len(prices, 2)
+	suite.Require().Equal(math.LegacyNewDec(4), prices[0].Price)
+
+	// the rate is unavailable when the token pair is disabled
+	suite.toggleConversion(ctx, fixed.Denom)
+	_, err = erc20Keeper.GetFeeTokenRate(ctx, fixed.Denom)
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+
+	allRes, err := erc20Keeper.FeeTokens(ctx, &types.QueryFeeTokensRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.FeeTokens, 2)
+}
+
+func (suite *KeeperTestSuite) TestRemoveFeeToken() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	erc20Keeper := suite.network.App.Erc20Keeper
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	_, err := erc20Keeper.RemoveFeeToken(ctx, &types.MsgRemoveFeeToken{
+		Authority: authority,
+		Denom:     "unregistered",
+	})
+	suite.Require().ErrorIs(err, types.ErrFeeTokenNotFound)
+
+	pair := suite.createFeeTokenPair(ctx)
+	_, err = erc20Keeper.RegisterFeeToken(ctx, &types.MsgRegisterFeeToken{
+		Authority: authority,
+		FeeToken:  types.NewTWAPFeeToken(pair.Denom, time.Hour),
+	})
+	suite.Require().NoError(err)
+	_, err = erc20Keeper.UpdateFeeTokenPrice(ctx, &types.MsgUpdateFeeTokenPrice{
+		Authority: authority,
+		Denom:     pair.Denom,
+		Price:     math.LegacyOneDec(),
+	})
+	suite.Require().NoError(err)
+
+	_, err = erc20Keeper.RemoveFeeToken(ctx, &types.MsgRemoveFeeToken{
+		Authority: authority,
+		Denom:     pair.Denom,
+	})
+	suite.Require().NoError(err)
+
+	_, found := erc20Keeper.GetFeeToken(ctx, pair.Denom)
+	suite.Require().False(found)
+	suite.Require().Empty(erc20Keeper.GetFeeTokenPrices(ctx, pair.Denom))
+
+	_, err = erc20Keeper.UpdateFeeTokenPrice(ctx, &types.MsgUpdateFeeTokenPrice{
+		Authority: authority,
+		Denom:     pair.Denom,
+		Price:     math.LegacyOneDec(),
+	})
+	suite.Require().ErrorIs(err, types.ErrFeeTokenNotFound)
+}
+
+// createFeeTokenPair creates a token factory denom with an enabled token pair
+// that can be whitelisted as fee token.
+func (suite *KeeperTestSuite) createFeeTokenPair(ctx sdk.Context) types.TokenPair {
+	subdenom := "token" + string(rune('a'+len(suite.network.App.Erc20Keeper.GetTokenPairs(ctx))))
+	pair, err := suite.network.App.Erc20Keeper.CreateFactoryDenom(
+		ctx, suite.keyring.GetAccAddr(0), subdenom, "Token", "TKN", 18,
+	)
+	suite.Require().NoError(err)
+	return pair
+}
@@ -86,3 +86,201 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Reconcile returns the escrow and supply comparison of the registered token
+// pairs
+func (k Keeper) Reconcile(c context.Context, req *types.QueryReconcileRequest) (*types.QueryReconcileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var reconciliations []types.PairReconciliation
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		reconciliations = append(reconciliations, k.ReconcileTokenPair(ctx, pair))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryReconcileResponse{
+		Reconciliations: reconciliations,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// Invariants runs the erc20 module invariants and returns the broken ones.
+// The app does not include the crisis module, so this query is used to check
+// the invariants of a running chain.
+func (k Keeper) Invariants(c context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryInvariantsResponse{}
+	for _, invariant := range []sdk.Invariant{ExternalEscrowInvariant(k), NativeEscrowInvariant(k)} {
+		if msg, broken := invariant(ctx); broken {
+			res.Broken = true
+			res.Message += msg
+		}
+	}
+	return res, nil
+}
+
+// FilteredTokenPairs returns the registered pairs matching the given filters
+func (k Keeper) FilteredTokenPairs(c context.Context, req *types.QueryFilteredTokenPairsRequest) (*types.QueryFilteredTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	var pairs []types.TokenPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return false, err
+		}
+
+		if !matchTokenPair(pair, params, req) {
+			return false, nil
+		}
+
+		if accumulate {
+			pairs = append(pairs, pair)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFilteredTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ConversionStats returns the cumulative conversion volume of a given
+// registered token pair
+func (k Keeper) ConversionStats(c context.Context, req *types.QueryConversionStatsRequest) (*types.QueryConversionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	res, err := k.TokenPair(c, &types.QueryTokenPairRequest{Token: req.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stats := k.GetConversionStats(ctx, res.TokenPair.Denom)
+
+	return &types.QueryConversionStatsResponse{ConversionStats: stats}, nil
+}
+
+// AllConversionStats returns the recorded conversion volumes of all token
+// pairs
+func (k Keeper) AllConversionStats(c context.Context, req *types.QueryAllConversionStatsRequest) (*types.QueryAllConversionStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var allStats []types.ConversionStats
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionStats)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var stats types.ConversionStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+		allStats = append(allStats, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllConversionStatsResponse{
+		ConversionStats: allStats,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// FeeTokens returns the tokens whitelisted to pay Ethereum transaction fees
+func (k Keeper) FeeTokens(c context.Context, req *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var feeTokens []types.FeeToken
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var feeToken types.FeeToken
+		if err := k.cdc.Unmarshal(value, &feeToken); err != nil {
+			return err
+		}
+		feeTokens = append(feeTokens, feeToken)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFeeTokensResponse{
+		FeeTokens:  feeTokens,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeeTokenRate returns the current conversion rate of a fee token
+func (k Keeper) FeeTokenRate(c context.Context, req *types.QueryFeeTokenRateRequest) (*types.QueryFeeTokenRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rate, err := k.GetFeeTokenRate(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeeTokenRateResponse{Rate: rate}, nil
+}
+
+// matchTokenPair returns true if the token pair matches all the filters of the
+// request
+func matchTokenPair(pair types.TokenPair, params types.Params, req *types.QueryFilteredTokenPairsRequest) bool {
+	if req.ContractOwner != types.OWNER_UNSPECIFIED && pair.ContractOwner != req.ContractOwner {
+		return false
+	}
+
+	switch req.Status {
+	case types.TOKEN_PAIR_STATUS_ENABLED:
+		if !pair.Enabled {
+			return false
+		}
+	case types.TOKEN_PAIR_STATUS_DISABLED:
+		if pair.Enabled {
+			return false
+		}
+	}
+
+	if req.ContractType == types.CONTRACT_TYPE_UNSPECIFIED {
+		return true
+	}
+	return params.GetContractType(pair.GetERC20Contract()) == req.ContractType
+}
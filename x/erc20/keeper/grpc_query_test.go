@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -178,3 +180,165 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestReconcile() {
+	suite.SetupTest()
+
+	contract, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+	_, err = suite.MintERC20Token(contract, suite.keyring.GetAddr(0), big.NewInt(100))
+	suite.Require().NoError(err)
+	// tokens sent to the module account directly are escrowed without supply
+	_, err = suite.MintERC20Token(contract, types.ModuleAddress, big.NewInt(10))
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	suite.convertERC20(ctx, contract, big.NewInt(60))
+
+	_, err = suite.network.App.Erc20Keeper.Reconcile(ctx, nil)
+	suite.Require().Error(err)
+
+	res, err := suite.network.App.Erc20Keeper.Reconcile(ctx, &types.QueryReconcileRequest{
+		Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Reconciliations, len(types.DefaultTokenPairs)+1)
+	suite.Require().Equal(uint64(len(types.DefaultTokenPairs)+1), res.Pagination.Total)
+
+	for _, rec := range res.Reconciliations {
+		if rec.TokenPair.Erc20Address != contract.Hex() {
+			// default pairs are represented by precompiles, which report
+			// the bank supply of the coin
+			bankSupply := suite.network.App.BankKeeper.GetSupply(ctx, rec.TokenPair.Denom).Amount
+			suite.Require().Equal(bankSupply, rec.Escrow)
+			suite.Require().Equal(bankSupply, rec.Supply)
+			suite.Require().True(rec.Difference.IsZero())
+			continue
+		}
+		suite.Require().Equal(math.NewInt(70), rec.Escrow)
+		suite.Require().Equal(math.NewInt(60), rec.Supply)
+		suite.Require().Equal(math.NewInt(10), rec.Difference)
+	}
+}
+
+func (suite *KeeperTestSuite) TestFilteredTokenPairs() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	external := types.NewTokenPair(utiltx.GenerateAddress(), "external", types.OWNER_EXTERNAL)
+	disabled := types.NewTokenPair(utiltx.GenerateAddress(), "disabled", types.OWNER_EXTERNAL)
+	disabled.Enabled = false
+	dynamic := types.NewTokenPair(utiltx.GenerateAddress(), "dynamic", types.OWNER_MODULE)
+	for _, pair := range []types.TokenPair{external, disabled, dynamic} {
+		suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+	}
+	suite.Require().NoError(suite.network.App.Erc20Keeper.EnableDynamicPrecompiles(ctx, dynamic.GetERC20Contract()))
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryFilteredTokenPairsRequest
+		expPairs []string
+	}{
+		{
+			"no filters",
+			&types.QueryFilteredTokenPairsRequest{},
+			[]string{types.DefaultTokenPairs[0].Denom, external.Denom, disabled.Denom, dynamic.Denom},
+		},
+		{
+			"external owner",
+			&types.QueryFilteredTokenPairsRequest{ContractOwner: types.OWNER_EXTERNAL},
+			[]string{external.Denom, disabled.Denom},
+		},
+		{
+			"external owner and enabled",
+			&types.QueryFilteredTokenPairsRequest{
+				ContractOwner: types.OWNER_EXTERNAL,
+				Status:        types.TOKEN_PAIR_STATUS_ENABLED,
+			},
+			[]string{external.Denom},
+		},
+		{
+			"disabled",
+			&types.QueryFilteredTokenPairsRequest{Status: types.TOKEN_PAIR_STATUS_DISABLED},
+			[]string{disabled.Denom},
+		},
+		{
+			"native precompile",
+			&types.QueryFilteredTokenPairsRequest{ContractType: types.CONTRACT_TYPE_NATIVE_PRECOMPILE},
+			[]string{types.DefaultTokenPairs[0].Denom},
+		},
+		{
+			"dynamic precompile",
+			&types.QueryFilteredTokenPairsRequest{ContractType: types.CONTRACT_TYPE_DYNAMIC_PRECOMPILE},
+			[]string{dynamic.Denom},
+		},
+		{
+			"deployed contract",
+			&types.QueryFilteredTokenPairsRequest{ContractType: types.CONTRACT_TYPE_DEPLOYED},
+			[]string{external.Denom, disabled.Denom},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.network.App.Erc20Keeper.FilteredTokenPairs(ctx, tc.req)
+			suite.Require().NoError(err)
+
+			denoms := make([]string, 0, len(res.TokenPairs))
+			for _, pair := range res.TokenPairs {
+				denoms = append(denoms, pair.Denom)
+			}
+			suite.Require().ElementsMatch(tc.expPairs, denoms)
+		})
+	}
+
+	res, err := suite.network.App.Erc20Keeper.FilteredTokenPairs(ctx, &types.QueryFilteredTokenPairsRequest{
+		ContractOwner: types.OWNER_EXTERNAL,
+		Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.TokenPairs, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestConversionStats() {
+	suite.SetupTest()
+
+	contract, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+	_, err = suite.MintERC20Token(contract, suite.keyring.GetAddr(0), big.NewInt(100))
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	denom := types.CreateDenom(contract.String())
+
+	res, err := suite.network.App.Erc20Keeper.ConversionStats(ctx, &types.QueryConversionStatsRequest{Token: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewConversionStats(denom), res.ConversionStats)
+
+	suite.convertERC20(ctx, contract, big.NewInt(60))
+	pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, suite.network.App.Erc20Keeper.GetTokenPairID(ctx, denom))
+	suite.Require().True(found)
+	err = suite.network.App.Erc20Keeper.ConvertCoinNativeERC20(
+		ctx, pair, math.NewInt(20), suite.keyring.GetAddr(0), suite.keyring.GetAccAddr(0),
+	)
+	suite.Require().NoError(err)
+
+	expStats := types.ConversionStats{
+		Denom:       denom,
+		Erc20ToCoin: math.NewInt(60),
+		CoinToErc20: math.NewInt(20),
+		Conversions: 2,
+	}
+
+	res, err = suite.network.App.Erc20Keeper.ConversionStats(ctx, &types.QueryConversionStatsRequest{Token: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expStats, res.ConversionStats)
+
+	_, err = suite.network.App.Erc20Keeper.ConversionStats(ctx, &types.QueryConversionStatsRequest{Token: "unregistered"})
+	suite.Require().Error(err)
+
+	allRes, err := suite.network.App.Erc20Keeper.AllConversionStats(ctx, &types.QueryAllConversionStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ConversionStats{expStats}, allRes.ConversionStats)
+}
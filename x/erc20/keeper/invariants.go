@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants. They only run if
+// the app includes the crisis module, otherwise they are checked with the
+// Invariants query.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "external-escrow", ExternalEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "native-escrow", NativeEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ExternalEscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return NativeEscrowInvariant(k)(ctx)
+	}
+}
+
+// ExternalEscrowInvariant checks that the ERC20 tokens escrowed by the module
+// account cover the Cosmos supply of every OWNER_EXTERNAL token pair.
+func ExternalEscrowInvariant(k Keeper) sdk.Invariant {
+	return escrowInvariant(k, types.OWNER_EXTERNAL, "external-escrow")
+}
+
+// NativeEscrowInvariant checks that the Cosmos coins escrowed by the module
+// account cover the ERC20 supply of every OWNER_MODULE token pair.
+func NativeEscrowInvariant(k Keeper) sdk.Invariant {
+	return escrowInvariant(k, types.OWNER_MODULE, "native-escrow")
+}
+
+// escrowInvariant checks that no token pair with the given owner has a
+// converted supply exceeding the escrowed balance.
+func escrowInvariant(k Keeper, owner types.Owner, route string) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+			if pair.ContractOwner != owner {
+				return false
+			}
+
+			rec := k.ReconcileTokenPair(ctx, pair)
+			if rec.Difference.IsNegative() {
+				broken++
+				msg += fmt.Sprintf(
+					"\t%s (%s): escrow %s is lower than supply %s\n",
+					pair.Denom, pair.Erc20Address, rec.Escrow, rec.Supply,
+				)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, route,
+			fmt.Sprintf("found %d token pairs with insufficient escrow\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// ReconcileTokenPair compares the balance escrowed by the module account for
+// the given token pair with the supply of its converted representation.
+func (k Keeper) ReconcileTokenPair(ctx sdk.Context, pair types.TokenPair) types.PairReconciliation {
+	escrow, supply := math.ZeroInt(), math.ZeroInt()
+	contract := pair.GetERC20Contract()
+
+	switch {
+	case pair.IsNativeERC20():
+		// contracts that were self-destructed return no balance
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		if balance := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress); balance != nil {
+			escrow = math.NewIntFromBigInt(balance)
+		}
+		supply = k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+	case pair.IsNativeCoin():
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		totalSupply := k.TotalSupply(ctx, erc20, contract)
+
+		params := k.GetParams(ctx)
+		if !params.IsNativePrecompile(contract) && !params.IsDynamicPrecompile(contract) {
+			moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+			escrow = k.bankKeeper.GetBalance(ctx, moduleAddr, pair.Denom).Amount
+			if totalSupply != nil {
+				supply = math.NewIntFromBigInt(totalSupply)
+			}
+			break
+		}
+
+		// precompiles read the bank balances directly, so the ERC20 supply
+		// reported by the precompile is backed by the bank supply of the coin
+		// instead of an escrow. A precompile that cannot be called leaves the
+		// whole bank supply without its ERC20 representation.
+		bankSupply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+		if totalSupply != nil {
+			escrow = bankSupply
+			supply = math.NewIntFromBigInt(totalSupply)
+		} else {
+			supply = bankSupply
+		}
+	}
+
+	return types.PairReconciliation{
+		TokenPair:  pair,
+		Escrow:     escrow,
+		Supply:     supply,
+		Difference: escrow.Sub(supply),
+	}
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/keeper"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowInvariants() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expExternal bool
+		expNative   bool
+	}{
+		{
+			"pass - default token pairs",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"pass - converted ERC20 tokens are escrowed",
+			func() {
+				suite.convertERC20(ctx, contract, big.NewInt(100))
+			},
+			false,
+			false,
+		},
+		{
+			"fail - Cosmos supply exceeds the escrowed ERC20 tokens",
+			func() {
+				suite.convertERC20(ctx, contract, big.NewInt(100))
+
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contract.String()), math.NewInt(1)))
+				err := suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+			false,
+		},
+		{
+			"fail - ERC20 supply of a native coin pair is not escrowed",
+			func() {
+				pair := types.NewTokenPair(contract, "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+			},
+			false,
+			true,
+		},
+		{
+			"pass - bank supply of a native coin precompile is reported by the precompile",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(ctx, pair)
+				suite.addNativePrecompile(ctx, pair.GetERC20Contract())
+
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, math.NewInt(100)))
+				err := suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+		},
+		{
+			"fail - bank supply of a native coin is not reported by its precompile",
+			func() {
+				// the precompile cannot be instantiated without the ERC20 map
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetTokenPair(ctx, pair)
+				suite.network.App.Erc20Keeper.SetDenomMap(ctx, pair.Denom, pair.GetID())
+				suite.addNativePrecompile(ctx, pair.GetERC20Contract())
+
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, math.NewInt(100)))
+				err := suite.network.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			contract, err = suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Require().NoError(err)
+			_, err = suite.MintERC20Token(contract, suite.keyring.GetAddr(0), big.NewInt(100))
+			suite.Require().NoError(err)
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			_, broken := keeper.ExternalEscrowInvariant(suite.network.App.Erc20Keeper)(ctx)
+			suite.Require().Equal(tc.expExternal, broken)
+			_, broken = keeper.NativeEscrowInvariant(suite.network.App.Erc20Keeper)(ctx)
+			suite.Require().Equal(tc.expNative, broken)
+			_, broken = keeper.AllInvariants(suite.network.App.Erc20Keeper)(ctx)
+			suite.Require().Equal(tc.expExternal || tc.expNative, broken)
+
+			res, err := suite.network.App.Erc20Keeper.Invariants(ctx, &types.QueryInvariantsRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expExternal || tc.expNative, res.Broken)
+			if tc.expExternal {
+				suite.Require().Contains(res.Message, "external-escrow")
+			}
+			if tc.expNative {
+				suite.Require().Contains(res.Message, "native-escrow")
+			}
+			if !res.Broken {
+				suite.Require().Empty(res.Message)
+			}
+		})
+	}
+}
+
+// convertERC20 converts the given amount of ERC20 tokens held by the first
+// keyring account into Cosmos coins.
+func (suite *KeeperTestSuite) convertERC20(ctx sdk.Context, contract common.Address, amount *big.Int) {
+	_, err := suite.network.App.Erc20Keeper.ConvertERC20(ctx, &types.MsgConvertERC20{
+		ContractAddress: contract.Hex(),
+		Amount:          math.NewIntFromBigInt(amount),
+		Receiver:        suite.keyring.GetAccAddr(0).String(),
+		Sender:          suite.keyring.GetAddr(0).Hex(),
+	})
+	suite.Require().NoError(err)
+}
+
+// addNativePrecompile adds the given address to the native precompiles of the
+// module parameters.
+func (suite *KeeperTestSuite) addNativePrecompile(ctx sdk.Context, address common.Address) {
+	params := suite.network.App.Erc20Keeper.GetParams(ctx)
+	params.NativePrecompiles = append(params.NativePrecompiles, address.Hex())
+	err := suite.network.App.Erc20Keeper.SetParams(ctx, params)
+	suite.Require().NoError(err)
+}
@@ -152,6 +152,8 @@ func (k Keeper) convertERC20IntoCoinsForNativeToken(
 		return nil, err
 	}
 
+	k.trackConversion(ctx, pair.Denom, msg.Amount, true)
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "convert", "erc20", "total"},
@@ -258,7 +260,12 @@ func (k Keeper) ConvertCoinNativeERC20(
 	}
 
 	// Check for unexpected `Approval` event in logs
-	return k.monitorApprovalEvent(res)
+	if err := k.monitorApprovalEvent(res); err != nil {
+		return err
+	}
+
+	k.trackConversion(ctx, pair.Denom, amount, false)
+	return nil
 }
 
 // UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
@@ -343,6 +350,422 @@ func (k *Keeper) ToggleConversion(goCtx context.Context, req *types.MsgToggleCon
 	return &types.MsgToggleConversionResponse{}, nil
 }
 
+// SetTokenPairMinter implements the gRPC MsgServer interface. After a successful governance vote
+// it sets the address allowed to mint and burn the tokens of a module owned token pair
+// through the ERC20 precompile if the requested authority is the Cosmos SDK governance module account
+func (k *Keeper) SetTokenPairMinter(goCtx context.Context, req *types.MsgSetTokenPairMinter) (*types.MsgSetTokenPairMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("setting the token pair minter is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if req.Minter != "" && !common.IsHexAddress(req.Minter) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid minter address: %s", req.Minter)
+	}
+
+	pair, err := k.setTokenPairMinter(ctx, req.Token, req.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetTokenPairMinter,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyMinter, pair.Minter),
+		),
+	)
+
+	return &types.MsgSetTokenPairMinterResponse{}, nil
+}
+
+// UpdateTokenPairMetadata implements the gRPC MsgServer interface. After a successful governance vote
+// it overwrites the bank metadata of a registered token pair if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateTokenPairMetadata(goCtx context.Context, req *types.MsgUpdateTokenPairMetadata) (*types.MsgUpdateTokenPairMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("updating token pair metadata is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.updateTokenPairMetadata(ctx, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePairMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgUpdateTokenPairMetadataResponse{}, nil
+}
+
+// MigrateTokenPair implements the gRPC MsgServer interface. After a successful governance vote
+// it points an ERC20 token pair to a new ERC20 contract if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) MigrateTokenPair(goCtx context.Context, req *types.MsgMigrateTokenPair) (*types.MsgMigrateTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token pair migration is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(req.NewErc20Address) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", req.NewErc20Address)
+	}
+
+	if !common.IsHexAddress(req.EscrowRecipient) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid escrow recipient address: %s", req.EscrowRecipient)
+	}
+
+	pair, err := k.getTokenPairByToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated, escrow, err := k.migrateTokenPair(
+		ctx, pair, common.HexToAddress(req.NewErc20Address), common.HexToAddress(req.EscrowRecipient),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, migrated.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyNewERC20Token, migrated.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyReceiver, req.EscrowRecipient),
+			sdk.NewAttribute(types.AttributeKeyEscrowAmount, escrow.String()),
+		),
+	)
+
+	return &types.MsgMigrateTokenPairResponse{}, nil
+}
+
+// RemoveTokenPair implements the gRPC MsgServer interface. After a successful governance vote
+// it deletes a disabled token pair without escrowed balance if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) RemoveTokenPair(goCtx context.Context, req *types.MsgRemoveTokenPair) (*types.MsgRemoveTokenPairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token pair removal is currently disabled by governance")
+	}
+
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.removeTokenPair(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgRemoveTokenPairResponse{}, nil
+}
+
+// RegisterFeeToken implements the gRPC MsgServer interface. After a successful governance vote
+// it whitelists a token pair denomination to pay Ethereum transaction fees if the requested
+// authority is the Cosmos SDK governance module account
+func (k *Keeper) RegisterFeeToken(goCtx context.Context, req *types.MsgRegisterFeeToken) (*types.MsgRegisterFeeTokenResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.setFeeToken(ctx, req.FeeToken); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterFeeToken,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, req.FeeToken.Denom),
+			sdk.NewAttribute(types.AttributeKeyRateSource, req.FeeToken.RateSource.String()),
+		),
+	)
+
+	return &types.MsgRegisterFeeTokenResponse{}, nil
+}
+
+// RemoveFeeToken implements the gRPC MsgServer interface. After a successful governance vote
+// it removes a token from the fee token whitelist if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) RemoveFeeToken(goCtx context.Context, req *types.MsgRemoveFeeToken) (*types.MsgRemoveFeeTokenResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetFeeToken(ctx, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom '%s'", req.Denom)
+	}
+
+	k.DeleteFeeToken(ctx, req.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveFeeToken,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, req.Denom),
+		),
+	)
+
+	return &types.MsgRemoveFeeTokenResponse{}, nil
+}
+
+// UpdateFeeTokenPrice implements the gRPC MsgServer interface. After a successful governance vote
+// it records a price of a fee token in the oracle store if the requested authority
+// is the Cosmos SDK governance module account
+func (k *Keeper) UpdateFeeTokenPrice(goCtx context.Context, req *types.MsgUpdateFeeTokenPrice) (*types.MsgUpdateFeeTokenPriceResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.recordFeeTokenPrice(ctx, req.Denom, req.Price); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateFeeTokenPrice,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyPrice, req.Price.String()),
+		),
+	)
+
+	return &types.MsgUpdateFeeTokenPriceResponse{}, nil
+}
+
+// CreateDenom implements the gRPC MsgServer interface. It creates a new token
+// factory denomination with the sender as admin and registers its ERC20 extension.
+func (k *Keeper) CreateDenom(goCtx context.Context, req *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token factory is currently disabled by governance")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, err := k.CreateFactoryDenom(ctx, sender, req.Subdenom, req.Name, req.Symbol, req.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgCreateDenomResponse{
+		Denom:        pair.Denom,
+		Erc20Address: pair.Erc20Address,
+	}, nil
+}
+
+// Mint implements the gRPC MsgServer interface. It mints tokens of a token
+// factory denomination if the sender is the denom admin.
+func (k *Keeper) Mint(goCtx context.Context, req *types.MsgMint) (*types.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token factory is currently disabled by governance")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient := sender
+	if req.MintToAddress != "" {
+		recipient, err = sdk.AccAddressFromBech32(req.MintToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.MintFactoryDenom(ctx, common.BytesToAddress(sender), req.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintFactoryDenom,
+			sdk.NewAttribute(types.AttributeKeyAdmin, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn implements the gRPC MsgServer interface. It burns tokens of a token
+// factory denomination from the sender balance if the sender is the denom admin.
+func (k *Keeper) Burn(goCtx context.Context, req *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token factory is currently disabled by governance")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.BurnFactoryDenom(ctx, common.BytesToAddress(sender), req.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFactoryDenom,
+			sdk.NewAttribute(types.AttributeKeyAdmin, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implements the gRPC MsgServer interface. It changes the admin of
+// a token factory denomination if the sender is the current denom admin.
+func (k *Keeper) ChangeAdmin(goCtx context.Context, req *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token factory is currently disabled by governance")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	var newAdmin common.Address
+	if req.NewAdmin != "" {
+		newAdminAcc, err := sdk.AccAddressFromBech32(req.NewAdmin)
+		if err != nil {
+			return nil, err
+		}
+		newAdmin = common.BytesToAddress(newAdminAcc)
+	}
+
+	pair, err := k.ChangeFactoryDenomAdmin(ctx, common.BytesToAddress(sender), req.Denom, newAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeDenomAdmin,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, req.NewAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implements the gRPC MsgServer interface. It overwrites the
+// bank metadata of a token factory denomination if the sender is the denom admin.
+func (k *Keeper) SetDenomMetadata(goCtx context.Context, req *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("token factory is currently disabled by governance")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetFactoryDenomMetadata(ctx, common.BytesToAddress(sender), req.Metadata); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, req.Metadata.Base),
+			sdk.NewAttribute(types.AttributeKeyAdmin, req.Sender),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// PermissionlessRegisterERC20 implements the gRPC MsgServer interface. It
+// registers a token pair for an ERC20 contract without a governance proposal
+// after escrowing the registration deposit of the sender.
+func (k *Keeper) PermissionlessRegisterERC20(goCtx context.Context, req *types.MsgPermissionlessRegisterERC20) (*types.MsgPermissionlessRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Check if the conversion is globally enabled
+	if !k.IsERC20Enabled(ctx) {
+		return nil, types.ErrERC20Disabled.Wrap("registration is currently disabled by governance")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !common.IsHexAddress(req.Erc20Address) {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", req.Erc20Address)
+	}
+
+	pair, err := k.registerERC20WithDeposit(ctx, sender, common.HexToAddress(req.Erc20Address))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, req.Sender),
+		),
+	)
+
+	return &types.MsgPermissionlessRegisterERC20Response{Denom: pair.Denom}, nil
+}
+
 // validateAuthority is a helper function to validate that the provided authority
 // is the keeper's authority address
 func (k *Keeper) validateAuthority(authority string) error {
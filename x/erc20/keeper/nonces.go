@@ -0,0 +1,129 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// GetPermitNonce returns the current EIP-2612 permit nonce of the owner for
+// the given token pair.
+func (k Keeper) GetPermitNonce(ctx sdk.Context, pairID []byte, owner common.Address) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	bz := store.Get(permitNonceKey(pairID, owner))
+	if len(bz) == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPermitNonce sets the EIP-2612 permit nonce of the owner for the given
+// token pair.
+func (k Keeper) SetPermitNonce(ctx sdk.Context, pairID []byte, owner common.Address, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	store.Set(permitNonceKey(pairID, owner), sdk.Uint64ToBigEndian(nonce))
+}
+
+// IsAuthorizationUsed returns true if the EIP-3009 authorization nonce of the
+// authorizer has already been used or canceled for the given token pair.
+func (k Keeper) IsAuthorizationUsed(ctx sdk.Context, pairID []byte, authorizer common.Address, nonce common.Hash) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorizationState)
+	return store.Has(authorizationStateKey(pairID, authorizer, nonce))
+}
+
+// SetAuthorizationUsed marks the EIP-3009 authorization nonce of the
+// authorizer as used for the given token pair.
+func (k Keeper) SetAuthorizationUsed(ctx sdk.Context, pairID []byte, authorizer common.Address, nonce common.Hash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorizationState)
+	store.Set(authorizationStateKey(pairID, authorizer, nonce), isTrue)
+}
+
+// GetAllPermitNonces returns the EIP-2612 permit nonces of all the owners.
+// Nonces of token pairs that are no longer registered are skipped.
+func (k Keeper) GetAllPermitNonces(ctx sdk.Context) []types.PermitNonce {
+	pairs := k.tokenPairsByID(ctx)
+	nonces := []types.PermitNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPermitNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		pair, found := pairs[string(key[:tmhash.Size])]
+		if !found {
+			continue
+		}
+
+		nonces = append(nonces, types.PermitNonce{
+			Erc20Address: pair.Erc20Address,
+			Owner:        common.BytesToAddress(key[tmhash.Size:]).Hex(),
+			Nonce:        binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	return nonces
+}
+
+// GetAllAuthorizationStates returns all the used or canceled EIP-3009
+// authorizations. Authorizations of token pairs that are no longer registered
+// are skipped.
+func (k Keeper) GetAllAuthorizationStates(ctx sdk.Context) []types.AuthorizationState {
+	pairs := k.tokenPairsByID(ctx)
+	states := []types.AuthorizationState{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAuthorizationState)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		pair, found := pairs[string(key[:tmhash.Size])]
+		if !found {
+			continue
+		}
+
+		authorizer := key[tmhash.Size : tmhash.Size+common.AddressLength]
+		nonce := key[tmhash.Size+common.AddressLength:]
+		states = append(states, types.AuthorizationState{
+			Erc20Address: pair.Erc20Address,
+			Authorizer:   common.BytesToAddress(authorizer).Hex(),
+			Nonce:        common.BytesToHash(nonce).Hex(),
+		})
+	}
+
+	return states
+}
+
+// tokenPairsByID returns the registered token pairs indexed by their ID.
+func (k Keeper) tokenPairsByID(ctx sdk.Context) map[string]types.TokenPair {
+	pairs := make(map[string]types.TokenPair)
+	for _, pair := range k.GetTokenPairs(ctx) {
+		pairs[string(pair.GetID())] = pair
+	}
+	return pairs
+}
+
+// permitNonceKey returns the store key of an EIP-2612 permit nonce:
+// pairID | owner
+func permitNonceKey(pairID []byte, owner common.Address) []byte {
+	key := make([]byte, 0, len(pairID)+common.AddressLength)
+	key = append(key, pairID...)
+	return append(key, owner.Bytes()...)
+}
+
+// authorizationStateKey returns the store key of an EIP-3009 authorization:
+// pairID | authorizer | nonce
+func authorizationStateKey(pairID []byte, authorizer common.Address, nonce common.Hash) []byte {
+	key := make([]byte, 0, len(pairID)+common.AddressLength+common.HashLength)
+	key = append(key, pairID...)
+	key = append(key, authorizer.Bytes()...)
+	return append(key, nonce.Bytes()...)
+}
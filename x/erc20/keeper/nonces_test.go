@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestPermitNonces() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.Erc20Keeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	otherPair := types.NewTokenPair(utiltx.GenerateAddress(), "coin2", types.OWNER_MODULE)
+	owner := utiltx.GenerateAddress()
+
+	suite.Require().Equal(uint64(0), keeper.GetPermitNonce(ctx, pair.GetID(), owner))
+
+	keeper.SetPermitNonce(ctx, pair.GetID(), owner, 3)
+	suite.Require().Equal(uint64(3), keeper.GetPermitNonce(ctx, pair.GetID(), owner))
+
+	// nonces are tracked per token pair and owner
+	suite.Require().Equal(uint64(0), keeper.GetPermitNonce(ctx, otherPair.GetID(), owner))
+	suite.Require().Equal(uint64(0), keeper.GetPermitNonce(ctx, pair.GetID(), utiltx.GenerateAddress()))
+}
+
+func (suite *KeeperTestSuite) TestAuthorizationState() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.Erc20Keeper
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	otherPair := types.NewTokenPair(utiltx.GenerateAddress(), "coin2", types.OWNER_MODULE)
+	authorizer := utiltx.GenerateAddress()
+	nonce := common.BytesToHash([]byte("nonce"))
+
+	suite.Require().False(keeper.IsAuthorizationUsed(ctx, pair.GetID(), authorizer, nonce))
+
+	keeper.SetAuthorizationUsed(ctx, pair.GetID(), authorizer, nonce)
+	suite.Require().True(keeper.IsAuthorizationUsed(ctx, pair.GetID(), authorizer, nonce))
+
+	suite.Require().False(keeper.IsAuthorizationUsed(ctx, otherPair.GetID(), authorizer, nonce))
+	suite.Require().False(keeper.IsAuthorizationUsed(ctx, pair.GetID(), authorizer, common.BytesToHash([]byte("other"))))
+}
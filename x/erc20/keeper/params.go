@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"slices"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,7 +21,13 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	dynamicPrecompiles := k.getDynamicPrecompiles(ctx)
 	nativePrecompiles := k.getNativePrecompiles(ctx)
-	return types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params = types.NewParams(enableErc20, nativePrecompiles, dynamicPrecompiles)
+	params.EnablePermissionlessRegistration = k.IsPermissionlessRegistrationEnabled(ctx)
+	params.RegistrationDeposit = k.getRegistrationDeposit(ctx)
+	params.RegistrationDepositPeriod = k.getRegistrationDepositPeriod(ctx)
+	params.RegistrationDenylist = k.getRegistrationDenylist(ctx)
+	params.DenomCreationFee = k.getDenomCreationFee(ctx)
+	return params
 }
 
 func (k Keeper) UpdateCodeHash(ctx sdk.Context, updatedDynamicPrecompiles []string) error {
@@ -47,6 +54,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	// and keep params equal between different executions
 	slices.Sort(params.DynamicPrecompiles)
 	slices.Sort(params.NativePrecompiles)
+	for i, addr := range params.RegistrationDenylist {
+		if common.IsHexAddress(addr) {
+			params.RegistrationDenylist[i] = common.HexToAddress(addr).Hex()
+		}
+	}
+	slices.Sort(params.RegistrationDenylist)
 
 	if err := params.Validate(); err != nil {
 		return err
@@ -60,6 +73,11 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setDynamicPrecompiles(ctx, params.DynamicPrecompiles)
 	k.setNativePrecompiles(ctx, params.NativePrecompiles)
+	k.setPermissionlessRegistrationEnabled(ctx, params.EnablePermissionlessRegistration)
+	k.setRegistrationDeposit(ctx, params.RegistrationDeposit)
+	k.setRegistrationDepositPeriod(ctx, params.RegistrationDepositPeriod)
+	k.setRegistrationDenylist(ctx, params.RegistrationDenylist)
+	k.setDenomCreationFee(ctx, params.DenomCreationFee)
 	return nil
 }
 
@@ -119,3 +137,97 @@ func (k Keeper) getNativePrecompiles(ctx sdk.Context) (nativePrecompiles []strin
 	}
 	return nativePrecompiles
 }
+
+// IsPermissionlessRegistrationEnabled returns true if ERC20 contracts can be
+// registered without a governance proposal
+func (k Keeper) IsPermissionlessRegistrationEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyEnablePermissionlessRegistration)
+}
+
+// setPermissionlessRegistrationEnabled sets the EnablePermissionlessRegistration param in the store
+func (k Keeper) setPermissionlessRegistrationEnabled(ctx sdk.Context, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(types.ParamStoreKeyEnablePermissionlessRegistration, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeyEnablePermissionlessRegistration)
+}
+
+// setRegistrationDeposit sets the RegistrationDeposit param in the store
+func (k Keeper) setRegistrationDeposit(ctx sdk.Context, deposit sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamStoreKeyRegistrationDeposit, []byte(deposit.String()))
+}
+
+// getRegistrationDeposit returns the RegistrationDeposit param from the store
+func (k Keeper) getRegistrationDeposit(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDeposit)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		// NOTE: shouldn't occur since the deposit is validated before it is stored
+		panic(err)
+	}
+	return deposit
+}
+
+// setRegistrationDepositPeriod sets the RegistrationDepositPeriod param in the store
+func (k Keeper) setRegistrationDepositPeriod(ctx sdk.Context, period time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamStoreKeyRegistrationDepositPeriod, sdk.Uint64ToBigEndian(uint64(period))) //#nosec G115 -- period is validated to be non-negative
+}
+
+// getRegistrationDepositPeriod returns the RegistrationDepositPeriod param from the store
+func (k Keeper) getRegistrationDepositPeriod(ctx sdk.Context) time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDepositPeriod)
+	return time.Duration(sdk.BigEndianToUint64(bz)) //#nosec G115 -- period is validated to be non-negative
+}
+
+// setRegistrationDenylist sets the RegistrationDenylist param in the store
+func (k Keeper) setRegistrationDenylist(ctx sdk.Context, denylist []string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 0, addressLength*len(denylist))
+	for _, str := range denylist {
+		bz = append(bz, []byte(str)...)
+	}
+	store.Set(types.ParamStoreKeyRegistrationDenylist, bz)
+}
+
+// getRegistrationDenylist returns the RegistrationDenylist param from the store
+func (k Keeper) getRegistrationDenylist(ctx sdk.Context) (denylist []string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDenylist)
+	for i := 0; i < len(bz); i += addressLength {
+		denylist = append(denylist, string(bz[i:i+addressLength]))
+	}
+	return denylist
+}
+
+// setDenomCreationFee sets the DenomCreationFee param in the store
+func (k Keeper) setDenomCreationFee(ctx sdk.Context, fee sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamStoreKeyDenomCreationFee, []byte(fee.String()))
+}
+
+// getDenomCreationFee returns the DenomCreationFee param from the store
+func (k Keeper) getDenomCreationFee(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyDenomCreationFee)
+	if len(bz) == 0 {
+		return nil
+	}
+
+	fee, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		// NOTE: shouldn't occur since the fee is validated before it is stored
+		panic(err)
+	}
+	return fee
+}
@@ -4,12 +4,17 @@
 package keeper
 
 import (
+	"math/big"
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v20/contracts"
 	"github.com/evmos/evmos/v20/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // RegisterERC20 creates a Cosmos coin and registers the token pair between the
@@ -132,3 +137,245 @@ func (k Keeper) toggleConversion(
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
+
+// setTokenPairMinter sets the minter of an enabled module owned token pair
+// other than the EVM coin. An empty minter disables minting and burning
+// through the ERC20 precompile.
+func (k Keeper) setTokenPairMinter(
+	ctx sdk.Context,
+	token, minter string,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if !pair.IsNativeCoin() {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrInvalidMinter, "token '%s' is not owned by the module", token,
+		)
+	}
+
+	if minter != "" {
+		// the supply of the EVM coin is managed by the inflation module
+		if pair.Denom == evmtypes.GetEVMCoinDenom() {
+			return types.TokenPair{}, errorsmod.Wrapf(
+				types.ErrInvalidMinter, "cannot set a minter for the EVM coin %s", pair.Denom,
+			)
+		}
+
+		// the supply of IBC vouchers is backed by the coins escrowed on their
+		// source chain, so it cannot be minted or burned on this chain
+		if strings.HasPrefix(pair.Denom, "ibc/") {
+			return types.TokenPair{}, errorsmod.Wrapf(
+				types.ErrInvalidMinter, "cannot set a minter for the IBC voucher %s", pair.Denom,
+			)
+		}
+
+		if !pair.Enabled {
+			return types.TokenPair{}, errorsmod.Wrapf(
+				types.ErrERC20TokenPairDisabled, "token '%s'", token,
+			)
+		}
+
+		minter = common.HexToAddress(minter).Hex()
+	}
+
+	pair.Minter = minter
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// updateTokenPairMetadata overwrites the bank metadata of a registered token
+// pair. The metadata base denomination must be the token pair denomination.
+func (k Keeper) updateTokenPairMetadata(
+	ctx sdk.Context,
+	metadata banktypes.Metadata,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, metadata.Base)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return pair, nil
+}
+
+// migrateTokenPair points an ERC20 token pair to a new ERC20 contract. The
+// module account must hold enough tokens of the new contract to back the
+// Cosmos coin supply. The tokens escrowed in the previous contract are
+// transferred to the escrow recipient.
+func (k Keeper) migrateTokenPair(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	newContract, escrowRecipient common.Address,
+) (types.TokenPair, *big.Int, error) {
+	if !pair.IsNativeERC20() {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidPairMigration, "token '%s' is not owned by an external contract", pair.Denom,
+		)
+	}
+
+	oldContract := pair.GetERC20Contract()
+	if k.IsERC20Registered(ctx, newContract) {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", newContract,
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, newContract)
+	if acc == nil || !acc.IsContract() {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidPairMigration, "address %s is not a contract", newContract,
+		)
+	}
+
+	oldData, err := k.QueryERC20(ctx, oldContract)
+	if err != nil {
+		return types.TokenPair{}, nil, err
+	}
+
+	newData, err := k.QueryERC20(ctx, newContract)
+	if err != nil {
+		return types.TokenPair{}, nil, err
+	}
+
+	if oldData.Decimals != newData.Decimals {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidPairMigration, "decimals mismatch: expected %d, got %d", oldData.Decimals, newData.Decimals,
+		)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount.BigInt()
+	newEscrow := k.BalanceOf(ctx, erc20, newContract, types.ModuleAddress)
+	if newEscrow == nil || newEscrow.Cmp(supply) < 0 {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidPairMigration, "module escrow in %s does not cover the coin supply %s", newContract, supply,
+		)
+	}
+
+	escrow := k.BalanceOf(ctx, erc20, oldContract, types.ModuleAddress)
+	if escrow == nil {
+		return types.TokenPair{}, nil, errorsmod.Wrapf(
+			types.ErrInvalidPairMigration, "failed to query module escrow in %s", oldContract,
+		)
+	}
+
+	if escrow.Sign() > 0 {
+		res, err := k.evmKeeper.CallEVM(ctx, erc20, types.ModuleAddress, oldContract, true, "transfer", escrowRecipient, escrow)
+		if err != nil {
+			return types.TokenPair{}, nil, err
+		}
+
+		var unpacked types.ERC20BoolResponse
+		if err := erc20.UnpackIntoInterface(&unpacked, "transfer", res.Ret); err != nil {
+			return types.TokenPair{}, nil, err
+		}
+
+		if !unpacked.Value {
+			return types.TokenPair{}, nil, errorsmod.Wrap(types.ErrUnexpectedEvent, "failed to transfer escrowed tokens")
+		}
+	}
+
+	// the pair ID depends on the contract address so the pair is stored again
+	k.DeleteTokenPair(ctx, pair)
+	pair.Erc20Address = newContract.Hex()
+	k.SetToken(ctx, pair)
+	k.migrateRegistrationDeposit(ctx, oldContract, newContract)
+
+	return pair, escrow, nil
+}
+
+// removeTokenPair deletes a disabled token pair once the module account holds
+// no escrowed balance for it. Native coin pairs are also removed from the
+// active precompiles.
+func (k Keeper) removeTokenPair(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, error) {
+	pair, err := k.getTokenPairByToken(ctx, token)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairEnabled, "token '%s' must be disabled before deletion", token,
+		)
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if escrow := k.bankKeeper.GetBalance(ctx, moduleAddr, pair.Denom); !escrow.IsZero() {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrNonZeroEscrow, "module holds %s", escrow)
+	}
+
+	if pair.IsNativeERC20() {
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		// NOTE: a nil balance means the contract self-destructed and nothing is escrowed
+		if escrow := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), types.ModuleAddress); escrow != nil && escrow.Sign() > 0 {
+			return types.TokenPair{}, errorsmod.Wrapf(types.ErrNonZeroEscrow, "module holds %s %s", escrow, pair.Erc20Address)
+		}
+
+		if supply := k.bankKeeper.GetSupply(ctx, pair.Denom); !supply.IsZero() {
+			return types.TokenPair{}, errorsmod.Wrapf(types.ErrNonZeroEscrow, "coin supply is %s", supply)
+		}
+	}
+
+	params := k.GetParams(ctx)
+	if params.IsNativePrecompile(pair.GetERC20Contract()) || params.IsDynamicPrecompile(pair.GetERC20Contract()) {
+		params.NativePrecompiles = removeAddr(params.NativePrecompiles, pair.GetERC20Contract())
+		params.DynamicPrecompiles = removeAddr(params.DynamicPrecompiles, pair.GetERC20Contract())
+		if err := k.SetParams(ctx, params); err != nil {
+			return types.TokenPair{}, err
+		}
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+	k.DeleteConversionStats(ctx, pair.Denom)
+	return pair, nil
+}
+
+// getTokenPairByToken returns the token pair registered for the given hex
+// contract address or Cosmos base denomination.
+func (k Keeper) getTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	return pair, nil
+}
+
+// removeAddr returns the hex addresses without the given address
+func removeAddr(addrs []string, addr common.Address) []string {
+	filtered := make([]string, 0, len(addrs))
+	for _, a := range addrs {
+		if common.HexToAddress(a) != addr {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -11,6 +13,8 @@ import (
 	"github.com/evmos/evmos/v20/contracts"
 	testfactory "github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	testutils "github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20"
 	"github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	erc20mocks "github.com/evmos/evmos/v20/x/erc20/types/mocks"
@@ -296,3 +300,459 @@ func (suite *KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetTokenPairMinter() {
+	var (
+		ctx    sdk.Context
+		pair   types.TokenPair
+		minter = utiltx.GenerateAddress()
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgSetTokenPairMinter
+		expPass  bool
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgSetTokenPairMinter {
+				return &types.MsgSetTokenPairMinter{Authority: suite.keyring.GetAccAddr(0).String(), Token: pair.Denom, Minter: minter.Hex()}
+			},
+			false,
+		},
+		{
+			"fail - token not registered",
+			func() *types.MsgSetTokenPairMinter {
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: "unregistered", Minter: minter.Hex()}
+			},
+			false,
+		},
+		{
+			"fail - invalid minter",
+			func() *types.MsgSetTokenPairMinter {
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: pair.Denom, Minter: "minter"}
+			},
+			false,
+		},
+		{
+			"fail - token pair is owned by an external contract",
+			func() *types.MsgSetTokenPairMinter {
+				pair.ContractOwner = types.OWNER_EXTERNAL
+				suite.network.App.Erc20Keeper.SetTokenPair(ctx, pair)
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: pair.Denom, Minter: minter.Hex()}
+			},
+			false,
+		},
+		{
+			"fail - token pair is disabled",
+			func() *types.MsgSetTokenPairMinter {
+				pair.Enabled = false
+				suite.network.App.Erc20Keeper.SetTokenPair(ctx, pair)
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: pair.Denom, Minter: minter.Hex()}
+			},
+			false,
+		},
+		{
+			"fail - EVM coin",
+			func() *types.MsgSetTokenPairMinter {
+				evmPair := types.NewTokenPair(utiltx.GenerateAddress(), evmtypes.GetEVMCoinDenom(), types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(ctx, evmPair)
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: evmPair.Denom, Minter: minter.Hex()}
+			},
+			false,
+		},
+		{
+			"fail - IBC voucher",
+			func() *types.MsgSetTokenPairMinter {
+				ibcPair := types.NewTokenPair(utiltx.GenerateAddress(), ibcBase, types.OWNER_MODULE)
+				suite.network.App.Erc20Keeper.SetToken(ctx, ibcPair)
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: ibcPair.Denom, Minter: minter.Hex()}
+			},
+			false,
+		},
+		{
+			"pass - set minter",
+			func() *types.MsgSetTokenPairMinter {
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: pair.Erc20Address, Minter: minter.Hex()}
+			},
+			true,
+		},
+		{
+			"pass - remove minter",
+			func() *types.MsgSetTokenPairMinter {
+				pair.Minter = minter.Hex()
+				suite.network.App.Erc20Keeper.SetTokenPair(ctx, pair)
+				return &types.MsgSetTokenPairMinter{Authority: authtypes.NewModuleAddress("gov").String(), Token: pair.Denom}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx = suite.network.GetContext()
+
+			pair = types.NewTokenPair(utiltx.GenerateAddress(), cosmosTokenBase, types.OWNER_MODULE)
+			suite.network.App.Erc20Keeper.SetTokenPair(ctx, pair)
+			suite.network.App.Erc20Keeper.SetDenomMap(ctx, pair.Denom, pair.GetID())
+			suite.network.App.Erc20Keeper.SetERC20Map(ctx, pair.GetERC20Contract(), pair.GetID())
+
+			msg := tc.malleate()
+			_, err := suite.network.App.Erc20Keeper.SetTokenPairMinter(ctx, msg)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+			suite.Require().NoError(err, tc.name)
+
+			pair, _ = suite.network.App.Erc20Keeper.GetTokenPair(ctx, pair.GetID())
+			suite.Require().Equal(msg.Minter, pair.Minter)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateTokenPairMetadata() {
+	var (
+		ctx      sdk.Context
+		metadata banktypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				metadata.Base = "unregistered"
+				metadata.DenomUnits[0].Denom = "unregistered"
+				metadata.Display = "unregistered"
+			},
+			false,
+		},
+		{
+			"fail - invalid metadata",
+			func() {
+				metadata.Display = "invalid"
+			},
+			false,
+		},
+		{
+			"pass",
+			func() {},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contractAddr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Require().NoError(err)
+			ctx = suite.network.GetContext()
+
+			denom := types.CreateDenom(contractAddr.String())
+			metadata = banktypes.Metadata{
+				Description: "Updated description",
+				Base:        denom,
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: denom, Exponent: 0},
+					{Denom: "ctkn", Exponent: uint32(erc20Decimals)},
+				},
+				Name:    "Coin Token v2",
+				Symbol:  "CTKN2",
+				Display: "ctkn",
+			}
+
+			tc.malleate()
+
+			_, err = suite.network.App.Erc20Keeper.UpdateTokenPairMetadata(ctx, &types.MsgUpdateTokenPairMetadata{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Metadata:  metadata,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			stored, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, denom)
+			suite.Require().True(found)
+			suite.Require().Equal(metadata, stored)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateTokenPair() {
+	var (
+		oldContract common.Address
+		newContract common.Address
+		recipient   = utiltx.GenerateAddress()
+		converted   = big.NewInt(100)
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - new contract not a contract",
+			func() {
+				newContract = utiltx.GenerateAddress()
+			},
+			false,
+		},
+		{
+			"fail - decimals mismatch",
+			func() {
+				var err error
+				newContract, err = suite.DeployContract(erc20Name, erc20Symbol, cosmosDecimals)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(newContract, types.ModuleAddress, converted)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - module escrow does not cover the supply",
+			func() {
+				var err error
+				newContract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(newContract, types.ModuleAddress, big.NewInt(50))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - new contract already registered",
+			func() {
+				var err error
+				newContract, err = suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(newContract, types.ModuleAddress, converted)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pass",
+			func() {
+				var err error
+				newContract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(newContract, types.ModuleAddress, converted)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			oldContract, err = suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Require().NoError(err)
+			_, err = suite.MintERC20Token(oldContract, suite.keyring.GetAddr(0), converted)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := suite.network.GetContext()
+			denom := types.CreateDenom(oldContract.String())
+			_, err = suite.network.App.Erc20Keeper.ConvertERC20(ctx, &types.MsgConvertERC20{
+				ContractAddress: oldContract.Hex(),
+				Amount:          math.NewIntFromBigInt(converted),
+				Receiver:        suite.keyring.GetAccAddr(0).String(),
+				Sender:          suite.keyring.GetAddr(0).Hex(),
+			})
+			suite.Require().NoError(err)
+
+			_, err = suite.network.App.Erc20Keeper.MigrateTokenPair(ctx, &types.MsgMigrateTokenPair{
+				Authority:       authtypes.NewModuleAddress("gov").String(),
+				Token:           denom,
+				NewErc20Address: newContract.Hex(),
+				EscrowRecipient: recipient.Hex(),
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, oldContract))
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, oldContract))
+			id := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, denom)
+			suite.Require().Equal(id, suite.network.App.Erc20Keeper.GetTokenPairID(ctx, newContract.Hex()))
+			pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(newContract.Hex(), pair.Erc20Address)
+			suite.Require().Equal(denom, pair.Denom)
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			suite.Require().Equal(converted, suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, oldContract, recipient))
+			suite.Require().Zero(suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, oldContract, types.ModuleAddress).Sign())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveTokenPair() {
+	var (
+		ctx   sdk.Context
+		token string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				token = "unregistered"
+			},
+			false,
+		},
+		{
+			"fail - token pair enabled",
+			func() {
+				contractAddr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+				token = contractAddr.Hex()
+			},
+			false,
+		},
+		{
+			"fail - escrowed ERC20 tokens",
+			func() {
+				contractAddr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.Require().NoError(err)
+				_, err = suite.MintERC20Token(contractAddr, suite.keyring.GetAddr(0), big.NewInt(100))
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+				token = contractAddr.Hex()
+
+				_, err = suite.network.App.Erc20Keeper.ConvertERC20(ctx, &types.MsgConvertERC20{
+					ContractAddress: token,
+					Amount:          math.NewInt(100),
+					Receiver:        suite.keyring.GetAccAddr(0).String(),
+					Sender:          suite.keyring.GetAddr(0).Hex(),
+				})
+				suite.Require().NoError(err)
+				suite.toggleConversion(ctx, token)
+			},
+			false,
+		},
+		{
+			"pass - ERC20 token pair",
+			func() {
+				contractAddr, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+				token = contractAddr.Hex()
+				suite.toggleConversion(ctx, token)
+			},
+			true,
+		},
+		{
+			"pass - native coin token pair with dynamic precompile",
+			func() {
+				pair, err := suite.network.App.Erc20Keeper.CreateFactoryDenom(
+					ctx, suite.keyring.GetAccAddr(0), "token", "Token", "TKN", 18,
+				)
+				suite.Require().NoError(err)
+				suite.Require().True(suite.network.App.Erc20Keeper.GetParams(ctx).IsDynamicPrecompile(pair.GetERC20Contract()))
+				token = pair.Denom
+				suite.toggleConversion(ctx, token)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+
+			tc.malleate()
+
+			id := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, token)
+			pair, _ := suite.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+
+			_, err := suite.network.App.Erc20Keeper.RemoveTokenPair(ctx, &types.MsgRemoveTokenPair{
+				Authority: authtypes.NewModuleAddress("gov").String(),
+				Token:     token,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().False(suite.network.App.Erc20Keeper.IsTokenPairRegistered(ctx, id))
+			suite.Require().False(suite.network.App.Erc20Keeper.IsDenomRegistered(ctx, pair.Denom))
+			suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, pair.GetERC20Contract()))
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			suite.Require().False(params.IsDynamicPrecompile(pair.GetERC20Contract()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenPairGovernanceGenesisRoundTrip() {
+	suite.SetupTest()
+
+	removed, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+	migrated, err := suite.setupRegisterERC20Pair(contractMinterBurner)
+	suite.Require().NoError(err)
+	newContract, err := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Require().NoError(err)
+
+	ctx := suite.network.GetContext()
+	suite.toggleConversion(ctx, removed.Hex())
+	_, err = suite.network.App.Erc20Keeper.RemoveTokenPair(ctx, &types.MsgRemoveTokenPair{
+		Authority: authtypes.NewModuleAddress("gov").String(),
+		Token:     removed.Hex(),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.network.App.Erc20Keeper.MigrateTokenPair(ctx, &types.MsgMigrateTokenPair{
+		Authority:       authtypes.NewModuleAddress("gov").String(),
+		Token:           migrated.Hex(),
+		NewErc20Address: newContract.Hex(),
+		EscrowRecipient: utiltx.GenerateAddress().Hex(),
+	})
+	suite.Require().NoError(err)
+
+	exported := erc20.ExportGenesis(ctx, suite.network.App.Erc20Keeper)
+	suite.Require().NoError(exported.Validate())
+
+	// import the exported state into a fresh chain
+	suite.SetupTest()
+	ctx = suite.network.GetContext()
+	erc20.InitGenesis(ctx, suite.network.App.Erc20Keeper, suite.network.App.AccountKeeper, *exported)
+
+	suite.Require().Equal(exported, erc20.ExportGenesis(ctx, suite.network.App.Erc20Keeper))
+	suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, removed))
+	suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, migrated))
+	suite.Require().True(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, newContract))
+	suite.Require().True(suite.network.App.Erc20Keeper.IsDenomRegistered(ctx, types.CreateDenom(migrated.String())))
+}
+
+// toggleConversion toggles the conversion of the given token through the
+// governance message.
+func (suite *KeeperTestSuite) toggleConversion(ctx sdk.Context, token string) {
+	_, err := suite.network.App.Erc20Keeper.ToggleConversion(ctx, &types.MsgToggleConversion{
+		Authority: authtypes.NewModuleAddress("gov").String(),
+		Token:     token,
+	})
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,346 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// registerERC20WithDeposit registers a token pair for the given ERC20
+// contract without a governance proposal. The contract must not be denylisted
+// and has to pass the ERC20 metadata and transfer checks. The registration
+// deposit is escrowed in the module account until the deposit period ends.
+func (k Keeper) registerERC20WithDeposit(
+	ctx sdk.Context,
+	depositor sdk.AccAddress,
+	contract common.Address,
+) (*types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if !params.EnablePermissionlessRegistration {
+		return nil, types.ErrPermissionlessDisabled
+	}
+
+	if params.IsDenylisted(contract) {
+		return nil, errorsmod.Wrapf(types.ErrERC20Denylisted, "contract %s", contract)
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract.String(),
+		)
+	}
+
+	// precompiles have no code and are registered by governance only
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "address %s is not a contract", contract)
+	}
+
+	if err := k.probeTransfer(ctx, contract, common.BytesToAddress(depositor)); err != nil {
+		return nil, err
+	}
+
+	// the ERC20 metadata is checked through QueryERC20 when creating the coin metadata
+	pair, err := k.registerERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := types.NewRegistrationDeposit(
+		contract,
+		depositor,
+		params.RegistrationDeposit,
+		ctx.BlockTime().Add(params.RegistrationDepositPeriod),
+	)
+	if !deposit.Amount.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, deposit.Amount); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow registration deposit")
+		}
+		k.SetRegistrationDeposit(ctx, deposit)
+	}
+
+	return pair, nil
+}
+
+// probeTransfer transfers the full token balance of the holder to the module
+// account and back on a cached context that is discarded afterwards. It fails
+// if a balance does not change by exactly the transferred amount or the total
+// supply changes, which flags fee-on-transfer and rebasing tokens.
+//
+// NOTE: the probe only checks the behaviour of the token at registration time.
+// It cannot detect tokens that rebase over time or that are upgraded to charge
+// a fee on transfers after the registration. The registration deposit period
+// gives governance the time to disable such token pairs.
+func (k Keeper) probeTransfer(ctx sdk.Context, contract, holder common.Address) error {
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	amount := k.BalanceOf(cacheCtx, erc20, contract, holder)
+	if amount == nil || amount.Sign() == 0 {
+		return errorsmod.Wrapf(
+			types.ErrTransferProbeFailed, "depositor %s must hold a balance of %s", holder, contract,
+		)
+	}
+
+	supply := k.TotalSupply(cacheCtx, erc20, contract)
+	if supply == nil {
+		return errorsmod.Wrapf(types.ErrTransferProbeFailed, "failed to query total supply of %s", contract)
+	}
+
+	if err := k.probeTransferStep(cacheCtx, erc20, contract, holder, types.ModuleAddress, amount); err != nil {
+		return err
+	}
+
+	if err := k.probeTransferStep(cacheCtx, erc20, contract, types.ModuleAddress, holder, amount); err != nil {
+		return err
+	}
+
+	if supplyAfter := k.TotalSupply(cacheCtx, erc20, contract); supplyAfter == nil || supplyAfter.Cmp(supply) != 0 {
+		return errorsmod.Wrapf(types.ErrTransferProbeFailed, "total supply of %s changed on transfer", contract)
+	}
+
+	return nil
+}
+
+// probeTransferStep executes a single transfer and checks the balances of the
+// sender and the receiver.
+func (k Keeper) probeTransferStep(
+	ctx sdk.Context,
+	erc20 abi.ABI,
+	contract, from, to common.Address,
+	amount *big.Int,
+) error {
+	fromBefore := k.BalanceOf(ctx, erc20, contract, from)
+	toBefore := k.BalanceOf(ctx, erc20, contract, to)
+	if fromBefore == nil || toBefore == nil {
+		return errorsmod.Wrapf(types.ErrTransferProbeFailed, "failed to query balances of %s", contract)
+	}
+
+	res, err := k.evmKeeper.CallEVM(ctx, erc20, from, contract, true, "transfer", to, amount)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrTransferProbeFailed, err.Error())
+	}
+
+	// NOTE: tokens that do not return a value on transfer are accepted
+	if len(res.Ret) > 0 {
+		var unpacked types.ERC20BoolResponse
+		if err := erc20.UnpackIntoInterface(&unpacked, "transfer", res.Ret); err != nil || !unpacked.Value {
+			return errorsmod.Wrapf(types.ErrTransferProbeFailed, "transfer of %s returned false", contract)
+		}
+	}
+
+	fromAfter := k.BalanceOf(ctx, erc20, contract, from)
+	toAfter := k.BalanceOf(ctx, erc20, contract, to)
+	if fromAfter == nil || toAfter == nil {
+		return errorsmod.Wrapf(types.ErrTransferProbeFailed, "failed to query balances of %s", contract)
+	}
+
+	if new(big.Int).Sub(fromBefore, fromAfter).Cmp(amount) != 0 ||
+		new(big.Int).Sub(toAfter, toBefore).Cmp(amount) != 0 {
+		return errorsmod.Wrapf(
+			types.ErrTransferProbeFailed, "balances of %s did not change by the transferred amount", contract,
+		)
+	}
+
+	return nil
+}
+
+// GetRegistrationDeposits returns all the pending registration deposits
+// ordered by release time.
+func (k Keeper) GetRegistrationDeposits(ctx sdk.Context) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// GetMaturedRegistrationDeposits returns the registration deposits with a
+// release time equal or prior to the given time.
+func (k Keeper) GetMaturedRegistrationDeposits(ctx sdk.Context, t time.Time) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(t)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// SetRegistrationDeposit stores a registration deposit
+func (k Keeper) SetRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	key := registrationDepositKey(deposit.ReleaseTime, common.HexToAddress(deposit.Erc20Address))
+	store.Set(key, k.cdc.MustMarshal(&deposit))
+}
+
+// DeleteRegistrationDeposit removes a registration deposit
+func (k Keeper) DeleteRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	store.Delete(registrationDepositKey(deposit.ReleaseTime, common.HexToAddress(deposit.Erc20Address)))
+}
+
+// migrateRegistrationDeposit moves the pending registration deposit of an
+// ERC20 contract to the contract its token pair was migrated to.
+func (k Keeper) migrateRegistrationDeposit(ctx sdk.Context, oldContract, newContract common.Address) {
+	for _, deposit := range k.GetRegistrationDeposits(ctx) {
+		if common.HexToAddress(deposit.Erc20Address) != oldContract {
+			continue
+		}
+
+		k.DeleteRegistrationDeposit(ctx, deposit)
+		deposit.Erc20Address = newContract.String()
+		k.SetRegistrationDeposit(ctx, deposit)
+	}
+}
+
+// ReleaseRegistrationDeposits releases the matured registration deposits. The
+// deposit is refunded if the token pair is still registered and enabled.
+// Otherwise governance has disabled or removed the pair during the deposit
+// period and the deposit is burned.
+//
+// Each deposit is released on a cached context. If the release fails, the
+// error is logged and the release is retried later instead of halting the
+// chain (see retryRegistrationDepositRelease).
+func (k Keeper) ReleaseRegistrationDeposits(ctx sdk.Context) {
+	for _, deposit := range k.GetMaturedRegistrationDeposits(ctx, ctx.BlockTime()) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.releaseRegistrationDeposit(cacheCtx, deposit); err != nil {
+			k.Logger(ctx).Error(
+				"failed to release registration deposit",
+				"erc20", deposit.Erc20Address,
+				"depositor", deposit.Depositor,
+				"attempts", deposit.ReleaseAttempts+1,
+				"error", err.Error(),
+			)
+			k.retryRegistrationDepositRelease(ctx, deposit, err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// retryRegistrationDepositRelease reschedules the release of a registration
+// deposit that failed to be released, with an exponential backoff. After
+// MaxRegistrationDepositReleaseAttempts failed attempts, the deposit is moved to
+// the failed registration deposits and is no longer retried. Its amount is kept
+// escrowed in the module account.
+func (k Keeper) retryRegistrationDepositRelease(ctx sdk.Context, deposit types.RegistrationDeposit, releaseErr error) {
+	k.DeleteRegistrationDeposit(ctx, deposit)
+	deposit.ReleaseAttempts++
+
+	eventType := types.EventTypeFailedRegistration
+	if deposit.ReleaseAttempts < types.MaxRegistrationDepositReleaseAttempts {
+		deposit.ReleaseTime = deposit.NextReleaseTime(ctx.BlockTime())
+		k.SetRegistrationDeposit(ctx, deposit)
+		eventType = types.EventTypeRetryRegistration
+	} else {
+		k.SetFailedRegistrationDeposit(ctx, deposit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAttempts, strconv.FormatUint(uint64(deposit.ReleaseAttempts), 10)),
+			sdk.NewAttribute(types.AttributeKeyReleaseTime, deposit.ReleaseTime.String()),
+			sdk.NewAttribute(types.AttributeKeyError, releaseErr.Error()),
+		),
+	)
+}
+
+// GetFailedRegistrationDeposits returns all the registration deposits that
+// could not be released after MaxRegistrationDepositReleaseAttempts attempts.
+func (k Keeper) GetFailedRegistrationDeposits(ctx sdk.Context) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedRegistrationDeposit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// SetFailedRegistrationDeposit stores a registration deposit that could not be
+// released, keyed by its ERC20 contract.
+func (k Keeper) SetFailedRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedRegistrationDeposit)
+	store.Set(common.HexToAddress(deposit.Erc20Address).Bytes(), k.cdc.MustMarshal(&deposit))
+}
+
+// releaseRegistrationDeposit refunds or burns a matured registration deposit
+// and deletes it from the store.
+func (k Keeper) releaseRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) error {
+	k.DeleteRegistrationDeposit(ctx, deposit)
+
+	eventType := types.EventTypeBurnRegistration
+	id := k.GetTokenPairID(ctx, deposit.Erc20Address)
+	pair, found := k.GetTokenPair(ctx, id)
+	if found && pair.Enabled {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount); err != nil {
+			return errorsmod.Wrapf(err, "failed to refund registration deposit of %s", deposit.Erc20Address)
+		}
+		eventType = types.EventTypeRefundRegistration
+	} else if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount); err != nil {
+		return errorsmod.Wrapf(err, "failed to burn registration deposit of %s", deposit.Erc20Address)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.Amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// registrationDepositKey returns the store key of a registration deposit:
+// releaseTime | erc20
+func registrationDepositKey(releaseTime time.Time, contract common.Address) []byte {
+	timeBz := sdk.FormatTimeBytes(releaseTime)
+	key := make([]byte, 0, len(timeBz)+common.AddressLength)
+	key = append(key, timeBz...)
+	return append(key, contract.Bytes()...)
+}
@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/contracts"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestPermissionlessRegisterERC20() {
+	var (
+		ctx      sdk.Context
+		contract common.Address
+		params   types.Params
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		errMsg   string
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func() {
+				params.EnablePermissionlessRegistration = false
+			},
+			false,
+			types.ErrPermissionlessDisabled.Error(),
+		},
+		{
+			"fail - denylisted contract",
+			func() {
+				params.RegistrationDenylist = []string{contract.Hex()}
+			},
+			false,
+			types.ErrERC20Denylisted.Error(),
+		},
+		{
+			"fail - not a contract",
+			func() {
+				contract = utiltx.GenerateAddress()
+			},
+			false,
+			"is not a contract",
+		},
+		{
+			"fail - depositor without token balance",
+			func() {
+				var err error
+				contract, err = suite.DeployContract("coin", "token", erc20Decimals)
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+			},
+			false,
+			"must hold a balance",
+		},
+		{
+			"fail - fee on transfer token",
+			func() {
+				var err error
+				contract, err = suite.DeployContractDirectBalanceManipulation()
+				suite.Require().NoError(err)
+				ctx = suite.network.GetContext()
+			},
+			false,
+			"did not change by the transferred amount",
+		},
+		{
+			"pass",
+			func() {},
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			contract, err = suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			_, err = suite.MintERC20Token(contract, suite.keyring.GetAddr(0), big.NewInt(1000))
+			suite.Require().NoError(err)
+
+			ctx = suite.network.GetContext()
+			baseDenom, err := sdk.GetBaseDenom()
+			suite.Require().NoError(err)
+			deposit := sdk.NewCoins(sdk.NewCoin(baseDenom, math.NewInt(1000)))
+
+			params = suite.network.App.Erc20Keeper.GetParams(ctx)
+			params.EnablePermissionlessRegistration = true
+			params.RegistrationDeposit = deposit
+
+			tc.malleate()
+			suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+
+			sender := suite.keyring.GetAccAddr(0)
+			balanceBefore := suite.network.App.BankKeeper.GetBalance(ctx, sender, baseDenom)
+
+			res, err := suite.network.App.Erc20Keeper.PermissionlessRegisterERC20(ctx, &types.MsgPermissionlessRegisterERC20{
+				Sender:       sender.String(),
+				Erc20Address: contract.Hex(),
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, tc.errMsg)
+				suite.Require().False(suite.network.App.Erc20Keeper.IsERC20Registered(ctx, contract))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.CreateDenom(contract.String()), res.Denom)
+
+			id := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, contract.String())
+			pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+			suite.Require().True(found)
+			suite.Require().True(pair.Enabled)
+			suite.Require().Equal(types.OWNER_EXTERNAL, pair.ContractOwner)
+
+			// the transfer probe must not change the token balances
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			balance := suite.network.App.Erc20Keeper.BalanceOf(ctx, erc20, contract, suite.keyring.GetAddr(0))
+			suite.Require().Equal(big.NewInt(1000), balance)
+
+			balanceAfter := suite.network.App.BankKeeper.GetBalance(ctx, sender, baseDenom)
+			suite.Require().Equal(balanceBefore.Sub(deposit[0]), balanceAfter)
+
+			deposits := suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx)
+			suite.Require().Len(deposits, 1)
+			suite.Require().Equal(sender.String(), deposits[0].Depositor)
+			suite.Require().Equal(deposit, deposits[0].Amount)
+			suite.Require().Equal(ctx.BlockTime().Add(params.RegistrationDepositPeriod), deposits[0].ReleaseTime)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReleaseRegistrationDeposits() {
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context, contract common.Address)
+		expRefund bool
+		expRetry  bool
+	}{
+		{
+			"refund - token pair enabled",
+			func(sdk.Context, common.Address) {},
+			true,
+			false,
+		},
+		{
+			"burn - token pair disabled by governance",
+			func(ctx sdk.Context, contract common.Address) {
+				_, err := suite.network.App.Erc20Keeper.ToggleConversion(ctx, &types.MsgToggleConversion{
+					Authority: authtypes.NewModuleAddress("gov").String(),
+					Token:     contract.Hex(),
+				})
+				suite.Require().NoError(err)
+			},
+			false,
+			false,
+		},
+		{
+			"retry - refund to a blocked address fails",
+			func(ctx sdk.Context, _ common.Address) {
+				deposit := suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx)[0]
+				deposit.Depositor = authtypes.NewModuleAddress("gov").String()
+				suite.network.App.Erc20Keeper.SetRegistrationDeposit(ctx, deposit)
+			},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contract, err := suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			_, err = suite.MintERC20Token(contract, suite.keyring.GetAddr(0), big.NewInt(1000))
+			suite.Require().NoError(err)
+
+			ctx := suite.network.GetContext()
+			baseDenom, err := sdk.GetBaseDenom()
+			suite.Require().NoError(err)
+			deposit := sdk.NewCoin(baseDenom, math.NewInt(1000))
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			params.EnablePermissionlessRegistration = true
+			params.RegistrationDeposit = sdk.NewCoins(deposit)
+			suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+
+			sender := suite.keyring.GetAccAddr(0)
+			_, err = suite.network.App.Erc20Keeper.PermissionlessRegisterERC20(ctx, &types.MsgPermissionlessRegisterERC20{
+				Sender:       sender.String(),
+				Erc20Address: contract.Hex(),
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate(ctx, contract)
+
+			balanceBefore := suite.network.App.BankKeeper.GetBalance(ctx, sender, baseDenom)
+			supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, baseDenom)
+
+			// deposits are not released before the end of the deposit period
+			suite.Require().NoError(suite.network.App.Erc20Keeper.EndBlock(ctx))
+			suite.Require().Len(suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx), 1)
+
+			releaseCtx := ctx.WithBlockTime(ctx.BlockTime().Add(params.RegistrationDepositPeriod))
+			suite.Require().NoError(suite.network.App.Erc20Keeper.EndBlock(releaseCtx))
+
+			balanceAfter := suite.network.App.BankKeeper.GetBalance(ctx, sender, baseDenom)
+			supplyAfter := suite.network.App.BankKeeper.GetSupply(ctx, baseDenom)
+			if tc.expRetry {
+				// the failed release is rescheduled with a backoff
+				deposits := suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx)
+				suite.Require().Len(deposits, 1)
+				suite.Require().Equal(uint32(1), deposits[0].ReleaseAttempts)
+				suite.Require().Equal(releaseCtx.BlockTime().Add(types.RegistrationDepositRetryDelay), deposits[0].ReleaseTime)
+				suite.Require().True(utils.ContainsEventType(releaseCtx.EventManager().ABCIEvents(), types.EventTypeRetryRegistration))
+				suite.Require().Equal(balanceBefore, balanceAfter)
+				suite.Require().Equal(supplyBefore, supplyAfter)
+
+				// the release is not retried before the backoff ends
+				suite.Require().NoError(suite.network.App.Erc20Keeper.EndBlock(releaseCtx))
+				suite.Require().Equal(deposits, suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx))
+
+				// the deposit is moved to the failed deposits after the last attempt
+				for attempts := uint32(1); attempts < types.MaxRegistrationDepositReleaseAttempts; attempts++ {
+					deposits = suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx)
+					suite.Require().Len(deposits, 1)
+					suite.Require().Equal(attempts, deposits[0].ReleaseAttempts)
+
+					releaseCtx = ctx.WithBlockTime(deposits[0].ReleaseTime).WithEventManager(sdk.NewEventManager())
+					suite.Require().NoError(suite.network.App.Erc20Keeper.EndBlock(releaseCtx))
+				}
+				suite.Require().Empty(suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx))
+				suite.Require().True(utils.ContainsEventType(releaseCtx.EventManager().ABCIEvents(), types.EventTypeFailedRegistration))
+
+				failed := suite.network.App.Erc20Keeper.GetFailedRegistrationDeposits(ctx)
+				suite.Require().Len(failed, 1)
+				suite.Require().Equal(uint32(types.MaxRegistrationDepositReleaseAttempts), failed[0].ReleaseAttempts)
+				suite.Require().Equal(supplyBefore, suite.network.App.BankKeeper.GetSupply(ctx, baseDenom))
+				return
+			}
+
+			suite.Require().Empty(suite.network.App.Erc20Keeper.GetRegistrationDeposits(ctx))
+			if tc.expRefund {
+				suite.Require().Equal(balanceBefore.Add(deposit), balanceAfter)
+				suite.Require().Equal(supplyBefore, supplyAfter)
+			} else {
+				suite.Require().Equal(balanceBefore, balanceAfter)
+				suite.Require().Equal(supplyBefore.Sub(deposit), supplyAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// CreateFactoryDenom creates a new token factory denomination for the creator,
+// stores its bank metadata and registers the ERC20 extension for it. The
+// creator is set as the denom admin, which is stored as the token pair minter.
+// The denom creation fee set by governance is paid by the creator and burned.
+// Denominations cannot be created while the fee is not set.
+func (k Keeper) CreateFactoryDenom(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	subdenom, name, symbol string,
+	decimals uint32,
+) (types.TokenPair, error) {
+	denom, err := types.GetFactoryDenom(creator.String(), subdenom)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if decimals > types.MaxFactoryDenomDecimals {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "decimals must be at most %d", types.MaxFactoryDenomDecimals)
+	}
+
+	if k.IsDenomRegistered(ctx, denom) || k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrFactoryDenomExists, "denom: %s", denom)
+	}
+
+	metadata := types.NewFactoryDenomMetadata(denom, name, symbol, decimals)
+	if err := metadata.Validate(); err != nil {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "invalid metadata: %s", err)
+	}
+
+	if err := k.burnDenomCreationFee(ctx, creator); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	pair, err := k.RegisterERC20Extension(ctx, denom)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair.Minter = common.BytesToAddress(creator).Hex()
+	k.SetTokenPair(ctx, *pair)
+	return *pair, nil
+}
+
+// MintFactoryDenom mints the coin of a token factory denomination to the
+// recipient. The admin must be the current denom admin and the token pair must
+// be enabled.
+func (k Keeper) MintFactoryDenom(
+	ctx sdk.Context,
+	admin common.Address,
+	coin sdk.Coin,
+	recipient sdk.AccAddress,
+) error {
+	pair, err := k.getFactoryTokenPair(ctx, admin, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !pair.Enabled {
+		return errorsmod.Wrapf(types.ErrERC20TokenPairDisabled, "denom: %s", coin.Denom)
+	}
+
+	coins := sdk.Coins{coin}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+}
+
+// BurnFactoryDenom burns the coin of a token factory denomination from the
+// balance of the admin. The admin must be the current denom admin and the
+// token pair must be enabled.
+func (k Keeper) BurnFactoryDenom(
+	ctx sdk.Context,
+	admin common.Address,
+	coin sdk.Coin,
+) error {
+	pair, err := k.getFactoryTokenPair(ctx, admin, coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	if !pair.Enabled {
+		return errorsmod.Wrapf(types.ErrERC20TokenPairDisabled, "denom: %s", coin.Denom)
+	}
+
+	coins := sdk.Coins{coin}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, admin.Bytes(), types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ChangeFactoryDenomAdmin sets the new admin of a token factory denomination.
+// The admin must be the current denom admin. A zero new admin address renounces
+// the admin permissions.
+func (k Keeper) ChangeFactoryDenomAdmin(
+	ctx sdk.Context,
+	admin common.Address,
+	denom string,
+	newAdmin common.Address,
+) (types.TokenPair, error) {
+	pair, err := k.getFactoryTokenPair(ctx, admin, denom)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair.Minter = ""
+	if newAdmin != (common.Address{}) {
+		pair.Minter = newAdmin.Hex()
+	}
+
+	k.SetTokenPair(ctx, pair)
+	return pair, nil
+}
+
+// SetFactoryDenomMetadata overwrites the bank metadata of a token factory
+// denomination. The admin must be the current denom admin.
+func (k Keeper) SetFactoryDenomMetadata(
+	ctx sdk.Context,
+	admin common.Address,
+	metadata banktypes.Metadata,
+) error {
+	if _, err := k.getFactoryTokenPair(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "invalid metadata: %s", err)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// burnDenomCreationFee charges the denom creation fee to the creator and burns it.
+func (k Keeper) burnDenomCreationFee(ctx sdk.Context, creator sdk.AccAddress) error {
+	fee := k.getDenomCreationFee(ctx)
+	if fee.IsZero() {
+		return errorsmod.Wrap(types.ErrDenomCreationDisabled, "the denom creation fee is not set")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, fee); err != nil {
+		return errorsmod.Wrap(err, "failed to pay denom creation fee")
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
+
+// getFactoryTokenPair returns the token pair of a token factory denomination
+// and checks that the given address is the denom admin.
+func (k Keeper) getFactoryTokenPair(ctx sdk.Context, admin common.Address, denom string) (types.TokenPair, error) {
+	if _, _, err := types.DeconstructFactoryDenom(denom); err != nil {
+		return types.TokenPair{}, err
+	}
+
+	id := k.GetDenomMap(ctx, denom)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "denom: %s", denom)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "denom: %s", denom)
+	}
+
+	if !pair.IsMinter(admin) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrUnauthorizedDenomAdmin, "denom: %s, address: %s", denom, admin)
+	}
+
+	return pair, nil
+}
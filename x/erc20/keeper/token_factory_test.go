@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		subdenom string
+		decimals uint32
+		expPass  bool
+	}{
+		{
+			"fail - erc20 disabled",
+			func() {
+				params := suite.network.App.Erc20Keeper.GetParams(suite.network.GetContext())
+				params.EnableErc20 = false
+				suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(suite.network.GetContext(), params))
+			},
+			"token",
+			18,
+			false,
+		},
+		{
+			"fail - invalid subdenom",
+			func() {},
+			"",
+			18,
+			false,
+		},
+		{
+			"fail - decimals overflow",
+			func() {},
+			"token",
+			256,
+			false,
+		},
+		{
+			"fail - denom already exists",
+			func() {
+				_, err := suite.network.App.Erc20Keeper.CreateFactoryDenom(
+					suite.network.GetContext(), suite.keyring.GetAccAddr(0), "token", "Token", "TKN", 18,
+				)
+				suite.Require().NoError(err)
+			},
+			"token",
+			18,
+			false,
+		},
+		{
+			"pass",
+			func() {},
+			"token",
+			18,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			sender := suite.keyring.GetAccAddr(0)
+
+			tc.malleate()
+
+			res, err := suite.network.App.Erc20Keeper.CreateDenom(ctx, &types.MsgCreateDenom{
+				Sender:   sender.String(),
+				Subdenom: tc.subdenom,
+				Name:     "Token",
+				Symbol:   "TKN",
+				Decimals: tc.decimals,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			expDenom := "factory/" + sender.String() + "/" + tc.subdenom
+			suite.Require().Equal(expDenom, res.Denom)
+			suite.Require().Equal(types.GetFactoryDenomAddress(expDenom).Hex(), res.Erc20Address)
+
+			id := suite.network.App.Erc20Keeper.GetTokenPairID(ctx, res.Denom)
+			pair, found := suite.network.App.Erc20Keeper.GetTokenPair(ctx, id)
+			suite.Require().True(found)
+			suite.Require().True(pair.IsNativeCoin())
+			suite.Require().Equal(common.BytesToAddress(sender).Hex(), pair.Minter)
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			suite.Require().Contains(params.DynamicPrecompiles, res.Erc20Address)
+
+			metadata, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, res.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal("Token", metadata.Name)
+			suite.Require().Equal("TKN", metadata.Symbol)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateDenomFee() {
+	testCases := []struct {
+		name    string
+		fee     math.Int
+		expPass bool
+	}{
+		{
+			"fail - fee not set",
+			math.ZeroInt(),
+			false,
+		},
+		{
+			"fail - insufficient balance for the fee",
+			math.NewInt(1e18).MulRaw(1e6),
+			false,
+		},
+		{
+			"pass - fee is burned",
+			math.NewInt(1e18),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.network.GetContext()
+			sender := suite.keyring.GetAccAddr(0)
+			denom := suite.network.GetDenom()
+
+			params := suite.network.App.Erc20Keeper.GetParams(ctx)
+			params.DenomCreationFee = sdk.NewCoins(sdk.NewCoin(denom, tc.fee))
+			suite.Require().NoError(suite.network.App.Erc20Keeper.SetParams(ctx, params))
+
+			balanceBefore := suite.network.App.BankKeeper.GetBalance(ctx, sender, denom)
+			supplyBefore := suite.network.App.BankKeeper.GetSupply(ctx, denom)
+
+			_, err := suite.network.App.Erc20Keeper.CreateFactoryDenom(ctx, sender, "token", "Token", "TKN", 18)
+			if !tc.expPass {
+				if tc.fee.IsZero() {
+					suite.Require().ErrorIs(err, types.ErrDenomCreationDisabled)
+				} else {
+					suite.Require().ErrorContains(err, "failed to pay denom creation fee")
+				}
+				return
+			}
+			suite.Require().NoError(err)
+
+			balanceAfter := suite.network.App.BankKeeper.GetBalance(ctx, sender, denom)
+			suite.Require().Equal(tc.fee, balanceBefore.Amount.Sub(balanceAfter.Amount), "expected the creator to pay the fee")
+			supplyAfter := suite.network.App.BankKeeper.GetSupply(ctx, denom)
+			suite.Require().Equal(tc.fee, supplyBefore.Amount.Sub(supplyAfter.Amount), "expected the fee to be burned")
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenFactoryAdminMsgs() {
+	var (
+		ctx   sdk.Context
+		denom string
+	)
+
+	testCases := []struct {
+		name      string
+		senderIdx int
+		msgs      func(sender, other sdk.AccAddress) []sdk.Msg
+		expPass   bool
+		postCheck func(sender, other sdk.AccAddress)
+	}{
+		{
+			"fail - mint by non admin",
+			1,
+			func(sender, _ sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{&types.MsgMint{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 100)}}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - mint to sender and receiver",
+			0,
+			func(sender, other sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&types.MsgMint{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+					&types.MsgMint{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 50), MintToAddress: other.String()},
+				}
+			},
+			true,
+			func(sender, other sdk.AccAddress) {
+				suite.Require().Equal(math.NewInt(100), suite.network.App.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+				suite.Require().Equal(math.NewInt(50), suite.network.App.BankKeeper.GetBalance(ctx, other, denom).Amount)
+			},
+		},
+		{
+			"pass - mint and burn",
+			0,
+			func(sender, _ sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&types.MsgMint{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+					&types.MsgBurn{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 40)},
+				}
+			},
+			true,
+			func(sender, _ sdk.AccAddress) {
+				suite.Require().Equal(math.NewInt(60), suite.network.App.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+				suite.Require().Equal(math.NewInt(60), suite.network.App.BankKeeper.GetSupply(ctx, denom).Amount)
+			},
+		},
+		{
+			"fail - burn more than balance",
+			0,
+			func(sender, _ sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{&types.MsgBurn{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 1)}}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - mint after changing admin",
+			0,
+			func(sender, other sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&types.MsgChangeAdmin{Sender: sender.String(), Denom: denom, NewAdmin: other.String()},
+					&types.MsgMint{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - new admin mints",
+			0,
+			func(sender, other sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&types.MsgChangeAdmin{Sender: sender.String(), Denom: denom, NewAdmin: other.String()},
+					&types.MsgMint{Sender: other.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+				}
+			},
+			true,
+			func(_, other sdk.AccAddress) {
+				suite.Require().Equal(math.NewInt(100), suite.network.App.BankKeeper.GetBalance(ctx, other, denom).Amount)
+			},
+		},
+		{
+			"fail - mint after renouncing admin",
+			0,
+			func(sender, _ sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{
+					&types.MsgChangeAdmin{Sender: sender.String(), Denom: denom},
+					&types.MsgMint{Sender: sender.String(), Amount: sdk.NewInt64Coin(denom, 100)},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - set metadata of another denom",
+			0,
+			func(sender, _ sdk.AccAddress) []sdk.Msg {
+				metadata := types.NewFactoryDenomMetadata("factory/"+sender.String()+"/other", "Other", "OTH", 6)
+				return []sdk.Msg{&types.MsgSetDenomMetadata{Sender: sender.String(), Metadata: metadata}}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - set metadata",
+			0,
+			func(sender, _ sdk.AccAddress) []sdk.Msg {
+				metadata := types.NewFactoryDenomMetadata(denom, "New Token", "NTKN", 6)
+				return []sdk.Msg{&types.MsgSetDenomMetadata{Sender: sender.String(), Metadata: metadata}}
+			},
+			true,
+			func(_, _ sdk.AccAddress) {
+				metadata, found := suite.network.App.BankKeeper.GetDenomMetaData(ctx, denom)
+				suite.Require().True(found)
+				suite.Require().Equal("New Token", metadata.Name)
+				suite.Require().Equal("ntkn", metadata.Display)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx = suite.network.GetContext()
+			admin := suite.keyring.GetAccAddr(0)
+			sender := suite.keyring.GetAccAddr(tc.senderIdx)
+			other := suite.keyring.GetAccAddr(1)
+
+			pair, err := suite.network.App.Erc20Keeper.CreateFactoryDenom(ctx, admin, "token", "Token", "TKN", 18)
+			suite.Require().NoError(err)
+			denom = pair.Denom
+
+			msgs := tc.msgs(sender, other)
+			for i, msg := range msgs {
+				switch msg := msg.(type) {
+				case *types.MsgMint:
+					_, err = suite.network.App.Erc20Keeper.Mint(ctx, msg)
+				case *types.MsgBurn:
+					_, err = suite.network.App.Erc20Keeper.Burn(ctx, msg)
+				case *types.MsgChangeAdmin:
+					_, err = suite.network.App.Erc20Keeper.ChangeAdmin(ctx, msg)
+				case *types.MsgSetDenomMetadata:
+					_, err = suite.network.App.Erc20Keeper.SetDenomMetadata(ctx, msg)
+				}
+				if i < len(msgs)-1 {
+					suite.Require().NoError(err)
+				}
+			}
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			tc.postCheck(sender, other)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFactoryDenomDisabledPair() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+	admin := suite.keyring.GetAccAddr(0)
+
+	pair, err := suite.network.App.Erc20Keeper.CreateFactoryDenom(ctx, admin, "token", "Token", "TKN", 18)
+	suite.Require().NoError(err)
+	coin := sdk.NewInt64Coin(pair.Denom, 100)
+	suite.Require().NoError(suite.network.App.Erc20Keeper.MintFactoryDenom(ctx, common.BytesToAddress(admin), coin, admin))
+
+	_, err = suite.network.App.Erc20Keeper.ToggleConversion(ctx, &types.MsgToggleConversion{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Token:     pair.Denom,
+	})
+	suite.Require().NoError(err)
+
+	err = suite.network.App.Erc20Keeper.MintFactoryDenom(ctx, common.BytesToAddress(admin), coin, admin)
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+	err = suite.network.App.Erc20Keeper.BurnFactoryDenom(ctx, common.BytesToAddress(admin), coin)
+	suite.Require().ErrorIs(err, types.ErrERC20TokenPairDisabled)
+}
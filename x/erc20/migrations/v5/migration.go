@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+// MigrateStore migrates the x/erc20 module state from the consensus version 4 to
+// version 5. Specifically, it stores the default permissionless registration
+// deposit period. The permissionless registration remains disabled.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	period := uint64(types.DefaultRegistrationDepositPeriod)
+	store.Set(types.ParamStoreKeyRegistrationDepositPeriod, sdk.Uint64ToBigEndian(period))
+
+	return nil
+}
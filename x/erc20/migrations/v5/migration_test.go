@@ -0,0 +1,26 @@
+package v5_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v5 "github.com/evmos/evmos/v20/x/erc20/migrations/v5"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey))
+
+	bz := store.Get(types.ParamStoreKeyRegistrationDepositPeriod)
+	require.Equal(t, uint64(types.DefaultRegistrationDepositPeriod), sdk.BigEndianToUint64(bz))
+	require.False(t, store.Has(types.ParamStoreKeyEnablePermissionlessRegistration))
+}
@@ -0,0 +1,199 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// DefaultCallbackGasLimit is the gas limit used for a contract callback
+	// when the packet memo does not specify one.
+	DefaultCallbackGasLimit uint64 = 200_000
+	// MaxCallbackGasLimit is the maximum gas limit that a contract callback
+	// can request through the packet memo.
+	MaxCallbackGasLimit uint64 = 1_000_000
+
+	// OnAckCallbackMethod is the name of the contract method called when
+	// an ICS20 packet sent by the contract is acknowledged.
+	OnAckCallbackMethod = "onAck"
+	// OnTimeoutCallbackMethod is the name of the contract method called when
+	// an ICS20 packet sent by the contract times out.
+	OnTimeoutCallbackMethod = "onTimeout"
+
+	// callbackSenderPrefix is used to derive the address that executes the
+	// destination callbacks on behalf of the packet sender.
+	callbackSenderPrefix = "ibc-callback"
+)
+
+// CallbacksABI defines the interface that contracts must implement to receive
+// the source callbacks of the ICS20 packets they send.
+var CallbacksABI abi.ABI
+
+func init() {
+	stringType, _ := abi.NewType("string", "", nil)
+	uint64Type, _ := abi.NewType("uint64", "", nil)
+	boolType, _ := abi.NewType("bool", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+
+	onAck := abi.NewMethod(
+		OnAckCallbackMethod, OnAckCallbackMethod, abi.Function, "nonpayable", false, false,
+		abi.Arguments{
+			{Name: "sourcePort", Type: stringType},
+			{Name: "sourceChannel", Type: stringType},
+			{Name: "sequence", Type: uint64Type},
+			{Name: "success", Type: boolType},
+			{Name: "acknowledgement", Type: bytesType},
+		},
+		nil,
+	)
+	onTimeout := abi.NewMethod(
+		OnTimeoutCallbackMethod, OnTimeoutCallbackMethod, abi.Function, "nonpayable", false, false,
+		abi.Arguments{
+			{Name: "sourcePort", Type: stringType},
+			{Name: "sourceChannel", Type: stringType},
+			{Name: "sequence", Type: uint64Type},
+		},
+		nil,
+	)
+
+	CallbacksABI = abi.ABI{
+		Methods: map[string]abi.Method{
+			OnAckCallbackMethod:     onAck,
+			OnTimeoutCallbackMethod: onTimeout,
+		},
+	}
+}
+
+// CallbackMemo defines the callback entries of an ICS20 packet memo.
+// The keys follow the ADR-8 callbacks memo format:
+//
+//	{
+//	  "src_callback": {"address": "0x...", "gas_limit": "200000"},
+//	  "dest_callback": {"address": "0x...", "calldata": "0x...", "gas_limit": "200000"}
+//	}
+type CallbackMemo struct {
+	// SrcCallback is executed on the sending chain when the packet is
+	// acknowledged or times out.
+	SrcCallback *CallbackData `json:"src_callback,omitempty"`
+	// DestCallback is executed on the receiving chain after the funds
+	// have been received.
+	DestCallback *CallbackData `json:"dest_callback,omitempty"`
+}
+
+// CallbackData defines the contract to call and the gas limit for its
+// execution.
+type CallbackData struct {
+	// Address is the hex address of the contract to call.
+	Address string `json:"address"`
+	// Calldata is the hex encoded ABI data used on destination callbacks.
+	Calldata string `json:"calldata,omitempty"`
+	// GasLimit is the gas limit for the callback execution. It is capped
+	// at MaxCallbackGasLimit.
+	GasLimit string `json:"gas_limit,omitempty"`
+}
+
+// ParseCallbackMemo parses the callback entries from an ICS20 packet memo.
+// It returns an empty CallbackMemo if the memo is not a JSON object, since
+// the memo can be used for any other purpose.
+func ParseCallbackMemo(memo string) (CallbackMemo, error) {
+	var callbackMemo CallbackMemo
+
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return callbackMemo, nil
+	}
+
+	if err := json.Unmarshal([]byte(memo), &callbackMemo); err != nil {
+		// the memo is not intended for callbacks
+		return CallbackMemo{}, nil
+	}
+
+	if callbackMemo.SrcCallback != nil {
+		if err := callbackMemo.SrcCallback.Validate(); err != nil {
+			return CallbackMemo{}, errorsmod.Wrap(err, "invalid source callback")
+		}
+	}
+
+	if callbackMemo.DestCallback != nil {
+		if err := callbackMemo.DestCallback.Validate(); err != nil {
+			return CallbackMemo{}, errorsmod.Wrap(err, "invalid destination callback")
+		}
+	}
+
+	return callbackMemo, nil
+}
+
+// Validate performs a stateless validation of the callback data.
+func (cd CallbackData) Validate() error {
+	if !common.IsHexAddress(cd.Address) {
+		return errorsmod.Wrapf(ErrInvalidCallback, "invalid contract address %q", cd.Address)
+	}
+
+	if cd.Calldata != "" {
+		if _, err := hexutil.Decode(cd.Calldata); err != nil {
+			return errorsmod.Wrapf(ErrInvalidCallback, "invalid calldata: %s", err)
+		}
+	}
+
+	if cd.GasLimit != "" {
+		gasLimit, err := strconv.ParseUint(cd.GasLimit, 10, 64)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidCallback, "invalid gas limit %q", cd.GasLimit)
+		}
+		if gasLimit == 0 {
+			return errorsmod.Wrap(ErrInvalidCallback, "gas limit cannot be zero")
+		}
+	}
+
+	return nil
+}
+
+// ContractAddress returns the hex address of the callback contract.
+func (cd CallbackData) ContractAddress() common.Address {
+	return common.HexToAddress(cd.Address)
+}
+
+// Input returns the decoded calldata of the callback.
+// CONTRACT: the callback data must have been validated.
+func (cd CallbackData) Input() []byte {
+	if cd.Calldata == "" {
+		return nil
+	}
+	input, _ := hexutil.Decode(cd.Calldata)
+	return input
+}
+
+// GetGasLimit returns the gas limit of the callback, defaulting to
+// DefaultCallbackGasLimit and capped at MaxCallbackGasLimit.
+// CONTRACT: the callback data must have been validated.
+func (cd CallbackData) GetGasLimit() uint64 {
+	if cd.GasLimit == "" {
+		return DefaultCallbackGasLimit
+	}
+
+	gasLimit, _ := strconv.ParseUint(cd.GasLimit, 10, 64)
+	if gasLimit > MaxCallbackGasLimit {
+		return MaxCallbackGasLimit
+	}
+	return gasLimit
+}
+
+// GenerateCallbackSender returns the address used as caller of a destination
+// callback. It is derived from the destination channel and the original packet
+// sender, so that contracts cannot be called on behalf of local accounts:
+//
+//	keccak256("ibc-callback" | "/" | channel | "/" | sender)[12:]
+func GenerateCallbackSender(channel, sender string) common.Address {
+	hash := crypto.Keccak256([]byte(callbackSenderPrefix + "/" + channel + "/" + sender))
+	return common.BytesToAddress(hash)
+}
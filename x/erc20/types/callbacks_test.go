@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/erc20/types"
+)
+
+func TestParseCallbackMemo(t *testing.T) {
+	contract := "0xdAC17F958D2ee523a2206206994597C13D831ec7"
+
+	testCases := []struct {
+		name        string
+		memo        string
+		expSrc      bool
+		expDest     bool
+		expGasLimit uint64
+		expError    bool
+	}{
+		{"empty memo", "", false, false, 0, false},
+		{"plain text memo", "hello world", false, false, 0, false},
+		{"invalid json memo", "{hello", false, false, 0, false},
+		{"json memo without callbacks", `{"forward": {"receiver": "cosmos1"}}`, false, false, 0, false},
+		{
+			"source callback with default gas limit",
+			`{"src_callback": {"address": "` + contract + `"}}`,
+			true, false, types.DefaultCallbackGasLimit, false,
+		},
+		{
+			"destination callback with calldata",
+			`{"dest_callback": {"address": "` + contract + `", "calldata": "0xa9059cbb", "gas_limit": "300000"}}`,
+			false, true, 300_000, false,
+		},
+		{
+			"gas limit is capped",
+			`{"dest_callback": {"address": "` + contract + `", "gas_limit": "100000000"}}`,
+			false, true, types.MaxCallbackGasLimit, false,
+		},
+		{"invalid address", `{"src_callback": {"address": "evmos1"}}`, false, false, 0, true},
+		{"invalid calldata", `{"dest_callback": {"address": "` + contract + `", "calldata": "a9059cbb"}}`, false, false, 0, true},
+		{"invalid gas limit", `{"dest_callback": {"address": "` + contract + `", "gas_limit": "-1"}}`, false, false, 0, true},
+		{"zero gas limit", `{"src_callback": {"address": "` + contract + `", "gas_limit": "0"}}`, false, false, 0, true},
+	}
+
+	for _, tc := range testCases {
+		memo, err := types.ParseCallbackMemo(tc.memo)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expSrc, memo.SrcCallback != nil, tc.name)
+		require.Equal(t, tc.expDest, memo.DestCallback != nil, tc.name)
+
+		if memo.SrcCallback != nil {
+			require.Equal(t, tc.expGasLimit, memo.SrcCallback.GetGasLimit(), tc.name)
+			require.Equal(t, contract, memo.SrcCallback.ContractAddress().Hex(), tc.name)
+		}
+		if memo.DestCallback != nil {
+			require.Equal(t, tc.expGasLimit, memo.DestCallback.GetGasLimit(), tc.name)
+			require.Equal(t, contract, memo.DestCallback.ContractAddress().Hex(), tc.name)
+		}
+	}
+}
+
+func TestGenerateCallbackSender(t *testing.T) {
+	sender := "cosmos1qql8ag4cluz6r4dz28p3w00dnc9w8ueulg2gmc"
+
+	addr := types.GenerateCallbackSender("channel-0", sender)
+	require.Equal(t, addr, types.GenerateCallbackSender("channel-0", sender))
+	require.NotEqual(t, addr, types.GenerateCallbackSender("channel-1", sender))
+	require.NotEqual(t, addr, types.GenerateCallbackSender("channel-0", "cosmos1"))
+}
+
+func TestCallbacksABI(t *testing.T) {
+	input, err := types.CallbacksABI.Pack(types.OnAckCallbackMethod, "transfer", "channel-0", uint64(1), true, []byte(`{"result":"AQ=="}`))
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256([]byte("onAck(string,string,uint64,bool,bytes)"))[:4], input[:4])
+
+	input, err = types.CallbacksABI.Pack(types.OnTimeoutCallbackMethod, "transfer", "channel-0", uint64(1))
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256([]byte("onTimeout(string,string,uint64)"))[:4], input[:4])
+}
@@ -32,6 +32,19 @@ const (
 	updateParams     = "evmos/erc20/MsgUpdateParams"
 	registerERC20    = "evmos/erc20/MsgRegisterERC20"
 	toggleConversion = "evmos/erc20/MsgToggleConversion"
+	setMinter        = "evmos/erc20/MsgSetTokenPairMinter"
+	createDenom      = "evmos/erc20/MsgCreateDenom"
+	mint             = "evmos/erc20/MsgMint"
+	burn             = "evmos/erc20/MsgBurn"
+	changeAdmin      = "evmos/erc20/MsgChangeAdmin"
+	setDenomMetadata = "evmos/erc20/MsgSetDenomMetadata"
+	permissionless   = "evmos/erc20/MsgPermissionlessRegister"
+	updateMetadata   = "evmos/erc20/MsgUpdatePairMetadata"
+	migratePair      = "evmos/erc20/MsgMigrateTokenPair"
+	removePair       = "evmos/erc20/MsgRemoveTokenPair"
+	registerFeeToken = "evmos/erc20/MsgRegisterFeeToken"
+	removeFeeToken   = "evmos/erc20/MsgRemoveFeeToken"
+	updateFeePrice   = "evmos/erc20/MsgUpdateFeeTokenPrice"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -49,6 +62,19 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgSetTokenPairMinter{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgPermissionlessRegisterERC20{},
+		&MsgUpdateTokenPairMetadata{},
+		&MsgMigrateTokenPair{},
+		&MsgRemoveTokenPair{},
+		&MsgRegisterFeeToken{},
+		&MsgRemoveFeeToken{},
+		&MsgUpdateFeeTokenPrice{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -69,4 +95,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgSetTokenPairMinter{}, setMinter, nil)
+	cdc.RegisterConcrete(&MsgCreateDenom{}, createDenom, nil)
+	cdc.RegisterConcrete(&MsgMint{}, mint, nil)
+	cdc.RegisterConcrete(&MsgBurn{}, burn, nil)
+	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdmin, nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, setDenomMetadata, nil)
+	cdc.RegisterConcrete(&MsgPermissionlessRegisterERC20{}, permissionless, nil)
+	cdc.RegisterConcrete(&MsgUpdateTokenPairMetadata{}, updateMetadata, nil)
+	cdc.RegisterConcrete(&MsgMigrateTokenPair{}, migratePair, nil)
+	cdc.RegisterConcrete(&MsgRemoveTokenPair{}, removePair, nil)
+	cdc.RegisterConcrete(&MsgRegisterFeeToken{}, registerFeeToken, nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeToken{}, removeFeeToken, nil)
+	cdc.RegisterConcrete(&MsgUpdateFeeTokenPrice{}, updateFeePrice, nil)
 }
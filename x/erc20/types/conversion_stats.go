@@ -0,0 +1,37 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConversionStats returns empty conversion stats for the given denom
+func NewConversionStats(denom string) ConversionStats {
+	return ConversionStats{
+		Denom:       denom,
+		Erc20ToCoin: math.ZeroInt(),
+		CoinToErc20: math.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of the conversion stats
+func (cs ConversionStats) Validate() error {
+	if err := sdk.ValidateDenom(cs.Denom); err != nil {
+		return err
+	}
+
+	if cs.Erc20ToCoin.IsNil() || cs.Erc20ToCoin.IsNegative() {
+		return fmt.Errorf("invalid ERC20 to coin volume for %s: %s", cs.Denom, cs.Erc20ToCoin)
+	}
+
+	if cs.CoinToErc20.IsNil() || cs.CoinToErc20.IsNegative() {
+		return fmt.Errorf("invalid coin to ERC20 volume for %s: %s", cs.Denom, cs.CoinToErc20)
+	}
+
+	return nil
+}
@@ -4,19 +4,29 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +66,39 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{0}
 }
 
+// FeeTokenRateSource defines the source of the conversion rate of a fee token.
+type FeeTokenRateSource int32
+
+const (
+	// FEE_TOKEN_RATE_SOURCE_UNSPECIFIED defines an invalid rate source
+	FEE_TOKEN_RATE_SOURCE_UNSPECIFIED FeeTokenRateSource = 0
+	// FEE_TOKEN_RATE_SOURCE_FIXED uses the fixed rate set by governance
+	FEE_TOKEN_RATE_SOURCE_FIXED FeeTokenRateSource = 1
+	// FEE_TOKEN_RATE_SOURCE_TWAP uses the time-weighted average of the prices
+	// recorded in the fee token oracle store
+	FEE_TOKEN_RATE_SOURCE_TWAP FeeTokenRateSource = 2
+)
+
+var FeeTokenRateSource_name = map[int32]string{
+	0: "FEE_TOKEN_RATE_SOURCE_UNSPECIFIED",
+	1: "FEE_TOKEN_RATE_SOURCE_FIXED",
+	2: "FEE_TOKEN_RATE_SOURCE_TWAP",
+}
+
+var FeeTokenRateSource_value = map[string]int32{
+	"FEE_TOKEN_RATE_SOURCE_UNSPECIFIED": 0,
+	"FEE_TOKEN_RATE_SOURCE_FIXED":       1,
+	"FEE_TOKEN_RATE_SOURCE_TWAP":        2,
+}
+
+func (x FeeTokenRateSource) String() string {
+	return proto.EnumName(FeeTokenRateSource_name, int32(x))
+}
+
+func (FeeTokenRateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 // Cosmos Coin and an ERC20 token address.
 type TokenPair struct {
@@ -67,6 +110,9 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// minter is the hex address allowed to mint and burn the tokens of a module
+	// owned token pair through the ERC20 precompile. Minting is disabled if empty.
+	Minter string `protobuf:"bytes,5,opt,name=minter,proto3" json:"minter,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +176,412 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+// RegistrationDeposit defines the deposit posted for a permissionless ERC20
+// registration. The deposit is refunded after the release time if the token
+// pair is still registered and enabled, otherwise it is burned.
+type RegistrationDeposit struct {
+	// erc20_address is the hex address of the registered ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// depositor is the bech32 address of the account that posted the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the deposited amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// release_time is the time after which the deposit is released
+	ReleaseTime time.Time `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+	// release_attempts is the number of failed attempts to release the deposit
+	ReleaseAttempts uint32 `protobuf:"varint,5,opt,name=release_attempts,json=releaseAttempts,proto3" json:"release_attempts,omitempty"`
+}
+
+func (m *RegistrationDeposit) Reset()         { *m = RegistrationDeposit{} }
+func (m *RegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDeposit) ProtoMessage()    {}
+func (*RegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *RegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDeposit.Merge(m, src)
+}
+func (m *RegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationDeposit proto.InternalMessageInfo
+
+func (m *RegistrationDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RegistrationDeposit) GetReleaseTime() time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return time.Time{}
+}
+
+func (m *RegistrationDeposit) GetReleaseAttempts() uint32 {
+	if m != nil {
+		return m.ReleaseAttempts
+	}
+	return 0
+}
+
+// ConversionStats defines the cumulative conversion volume of a token pair.
+type ConversionStats struct {
+	// denom is the cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// erc20_to_coin is the total amount converted from ERC20 tokens into Cosmos coins
+	Erc20ToCoin cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=erc20_to_coin,json=erc20ToCoin,proto3,customtype=cosmossdk.io/math.Int" json:"erc20_to_coin"`
+	// coin_to_erc20 is the total amount converted from Cosmos coins into ERC20 tokens
+	CoinToErc20 cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=coin_to_erc20,json=coinToErc20,proto3,customtype=cosmossdk.io/math.Int" json:"coin_to_erc20"`
+	// conversions is the number of conversions in both directions
+	Conversions uint64 `protobuf:"varint,4,opt,name=conversions,proto3" json:"conversions,omitempty"`
+}
+
+func (m *ConversionStats) Reset()         { *m = ConversionStats{} }
+func (m *ConversionStats) String() string { return proto.CompactTextString(m) }
+func (*ConversionStats) ProtoMessage()    {}
+func (*ConversionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{2}
+}
+func (m *ConversionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionStats.Merge(m, src)
+}
+func (m *ConversionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionStats proto.InternalMessageInfo
+
+func (m *ConversionStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConversionStats) GetConversions() uint64 {
+	if m != nil {
+		return m.Conversions
+	}
+	return 0
+}
+
+// PermitNonce defines the EIP-2612 permit nonce of an owner for a token pair.
+type PermitNonce struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// owner is the hex address of the owner of the tokens
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// nonce is the nonce of the next permit of the owner
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *PermitNonce) Reset()         { *m = PermitNonce{} }
+func (m *PermitNonce) String() string { return proto.CompactTextString(m) }
+func (*PermitNonce) ProtoMessage()    {}
+func (*PermitNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{3}
+}
+func (m *PermitNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PermitNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PermitNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PermitNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermitNonce.Merge(m, src)
+}
+func (m *PermitNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *PermitNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermitNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermitNonce proto.InternalMessageInfo
+
+func (m *PermitNonce) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *PermitNonce) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PermitNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// AuthorizationState defines an EIP-3009 authorization nonce that has been used
+// or canceled by an authorizer for a token pair.
+type AuthorizationState struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// authorizer is the hex address of the authorizer
+	Authorizer string `protobuf:"bytes,2,opt,name=authorizer,proto3" json:"authorizer,omitempty"`
+	// nonce is the hex encoded 32 bytes nonce of the authorization
+	Nonce string `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *AuthorizationState) Reset()         { *m = AuthorizationState{} }
+func (m *AuthorizationState) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationState) ProtoMessage()    {}
+func (*AuthorizationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{4}
+}
+func (m *AuthorizationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationState.Merge(m, src)
+}
+func (m *AuthorizationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationState proto.InternalMessageInfo
+
+func (m *AuthorizationState) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *AuthorizationState) GetAuthorizer() string {
+	if m != nil {
+		return m.Authorizer
+	}
+	return ""
+}
+
+func (m *AuthorizationState) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+// FeeToken defines a token pair denomination that can be used to pay the fees
+// of Ethereum transactions instead of the EVM coin.
+type FeeToken struct {
+	// denom is the cosmos base denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate_source defines how the conversion rate of the token is determined
+	RateSource FeeTokenRateSource `protobuf:"varint,2,opt,name=rate_source,json=rateSource,proto3,enum=evmos.erc20.v1.FeeTokenRateSource" json:"rate_source,omitempty"`
+	// fixed_rate is the amount of EVM coin, in its 18 decimals representation,
+	// worth one base unit of the token. It is only used with the fixed rate source.
+	FixedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fixed_rate,json=fixedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fixed_rate"`
+	// twap_window is the period over which the recorded prices are averaged. It
+	// is only used with the TWAP rate source.
+	TwapWindow time.Duration `protobuf:"bytes,4,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{5}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetRateSource() FeeTokenRateSource {
+	if m != nil {
+		return m.RateSource
+	}
+	return FEE_TOKEN_RATE_SOURCE_UNSPECIFIED
+}
+
+func (m *FeeToken) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+// FeeTokenPrice defines a price of a fee token recorded in the oracle store.
+type FeeTokenPrice struct {
+	// denom is the cosmos base denomination of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of EVM coin, in its 18 decimals representation, worth
+	// one base unit of the token
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// time is the block time at which the price was recorded
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *FeeTokenPrice) Reset()         { *m = FeeTokenPrice{} }
+func (m *FeeTokenPrice) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenPrice) ProtoMessage()    {}
+func (*FeeTokenPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{6}
+}
+func (m *FeeTokenPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenPrice.Merge(m, src)
+}
+func (m *FeeTokenPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenPrice proto.InternalMessageInfo
+
+func (m *FeeTokenPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeTokenPrice) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin. We're keeping it to remove the existing proposals from
 // store. After that, remove this message.
@@ -140,14 +592,14 @@ type RegisterCoinProposal struct {
 	// description of the proposal
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{7}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +642,7 @@ func (m *RegisterCoinProposal) GetDescription() string {
 	return ""
 }
 
-func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
+func (m *RegisterCoinProposal) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -202,14 +654,14 @@ func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
 // store. After that, remove this message.
 type ProposalMetadata struct {
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{8}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +690,7 @@ func (m *ProposalMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
 
-func (m *ProposalMetadata) GetMetadata() []types.Metadata {
+func (m *ProposalMetadata) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -261,7 +713,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{9}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +780,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{10}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +832,14 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("evmos.erc20.v1.FeeTokenRateSource", FeeTokenRateSource_name, FeeTokenRateSource_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*RegistrationDeposit)(nil), "evmos.erc20.v1.RegistrationDeposit")
+	proto.RegisterType((*ConversionStats)(nil), "evmos.erc20.v1.ConversionStats")
+	proto.RegisterType((*PermitNonce)(nil), "evmos.erc20.v1.PermitNonce")
+	proto.RegisterType((*AuthorizationState)(nil), "evmos.erc20.v1.AuthorizationState")
+	proto.RegisterType((*FeeToken)(nil), "evmos.erc20.v1.FeeToken")
+	proto.RegisterType((*FeeTokenPrice)(nil), "evmos.erc20.v1.FeeTokenPrice")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
@@ -390,39 +849,74 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0x6c, 0xbb, 0xba, 0x9d, 0xdd, 0x0d, 0x71, 0x68, 0x21, 0x14, 0x36, 0x1b, 0x2a, 0x48,
-	0xf0, 0x90, 0xb4, 0xf1, 0x26, 0x82, 0x6c, 0xbb, 0x11, 0x56, 0x76, 0xdb, 0x92, 0xed, 0xa2, 0x78,
-	0x29, 0x93, 0x64, 0x88, 0x61, 0xdb, 0x4c, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2, 0x5d,
-	0xd0, 0x3f, 0x66, 0x8f, 0x7b, 0xf4, 0x24, 0xd2, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0xb8, 0x1e,
-	0xdd, 0xcb, 0xf0, 0xbe, 0xef, 0xfd, 0xe0, 0xbd, 0xef, 0xcd, 0x83, 0x5d, 0x52, 0x2c, 0x29, 0xf7,
-	0x08, 0x8b, 0xfd, 0xbe, 0x57, 0x0c, 0x94, 0xe1, 0xae, 0x18, 0x15, 0x14, 0xe9, 0xd2, 0xe7, 0x2a,
-	0xaa, 0x18, 0x74, 0xad, 0x98, 0xf2, 0x32, 0x38, 0xc2, 0xf9, 0xa5, 0x57, 0x0c, 0x22, 0x22, 0xf0,
-	0x40, 0x02, 0x15, 0xdf, 0x6d, 0xa7, 0x34, 0xa5, 0xd2, 0xf4, 0x4a, 0x4b, 0xb1, 0xbd, 0x6f, 0x00,
-	0xb6, 0x66, 0xf4, 0x92, 0xe4, 0x53, 0x9c, 0x31, 0x74, 0x1f, 0xee, 0xcb, 0x7a, 0x73, 0x9c, 0x24,
-	0x8c, 0x70, 0x6e, 0x02, 0x1b, 0x38, 0xad, 0x70, 0x4f, 0x92, 0x47, 0x8a, 0x43, 0x6d, 0xb8, 0x9d,
-	0x90, 0x9c, 0x2e, 0xcd, 0x2d, 0xe9, 0x54, 0x00, 0x99, 0xf0, 0x2e, 0xc9, 0x71, 0xb4, 0x20, 0x89,
-	0xd9, 0xb0, 0x81, 0xb3, 0x13, 0xd6, 0x10, 0x3d, 0x81, 0x7a, 0x4c, 0x73, 0xc1, 0x70, 0x2c, 0xe6,
-	0xf4, 0x6d, 0x4e, 0x98, 0xd9, 0xb4, 0x81, 0xa3, 0xfb, 0x1d, 0xf7, 0xe6, 0x04, 0xee, 0xa4, 0x74,
-	0x86, 0xfb, 0x75, 0xb0, 0x84, 0x8f, 0x9b, 0xbf, 0xbe, 0x1c, 0x82, 0xde, 0x67, 0x00, 0xdb, 0x21,
-	0x49, 0x33, 0x2e, 0x08, 0x1b, 0xd1, 0x2c, 0x9f, 0x32, 0xba, 0xa2, 0x1c, 0x2f, 0xca, 0x66, 0x44,
-	0x26, 0x16, 0xa4, 0xea, 0x54, 0x01, 0x64, 0xc3, 0xdd, 0x84, 0xf0, 0x98, 0x65, 0x2b, 0x91, 0xd1,
-	0xbc, 0x6a, 0xf4, 0x6f, 0x0a, 0x3d, 0x85, 0x3b, 0x4b, 0x22, 0x70, 0x82, 0x05, 0x36, 0x1b, 0x76,
-	0xc3, 0xd9, 0xf5, 0x0f, 0x5c, 0x25, 0xa0, 0x2b, 0x35, 0xab, 0x04, 0x74, 0xcf, 0xaa, 0xa0, 0x61,
-	0xf3, 0xea, 0xc7, 0xa1, 0x16, 0xfe, 0x49, 0x92, 0x7d, 0x69, 0xbd, 0x73, 0x68, 0xd4, 0xad, 0xd4,
-	0x91, 0x37, 0x4a, 0x83, 0xff, 0x28, 0xdd, 0xfb, 0x00, 0x3b, 0xf5, 0xac, 0x41, 0x38, 0xf2, 0xfb,
-	0xb7, 0x1e, 0xf6, 0x01, 0xd4, 0xa5, 0xc8, 0xd5, 0x56, 0x09, 0x97, 0x23, 0xb7, 0xc2, 0x7f, 0xd8,
-	0x6a, 0x26, 0x0e, 0x0f, 0x66, 0x34, 0x4d, 0x17, 0x44, 0xfe, 0x8b, 0x11, 0xcd, 0x0b, 0xc2, 0x78,
-	0x46, 0x6f, 0xaf, 0x79, 0x99, 0x57, 0x96, 0x94, 0x1f, 0xa4, 0xcc, 0x2b, 0x81, 0x5a, 0xf0, 0xc3,
-	0xe7, 0x70, 0x5b, 0xee, 0x1b, 0x75, 0xe0, 0xbd, 0xc9, 0x8b, 0x71, 0x10, 0xce, 0x2f, 0xc6, 0xe7,
-	0xd3, 0x60, 0x74, 0xf2, 0xec, 0x24, 0x38, 0x36, 0x34, 0x64, 0xc0, 0x3d, 0x45, 0x9f, 0x4d, 0x8e,
-	0x2f, 0x4e, 0x03, 0x03, 0x20, 0x04, 0x75, 0xc5, 0x04, 0x2f, 0x67, 0x41, 0x38, 0x3e, 0x3a, 0x35,
-	0xb6, 0xba, 0xcd, 0x8f, 0x5f, 0x2d, 0x6d, 0x38, 0xbc, 0x5a, 0x5b, 0xe0, 0x7a, 0x6d, 0x81, 0x9f,
-	0x6b, 0x0b, 0x7c, 0xda, 0x58, 0xda, 0xf5, 0xc6, 0xd2, 0xbe, 0x6f, 0x2c, 0xed, 0x95, 0x93, 0x66,
-	0xe2, 0xf5, 0x9b, 0xc8, 0x8d, 0xe9, 0xd2, 0xab, 0x4e, 0x4b, 0xbe, 0x85, 0xdf, 0xf7, 0xde, 0x55,
-	0x67, 0x26, 0xde, 0xaf, 0x08, 0x8f, 0xee, 0xc8, 0xf3, 0x78, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0xfb, 0x5c, 0x69, 0x82, 0x03, 0x00, 0x00,
+	// 1068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0x4e, 0x89, 0x9f, 0x13, 0xd7, 0x5d, 0x12, 0xe4, 0xb8, 0xd4, 0x0e, 0x46, 0xa0,
+	0x10, 0xd4, 0xdd, 0xc4, 0x88, 0x0b, 0x02, 0x21, 0x7f, 0x6c, 0x90, 0x21, 0x8d, 0xad, 0x8d, 0xa3,
+	0x54, 0x1c, 0x58, 0x8d, 0x77, 0xa7, 0xce, 0x2a, 0xde, 0x1d, 0x6b, 0x66, 0xec, 0xb4, 0x48, 0x5c,
+	0x7a, 0xe2, 0xd8, 0x0b, 0x12, 0x47, 0x24, 0x2e, 0x08, 0x09, 0x89, 0x03, 0x37, 0xf8, 0x03, 0x7a,
+	0xac, 0x38, 0x21, 0x0e, 0x2d, 0x4a, 0x0e, 0x20, 0xf1, 0x4f, 0xa0, 0xf9, 0x58, 0xc7, 0x49, 0x5a,
+	0x29, 0xa4, 0x17, 0x7b, 0xdf, 0xef, 0xbd, 0xf7, 0x9b, 0xf7, 0x39, 0xbb, 0x50, 0xc4, 0xe3, 0x88,
+	0x30, 0x1b, 0x53, 0xbf, 0xba, 0x61, 0x8f, 0x37, 0xd5, 0x83, 0x35, 0xa4, 0x84, 0x13, 0x33, 0x27,
+	0x75, 0x96, 0x82, 0xc6, 0x9b, 0xc5, 0x1b, 0x28, 0x0a, 0x63, 0x62, 0xcb, 0x5f, 0x65, 0x52, 0x2c,
+	0xf9, 0x84, 0x09, 0xff, 0x1e, 0x8a, 0x0f, 0xed, 0xf1, 0x66, 0x0f, 0x73, 0xb4, 0x29, 0x85, 0x0b,
+	0x7a, 0x86, 0x27, 0x7a, 0x9f, 0x84, 0xb1, 0xd6, 0xaf, 0x28, 0xbd, 0x27, 0x25, 0x5b, 0x09, 0x5a,
+	0xb5, 0xd4, 0x27, 0x7d, 0xa2, 0x70, 0xf1, 0x94, 0x10, 0xf6, 0x09, 0xe9, 0x0f, 0xb0, 0x2d, 0xa5,
+	0xde, 0xe8, 0x9e, 0x1d, 0x8c, 0x28, 0xe2, 0x21, 0x49, 0x08, 0xcb, 0xe7, 0xf5, 0x3c, 0x8c, 0x30,
+	0xe3, 0x28, 0x1a, 0x2a, 0x83, 0xca, 0x6f, 0x06, 0x64, 0xba, 0xe4, 0x10, 0xc7, 0x1d, 0x14, 0x52,
+	0xf3, 0x4d, 0x58, 0x94, 0xe9, 0x79, 0x28, 0x08, 0x28, 0x66, 0xac, 0x60, 0xac, 0x1a, 0x6b, 0x19,
+	0x77, 0x41, 0x82, 0x35, 0x85, 0x99, 0x4b, 0x30, 0x17, 0xe0, 0x98, 0x44, 0x85, 0x19, 0xa9, 0x54,
+	0x82, 0x59, 0x80, 0x57, 0x70, 0x8c, 0x7a, 0x03, 0x1c, 0x14, 0x66, 0x57, 0x8d, 0xb5, 0x79, 0x37,
+	0x11, 0xcd, 0x0f, 0x21, 0xe7, 0x93, 0x98, 0x53, 0xe4, 0x73, 0x8f, 0x1c, 0xc5, 0x98, 0x16, 0xd2,
+	0xab, 0xc6, 0x5a, 0xae, 0xba, 0x6c, 0x9d, 0x2d, 0xa8, 0xd5, 0x16, 0x4a, 0x77, 0x31, 0x31, 0x96,
+	0xa2, 0xf9, 0x1a, 0x5c, 0x8b, 0xc2, 0x98, 0x63, 0x5a, 0x98, 0x93, 0xc7, 0x69, 0xe9, 0x83, 0xf4,
+	0x3f, 0xdf, 0x95, 0x8d, 0xca, 0xaf, 0x33, 0xf0, 0xaa, 0x8b, 0xfb, 0x21, 0xe3, 0x2a, 0xed, 0x26,
+	0x1e, 0x12, 0x16, 0xf2, 0xcb, 0x25, 0xf2, 0x3a, 0x64, 0x02, 0x65, 0x4f, 0xa8, 0x4e, 0xe6, 0x14,
+	0x30, 0x0f, 0xe0, 0x1a, 0x8a, 0xc8, 0x28, 0xe6, 0x85, 0xd9, 0xd5, 0xd9, 0xb5, 0x6c, 0x75, 0xc5,
+	0xd2, 0xfd, 0x10, 0xcd, 0xb3, 0x74, 0xf3, 0xac, 0x06, 0x09, 0xe3, 0xfa, 0xfb, 0x8f, 0x9f, 0x96,
+	0x53, 0x3f, 0x3e, 0x2b, 0xaf, 0xf5, 0x43, 0x7e, 0x30, 0xea, 0x59, 0x3e, 0x89, 0x74, 0xf3, 0xf4,
+	0xdf, 0x6d, 0x16, 0x1c, 0xda, 0xfc, 0xc1, 0x10, 0x33, 0xe9, 0xc0, 0x7e, 0xf8, 0xfb, 0xe7, 0x75,
+	0xc3, 0xd5, 0xfc, 0xe6, 0x36, 0x2c, 0x50, 0x3c, 0xc0, 0x88, 0x61, 0x4f, 0xb4, 0x47, 0x96, 0x27,
+	0x5b, 0x2d, 0x5a, 0xaa, 0x77, 0x56, 0xd2, 0x3b, 0xab, 0x9b, 0xf4, 0xae, 0xbe, 0x28, 0x0e, 0x7c,
+	0xf4, 0xac, 0x6c, 0x28, 0xa2, 0xac, 0x76, 0x17, 0x06, 0xe6, 0x3b, 0x90, 0x4f, 0xd8, 0x10, 0xe7,
+	0x38, 0x1a, 0x72, 0x26, 0x4b, 0xb7, 0xe8, 0x5e, 0xd7, 0x78, 0x4d, 0xc3, 0x95, 0x7f, 0x0d, 0xb8,
+	0xde, 0x20, 0xf1, 0x18, 0x53, 0x16, 0x92, 0x78, 0x97, 0x23, 0x3e, 0xd5, 0x5d, 0x63, 0xba, 0xbb,
+	0xed, 0xa4, 0x9e, 0x9c, 0x78, 0x62, 0x5e, 0x55, 0xb9, 0xea, 0xef, 0x8a, 0x38, 0xfe, 0x7c, 0x5a,
+	0x5e, 0x56, 0x69, 0xb2, 0xe0, 0xd0, 0x0a, 0x89, 0x1d, 0x21, 0x7e, 0x60, 0xb5, 0x62, 0xfe, 0xfb,
+	0x2f, 0xb7, 0x41, 0xd7, 0xac, 0x15, 0x73, 0x37, 0x2b, 0x19, 0xba, 0x44, 0x54, 0x40, 0x10, 0x0a,
+	0x1e, 0xc1, 0x27, 0xe1, 0xc2, 0xec, 0x15, 0x08, 0x05, 0x43, 0x97, 0x38, 0xc2, 0xdf, 0x5c, 0x85,
+	0xac, 0x3f, 0x49, 0x85, 0xc9, 0x1a, 0xa6, 0xdd, 0x69, 0xa8, 0xf2, 0x05, 0x64, 0x3b, 0x98, 0x46,
+	0x21, 0xdf, 0x21, 0xb1, 0x8f, 0x2f, 0x3d, 0xeb, 0x6a, 0x64, 0xf5, 0xac, 0x4b, 0x41, 0xa0, 0xb1,
+	0xe0, 0x90, 0x41, 0xa7, 0x5d, 0x25, 0x54, 0x08, 0x98, 0xb5, 0x11, 0x3f, 0x20, 0x34, 0xfc, 0x12,
+	0x71, 0x5d, 0xcf, 0x4b, 0x1e, 0x53, 0x02, 0x40, 0xda, 0x75, 0x72, 0xd6, 0x14, 0x72, 0xf6, 0xc0,
+	0x4c, 0x72, 0xe0, 0xc3, 0x19, 0x98, 0xdf, 0xc2, 0x58, 0xae, 0xef, 0x0b, 0xfa, 0xd6, 0x80, 0x2c,
+	0x45, 0x1c, 0x7b, 0x8c, 0x8c, 0xa8, 0x8f, 0x25, 0x73, 0xae, 0x5a, 0x39, 0xbf, 0x78, 0x09, 0x89,
+	0x8b, 0x38, 0xde, 0x95, 0x96, 0x2e, 0xd0, 0xc9, 0xb3, 0xd9, 0x01, 0xb8, 0x17, 0xde, 0xc7, 0x81,
+	0x27, 0x30, 0xdd, 0xa8, 0x4d, 0xdd, 0xa8, 0x9b, 0x17, 0x1b, 0xb5, 0x8d, 0xfb, 0xc8, 0x7f, 0xd0,
+	0xc4, 0xfe, 0x54, 0xbb, 0x9a, 0xd8, 0x77, 0x33, 0x92, 0x44, 0x9c, 0x61, 0xb6, 0x20, 0xcb, 0x8f,
+	0xd0, 0xd0, 0x3b, 0x0a, 0xe3, 0x80, 0x1c, 0xe9, 0x81, 0x5f, 0xb9, 0x30, 0xf0, 0x4d, 0x7d, 0x99,
+	0xa9, 0x79, 0xff, 0x76, 0x32, 0xef, 0x20, 0x9c, 0xf7, 0xa5, 0x6f, 0xe5, 0x27, 0x03, 0x16, 0x93,
+	0xf8, 0x3b, 0x34, 0xf4, 0xf1, 0x0b, 0x2a, 0xf1, 0x09, 0xcc, 0x0d, 0x69, 0xa8, 0x6b, 0x70, 0xa5,
+	0xf8, 0x95, 0xbf, 0xf9, 0x11, 0xa4, 0xe5, 0x96, 0xce, 0xfe, 0xdf, 0x2d, 0x95, 0x6e, 0x95, 0x6f,
+	0x0c, 0x58, 0x52, 0x37, 0x16, 0xa6, 0x62, 0x13, 0x3a, 0x94, 0x0c, 0x09, 0x43, 0x03, 0x11, 0x36,
+	0x0f, 0xf9, 0x00, 0x27, 0x61, 0x4b, 0x41, 0x8c, 0x75, 0x80, 0x99, 0x4f, 0xc3, 0xa1, 0x28, 0x84,
+	0x1e, 0x8d, 0x69, 0xc8, 0xfc, 0x18, 0xe6, 0x23, 0xcc, 0x51, 0x80, 0x38, 0xd2, 0x37, 0xd5, 0xad,
+	0xd3, 0x9b, 0x2a, 0x3e, 0x9c, 0xdc, 0x54, 0x77, 0xb4, 0x51, 0x3d, 0x2d, 0xc2, 0x72, 0x27, 0x4e,
+	0xf2, 0x26, 0x4d, 0x55, 0x76, 0x21, 0x9f, 0x84, 0x92, 0x58, 0x9e, 0xa1, 0x36, 0xae, 0x40, 0x5d,
+	0xf9, 0x0a, 0x96, 0x93, 0x5c, 0x1d, 0xb7, 0x51, 0xdd, 0x78, 0xe9, 0x64, 0xdf, 0x86, 0x9c, 0x9c,
+	0x5a, 0xbd, 0x4c, 0x98, 0xc9, 0x94, 0x33, 0xee, 0x39, 0x54, 0xe7, 0xc4, 0xe0, 0x56, 0x97, 0xf4,
+	0xfb, 0x03, 0x35, 0x1d, 0xa7, 0x37, 0xdd, 0x4b, 0x87, 0x21, 0xfc, 0x04, 0x65, 0xb2, 0x8f, 0x52,
+	0x50, 0xaf, 0xa4, 0xf5, 0x4f, 0x61, 0x4e, 0xbd, 0xb9, 0x96, 0xe1, 0x46, 0x7b, 0x7f, 0xc7, 0x71,
+	0xbd, 0xbd, 0x9d, 0xdd, 0x8e, 0xd3, 0x68, 0x6d, 0xb5, 0x9c, 0x66, 0x3e, 0x65, 0xe6, 0x61, 0x41,
+	0xc1, 0x77, 0xda, 0xcd, 0xbd, 0x6d, 0x27, 0x6f, 0x98, 0x26, 0xe4, 0x14, 0xe2, 0xdc, 0xed, 0x3a,
+	0xee, 0x4e, 0x6d, 0x3b, 0x3f, 0x53, 0x4c, 0x7f, 0xfd, 0x7d, 0x29, 0xb5, 0xfe, 0xd0, 0x00, 0xf3,
+	0xe2, 0x72, 0x9a, 0x6f, 0xc1, 0x1b, 0x5b, 0x8e, 0xe3, 0x75, 0xdb, 0x9f, 0x39, 0x3b, 0x9e, 0x5b,
+	0xeb, 0x3a, 0xde, 0x6e, 0x7b, 0xcf, 0x6d, 0x38, 0xe7, 0x4e, 0x2a, 0xc3, 0xcd, 0xe7, 0x9b, 0x6d,
+	0xb5, 0xee, 0x3a, 0xcd, 0xbc, 0x61, 0x96, 0xa0, 0xf8, 0x7c, 0x83, 0xee, 0x7e, 0xad, 0x93, 0x04,
+	0x51, 0xaf, 0x3f, 0x3e, 0x2e, 0x19, 0x4f, 0x8e, 0x4b, 0xc6, 0x5f, 0xc7, 0x25, 0xe3, 0xd1, 0x49,
+	0x29, 0xf5, 0xe4, 0xa4, 0x94, 0xfa, 0xe3, 0xa4, 0x94, 0xfa, 0x7c, 0xfa, 0x7d, 0xa7, 0x3f, 0x9c,
+	0xe4, 0xef, 0xb8, 0xba, 0x61, 0xdf, 0xd7, 0x1f, 0x51, 0xf2, 0xad, 0xd7, 0xbb, 0x26, 0xd7, 0xe3,
+	0xbd, 0xff, 0x06, 0x00, 0x85, 0x8d, 0x9c, 0x90, 0x60, 0x09, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -456,6 +950,9 @@ func (this *TokenPair) Equal(that interface{}) bool {
 	if this.ContractOwner != that1.ContractOwner {
 		return false
 	}
+	if this.Minter != that1.Minter {
+		return false
+	}
 	return true
 }
 func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
@@ -508,6 +1005,13 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ContractOwner != 0 {
 		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
 		i--
@@ -540,7 +1044,7 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+func (m *RegistrationDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -550,20 +1054,33 @@ func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *RegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ReleaseAttempts != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ReleaseAttempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintErc20(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -574,15 +1091,308 @@ func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Conversions != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Conversions))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.CoinToErc20.Size()
+		i -= size
+		if _, err := m.CoinToErc20.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Erc20ToCoin.Size()
+		i -= size
+		if _, err := m.Erc20ToCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PermitNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PermitNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PermitNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authorizer) > 0 {
+		i -= len(m.Authorizer)
+		copy(dAtA[i:], m.Authorizer)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Authorizer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintErc20(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FixedRate.Size()
+		i -= size
+		if _, err := m.FixedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RateSource != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.RateSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintErc20(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
 		copy(dAtA[i:], m.Title)
 		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
 		i--
@@ -749,101 +1559,231 @@ func (m *TokenPair) Size() (n int) {
 	if m.ContractOwner != 0 {
 		n += 1 + sovErc20(uint64(m.ContractOwner))
 	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
 	return n
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
+func (m *RegistrationDeposit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Erc20Address)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Depositor)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovErc20(uint64(l))
 		}
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovErc20(uint64(l))
+	if m.ReleaseAttempts != 0 {
+		n += 1 + sovErc20(uint64(m.ReleaseAttempts))
+	}
 	return n
 }
 
-func (m *ProposalMetadata) Size() (n int) {
+func (m *ConversionStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
-		}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Erc20ToCoin.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.CoinToErc20.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Conversions != 0 {
+		n += 1 + sovErc20(uint64(m.Conversions))
 	}
 	return n
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
+func (m *PermitNonce) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Erc20Address)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	if len(m.Erc20Addresses) > 0 {
-		for _, s := range m.Erc20Addresses {
-			l = len(s)
-			n += 1 + l + sovErc20(uint64(l))
-		}
+	if m.Nonce != 0 {
+		n += 1 + sovErc20(uint64(m.Nonce))
 	}
 	return n
 }
 
-func (m *ToggleTokenConversionProposal) Size() (n int) {
+func (m *AuthorizationState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
+	l = len(m.Erc20Address)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Description)
+	l = len(m.Authorizer)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	l = len(m.Token)
+	l = len(m.Nonce)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
 	return n
 }
 
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozErc20(x uint64) (n int) {
-	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.RateSource != 0 {
+		n += 1 + sovErc20(uint64(m.RateSource))
+	}
+	l = m.FixedRate.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *FeeTokenPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Erc20Addresses) > 0 {
+		for _, s := range m.Erc20Addresses {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ToggleTokenConversionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -972,6 +1912,1003 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseAttempts", wireType)
+			}
+			m.ReleaseAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20ToCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToErc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToErc20.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+			}
+			m.Conversions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conversions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PermitNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PermitNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PermitNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			m.RateSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateSource |= FeeTokenRateSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1115,7 +3052,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1199,7 +3136,7 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
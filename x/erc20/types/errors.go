@@ -24,4 +24,20 @@ var (
 	ErrInvalidIBC               = errorsmod.Register(ModuleName, 14, "invalid IBC transaction")
 	ErrTokenPairOwnedByModule   = errorsmod.Register(ModuleName, 15, "token pair owned by module")
 	ErrNativeConversionDisabled = errorsmod.Register(ModuleName, 16, "native coins manual conversion is disabled")
+	ErrInvalidCallback          = errorsmod.Register(ModuleName, 17, "invalid IBC callback")
+	ErrCallbackFailed           = errorsmod.Register(ModuleName, 18, "IBC callback execution failed")
+	ErrInvalidMinter            = errorsmod.Register(ModuleName, 19, "invalid token pair minter")
+	ErrInvalidFactoryDenom      = errorsmod.Register(ModuleName, 20, "invalid token factory denom")
+	ErrFactoryDenomExists       = errorsmod.Register(ModuleName, 21, "token factory denom already exists")
+	ErrUnauthorizedDenomAdmin   = errorsmod.Register(ModuleName, 22, "sender is not the denom admin")
+	ErrPermissionlessDisabled   = errorsmod.Register(ModuleName, 23, "permissionless registration is disabled")
+	ErrERC20Denylisted          = errorsmod.Register(ModuleName, 24, "ERC20 contract is denylisted")
+	ErrTransferProbeFailed      = errorsmod.Register(ModuleName, 25, "ERC20 transfer probe failed")
+	ErrTokenPairEnabled         = errorsmod.Register(ModuleName, 26, "token pair is enabled")
+	ErrNonZeroEscrow            = errorsmod.Register(ModuleName, 27, "token pair escrow is not zero")
+	ErrInvalidPairMigration     = errorsmod.Register(ModuleName, 28, "invalid token pair migration")
+	ErrFeeTokenNotFound         = errorsmod.Register(ModuleName, 29, "fee token not found")
+	ErrInvalidFeeToken          = errorsmod.Register(ModuleName, 30, "invalid fee token")
+	ErrFeeTokenPriceNotFound    = errorsmod.Register(ModuleName, 31, "fee token price not found")
+	ErrDenomCreationDisabled    = errorsmod.Register(ModuleName, 32, "token factory denom creation is disabled")
 )
@@ -15,11 +15,48 @@ const (
 	EventTypeRegisterERC20          = "register_erc20"
 	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
 	EventTypeRegisterERC20Extension = "register_erc20_extension"
+	EventTypeIBCCallback            = "ibc_callback"
+	EventTypeSetTokenPairMinter     = "set_token_pair_minter"
+	EventTypeCreateDenom            = "create_denom"
+	EventTypeMintFactoryDenom       = "mint_factory_denom"
+	EventTypeBurnFactoryDenom       = "burn_factory_denom"
+	EventTypeChangeDenomAdmin       = "change_denom_admin"
+	EventTypeSetDenomMetadata       = "set_denom_metadata"
+	EventTypeRefundRegistration     = "refund_registration_deposit"
+	EventTypeBurnRegistration       = "burn_registration_deposit"
+	EventTypeRetryRegistration      = "retry_registration_deposit"
+	EventTypeFailedRegistration     = "failed_registration_deposit"
+	EventTypeUpdatePairMetadata     = "update_token_pair_metadata"
+	EventTypeMigrateTokenPair       = "migrate_token_pair"
+	EventTypeRemoveTokenPair        = "remove_token_pair"
+	EventTypeRegisterFeeToken       = "register_fee_token"
+	EventTypeRemoveFeeToken         = "remove_fee_token"
+	EventTypeUpdateFeeTokenPrice    = "update_fee_token_price"
 
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyCallbackType   = "callback_type"
+	AttributeKeyContract       = "contract"
+	AttributeKeySequence       = "sequence"
+	AttributeKeySuccess        = "success"
+	AttributeKeyError          = "error"
+	AttributeKeyMinter         = "minter"
+	AttributeKeyCreator        = "creator"
+	AttributeKeyAdmin          = "admin"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyDepositor      = "depositor"
+	AttributeKeyReleaseTime    = "release_time"
+	AttributeKeyAttempts       = "attempts"
+	AttributeKeyNewERC20Token  = "new_erc20_token" // #nosec
+	AttributeKeyEscrowAmount   = "escrow_amount"
+	AttributeKeyRateSource     = "rate_source"
+	AttributeKeyPrice          = "price"
+
+	CallbackTypeReceive         = "receive"
+	CallbackTypeAcknowledgement = "acknowledgement"
+	CallbackTypeTimeout         = "timeout"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
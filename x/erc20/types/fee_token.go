@@ -0,0 +1,128 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTWAPWindow defines the maximum period over which the fee token prices
+// can be averaged
+const MaxTWAPWindow = 30 * 24 * time.Hour
+
+// NewFixedRateFeeToken returns a fee token converted at the given fixed rate
+func NewFixedRateFeeToken(denom string, rate math.LegacyDec) FeeToken {
+	return FeeToken{
+		Denom:      denom,
+		RateSource: FEE_TOKEN_RATE_SOURCE_FIXED,
+		FixedRate:  rate,
+	}
+}
+
+// NewTWAPFeeToken returns a fee token converted at the time-weighted average of
+// the prices recorded over the given window
+func NewTWAPFeeToken(denom string, window time.Duration) FeeToken {
+	return FeeToken{
+		Denom:      denom,
+		RateSource: FEE_TOKEN_RATE_SOURCE_TWAP,
+		FixedRate:  math.LegacyZeroDec(),
+		TwapWindow: window,
+	}
+}
+
+// Validate performs a stateless validation of the fee token
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return err
+	}
+
+	switch ft.RateSource {
+	case FEE_TOKEN_RATE_SOURCE_FIXED:
+		if ft.FixedRate.IsNil() || !ft.FixedRate.IsPositive() {
+			return fmt.Errorf("fixed rate of fee token %s must be positive: %s", ft.Denom, ft.FixedRate)
+		}
+	case FEE_TOKEN_RATE_SOURCE_TWAP:
+		if ft.TwapWindow <= 0 || ft.TwapWindow > MaxTWAPWindow {
+			return fmt.Errorf("TWAP window of fee token %s must be positive and at most %s: %s", ft.Denom, MaxTWAPWindow, ft.TwapWindow)
+		}
+	default:
+		return fmt.Errorf("invalid rate source of fee token %s: %s", ft.Denom, ft.RateSource)
+	}
+
+	return nil
+}
+
+// NewFeeTokenPrice returns a fee token price recorded at the given time
+func NewFeeTokenPrice(denom string, price math.LegacyDec, t time.Time) FeeTokenPrice {
+	return FeeTokenPrice{
+		Denom: denom,
+		Price: price,
+		Time:  t,
+	}
+}
+
+// Validate performs a stateless validation of the fee token price
+func (p FeeTokenPrice) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("price of fee token %s must be positive: %s", p.Denom, p.Price)
+	}
+
+	return nil
+}
+
+// TWAP returns the time-weighted average of the prices over the window ending
+// at the given time. Each price is weighted by the time it was the latest one,
+// so the last price recorded before the window counts from the window start.
+// The prices must be sorted by time. An error is returned if no price was
+// recorded before the end of the window.
+func TWAP(prices []FeeTokenPrice, end time.Time, window time.Duration) (math.LegacyDec, error) {
+	start := end.Add(-window)
+
+	weightedSum := math.LegacyZeroDec()
+	totalWeight := int64(0)
+	for i, p := range prices {
+		if p.Time.After(end) {
+			break
+		}
+
+		// the price is valid until the next recorded price or the window end
+		next := end
+		if i+1 < len(prices) && prices[i+1].Time.Before(end) {
+			next = prices[i+1].Time
+		}
+
+		from := p.Time
+		if from.Before(start) {
+			from = start
+		}
+
+		if !next.After(from) {
+			continue
+		}
+
+		weight := next.Sub(from).Nanoseconds()
+		weightedSum = weightedSum.Add(p.Price.MulInt64(weight))
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		// the only prices were recorded at the window end
+		for i := len(prices) - 1; i >= 0; i-- {
+			if !prices[i].Time.After(end) {
+				return prices[i].Price, nil
+			}
+		}
+		return math.LegacyDec{}, fmt.Errorf("no price recorded before %s", end)
+	}
+
+	return weightedSum.QuoInt64(totalWeight), nil
+}
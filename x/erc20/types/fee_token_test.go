@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeTokenValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		feeToken FeeToken
+		expPass  bool
+	}{
+		{"valid fixed rate", NewFixedRateFeeToken("ibc/token", math.LegacyNewDec(2)), true},
+		{"valid TWAP", NewTWAPFeeToken("ibc/token", time.Hour), true},
+		{"invalid denom", NewFixedRateFeeToken("", math.LegacyOneDec()), false},
+		{"zero fixed rate", NewFixedRateFeeToken("ibc/token", math.LegacyZeroDec()), false},
+		{"unset fixed rate", FeeToken{Denom: "ibc/token", RateSource: FEE_TOKEN_RATE_SOURCE_FIXED}, false},
+		{"zero TWAP window", NewTWAPFeeToken("ibc/token", 0), false},
+		{"TWAP window above maximum", NewTWAPFeeToken("ibc/token", MaxTWAPWindow+time.Second), false},
+		{"unspecified rate source", FeeToken{Denom: "ibc/token", FixedRate: math.LegacyOneDec()}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.feeToken.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestTWAP(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	prices := []FeeTokenPrice{
+		NewFeeTokenPrice("ibc/token", math.LegacyNewDec(2), start),
+		NewFeeTokenPrice("ibc/token", math.LegacyNewDec(4), start.Add(10*time.Second)),
+		NewFeeTokenPrice("ibc/token", math.LegacyNewDec(10), start.Add(30*time.Second)),
+	}
+
+	testCases := []struct {
+		name    string
+		prices  []FeeTokenPrice
+		end     time.Time
+		window  time.Duration
+		expRate math.LegacyDec
+		expPass bool
+	}{
+		{"no prices", nil, start, time.Minute, math.LegacyDec{}, false},
+		{"only prices after the window end", prices, start.Add(-time.Second), time.Minute, math.LegacyDec{}, false},
+		{"single price at the window end", prices[:1], start, time.Minute, math.LegacyNewDec(2), true},
+		{"window within a single price", prices, start.Add(10 * time.Second), 5 * time.Second, math.LegacyNewDec(2), true},
+		{"window over two prices", prices, start.Add(20 * time.Second), 20 * time.Second, math.LegacyNewDec(3), true},
+		{"price before the window start counts from the window start", prices, start.Add(15 * time.Second), 10 * time.Second, math.LegacyNewDec(3), true},
+		{"prices after the window end are ignored", prices, start.Add(25 * time.Second), 20 * time.Second, math.LegacyNewDecWithPrec(35, 1), true},
+		{"latest price", prices, start.Add(40 * time.Second), 10 * time.Second, math.LegacyNewDec(10), true},
+	}
+
+	for _, tc := range testCases {
+		rate, err := TWAP(tc.prices, tc.end, tc.window)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expRate.String(), rate.String(), tc.name)
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v20/types"
 )
 
@@ -40,6 +41,7 @@ func DefaultGenesisState() *GenesisState {
 // TODO: Validate that the precompiles have a corresponding token pair
 func (gs GenesisState) Validate() error {
 	seenErc20 := make(map[string]bool)
+	pairContracts := make(map[common.Address]bool)
 	seenDenom := make(map[string]bool)
 
 	for _, b := range gs.TokenPairs {
@@ -56,6 +58,99 @@ func (gs GenesisState) Validate() error {
 
 		seenErc20[b.Erc20Address] = true
 		seenDenom[b.Denom] = true
+		pairContracts[b.GetERC20Contract()] = true
+	}
+
+	seenDeposits := make(map[string]bool)
+	for _, d := range gs.RegistrationDeposits {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid registration deposit on genesis: %w", err)
+		}
+
+		// use the EIP-55 address to catch duplicates with different casing
+		erc20 := common.HexToAddress(d.Erc20Address).Hex()
+		if seenDeposits[erc20] {
+			return fmt.Errorf("registration deposit duplicated on genesis: '%s'", d.Erc20Address)
+		}
+		seenDeposits[erc20] = true
+	}
+
+	for _, d := range gs.FailedRegistrationDeposits {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("invalid failed registration deposit on genesis: %w", err)
+		}
+
+		erc20 := common.HexToAddress(d.Erc20Address).Hex()
+		if seenDeposits[erc20] {
+			return fmt.Errorf("registration deposit duplicated on genesis: '%s'", d.Erc20Address)
+		}
+		seenDeposits[erc20] = true
+	}
+
+	seenStats := make(map[string]bool)
+	for _, cs := range gs.ConversionStats {
+		if err := cs.Validate(); err != nil {
+			return fmt.Errorf("invalid conversion stats on genesis: %w", err)
+		}
+		if seenStats[cs.Denom] {
+			return fmt.Errorf("conversion stats duplicated on genesis: '%s'", cs.Denom)
+		}
+		seenStats[cs.Denom] = true
+	}
+
+	seenFeeTokens := make(map[string]bool)
+	for _, ft := range gs.FeeTokens {
+		if err := ft.Validate(); err != nil {
+			return fmt.Errorf("invalid fee token on genesis: %w", err)
+		}
+		if seenFeeTokens[ft.Denom] {
+			return fmt.Errorf("fee token duplicated on genesis: '%s'", ft.Denom)
+		}
+		if !seenDenom[ft.Denom] {
+			return fmt.Errorf("fee token '%s' not found in token pairs", ft.Denom)
+		}
+		seenFeeTokens[ft.Denom] = true
+	}
+
+	for _, p := range gs.FeeTokenPrices {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid fee token price on genesis: %w", err)
+		}
+		if !seenFeeTokens[p.Denom] {
+			return fmt.Errorf("fee token price for '%s' not found in fee tokens", p.Denom)
+		}
+	}
+
+	seenNonces := make(map[string]bool)
+	for _, n := range gs.PermitNonces {
+		if err := n.Validate(); err != nil {
+			return fmt.Errorf("invalid permit nonce on genesis: %w", err)
+		}
+		erc20 := common.HexToAddress(n.Erc20Address)
+		if !pairContracts[erc20] {
+			return fmt.Errorf("permit nonce token pair '%s' not found in token pairs", n.Erc20Address)
+		}
+		key := erc20.Hex() + "/" + common.HexToAddress(n.Owner).Hex()
+		if seenNonces[key] {
+			return fmt.Errorf("permit nonce duplicated on genesis: '%s'", key)
+		}
+		seenNonces[key] = true
+	}
+
+	seenAuthorizations := make(map[string]bool)
+	for _, a := range gs.AuthorizationStates {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid authorization state on genesis: %w", err)
+		}
+		erc20 := common.HexToAddress(a.Erc20Address)
+		if !pairContracts[erc20] {
+			return fmt.Errorf("authorization state token pair '%s' not found in token pairs", a.Erc20Address)
+		}
+		key := erc20.Hex() + "/" + common.HexToAddress(a.Authorizer).Hex() + "/" + common.HexToHash(a.Nonce).Hex()
+		if seenAuthorizations[key] {
+			return fmt.Errorf("authorization state duplicated on genesis: '%s'", key)
+		}
+		seenAuthorizations[key] = true
 	}
 
 	// Check if params are valid
@@ -5,18 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +36,20 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// registration_deposits is a slice of the pending permissionless registration deposits at genesis
+	RegistrationDeposits []RegistrationDeposit `protobuf:"bytes,3,rep,name=registration_deposits,json=registrationDeposits,proto3" json:"registration_deposits"`
+	// conversion_stats is a slice of the cumulative conversion volumes of the token pairs at genesis
+	ConversionStats []ConversionStats `protobuf:"bytes,4,rep,name=conversion_stats,json=conversionStats,proto3" json:"conversion_stats"`
+	// fee_tokens is a slice of the tokens that can be used to pay Ethereum transaction fees at genesis
+	FeeTokens []FeeToken `protobuf:"bytes,5,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// fee_token_prices is a slice of the fee token prices recorded in the oracle store at genesis
+	FeeTokenPrices []FeeTokenPrice `protobuf:"bytes,6,rep,name=fee_token_prices,json=feeTokenPrices,proto3" json:"fee_token_prices"`
+	// permit_nonces is a slice of the EIP-2612 permit nonces of the token owners at genesis
+	PermitNonces []PermitNonce `protobuf:"bytes,7,rep,name=permit_nonces,json=permitNonces,proto3" json:"permit_nonces"`
+	// authorization_states is a slice of the used or canceled EIP-3009 authorizations at genesis
+	AuthorizationStates []AuthorizationState `protobuf:"bytes,8,rep,name=authorization_states,json=authorizationStates,proto3" json:"authorization_states"`
+	// failed_registration_deposits is a slice of the registration deposits that could not be released at genesis
+	FailedRegistrationDeposits []RegistrationDeposit `protobuf:"bytes,9,rep,name=failed_registration_deposits,json=failedRegistrationDeposits,proto3" json:"failed_registration_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +99,55 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRegistrationDeposits() []RegistrationDeposit {
+	if m != nil {
+		return m.RegistrationDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionStats() []ConversionStats {
+	if m != nil {
+		return m.ConversionStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokenPrices() []FeeTokenPrice {
+	if m != nil {
+		return m.FeeTokenPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPermitNonces() []PermitNonce {
+	if m != nil {
+		return m.PermitNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorizationStates() []AuthorizationState {
+	if m != nil {
+		return m.AuthorizationStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedRegistrationDeposits() []RegistrationDeposit {
+	if m != nil {
+		return m.FailedRegistrationDeposits
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -89,6 +158,21 @@ type Params struct {
 	// dynamic_precompiles defines the slice of hex addresses of the
 	// active precompiles that are used to interact with Bank coins as ERC20s
 	DynamicPrecompiles []string `protobuf:"bytes,4,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// enable_permissionless_registration allows any account to register a token
+	// pair for an ERC20 contract by posting the registration deposit
+	EnablePermissionlessRegistration bool `protobuf:"varint,5,opt,name=enable_permissionless_registration,json=enablePermissionlessRegistration,proto3" json:"enable_permissionless_registration,omitempty"`
+	// registration_deposit is the refundable deposit required for a permissionless
+	// ERC20 registration
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// registration_deposit_period is the time the deposit is held before it is
+	// refunded to the depositor
+	RegistrationDepositPeriod time.Duration `protobuf:"bytes,7,opt,name=registration_deposit_period,json=registrationDepositPeriod,proto3,stdduration" json:"registration_deposit_period"`
+	// registration_denylist defines the slice of hex addresses of the ERC20
+	// contracts that cannot be registered permissionlessly
+	RegistrationDenylist []string `protobuf:"bytes,8,rep,name=registration_denylist,json=registrationDenylist,proto3" json:"registration_denylist,omitempty"`
+	// denom_creation_fee is the fee burned when a token factory denomination is
+	// created. Denominations cannot be created while it is empty.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -145,6 +229,41 @@ func (m *Params) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetEnablePermissionlessRegistration() bool {
+	if m != nil {
+		return m.EnablePermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationDepositPeriod() time.Duration {
+	if m != nil {
+		return m.RegistrationDepositPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRegistrationDenylist() []string {
+	if m != nil {
+		return m.RegistrationDenylist
+	}
+	return nil
+}
+
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -153,29 +272,54 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0x94, 0x10, 0xd8, 0x92, 0x7f, 0xfe, 0xac, 0xc6, 0x20, 0x31, 0x15, 0x39, 0x35,
-	0x26, 0x76, 0xa1, 0x9e, 0xbc, 0x92, 0x10, 0x13, 0x4f, 0x04, 0x3d, 0x79, 0x21, 0x4b, 0x9d, 0xd4,
-	0x8d, 0xb4, 0xdb, 0x74, 0xd7, 0x46, 0xde, 0x02, 0xdf, 0xc2, 0xa3, 0x8f, 0xc1, 0x91, 0xa3, 0x27,
-	0x63, 0xe0, 0xe0, 0x6b, 0x18, 0x76, 0x6b, 0x04, 0x2e, 0x93, 0xc9, 0xf7, 0xfb, 0xbe, 0x99, 0xcd,
-	0x2c, 0x3e, 0x81, 0x3c, 0x16, 0x92, 0x42, 0x16, 0x06, 0x5d, 0x9a, 0xf7, 0x68, 0x04, 0x09, 0x48,
-	0x2e, 0xfd, 0x34, 0x13, 0x4a, 0x90, 0x7f, 0x9a, 0xfa, 0x9a, 0xfa, 0x79, 0xaf, 0xd5, 0x60, 0x31,
-	0x4f, 0x04, 0xd5, 0xd5, 0x58, 0x5a, 0xad, 0xbd, 0x01, 0xc6, 0x6b, 0xd8, 0x61, 0x24, 0x22, 0xa1,
-	0x5b, 0xba, 0xe9, 0x8c, 0xda, 0x99, 0x23, 0x5c, 0xbf, 0x36, 0x6b, 0x6e, 0x15, 0x53, 0x40, 0xae,
-	0x70, 0x25, 0x65, 0x19, 0x8b, 0x65, 0x13, 0xb5, 0x91, 0xe7, 0x04, 0x47, 0xfe, 0xee, 0x5a, 0x7f,
-	0xa8, 0x69, 0xbf, 0xb6, 0xf8, 0x3c, 0xb5, 0xde, 0xbe, 0xdf, 0xcf, 0xd1, 0xa8, 0x08, 0x90, 0x01,
-	0x76, 0x94, 0x78, 0x82, 0x64, 0x9c, 0x32, 0x9e, 0xc9, 0x66, 0xa9, 0x6d, 0x7b, 0x4e, 0x70, 0xbc,
-	0x9f, 0xbf, 0xdb, 0x58, 0x86, 0x8c, 0x67, 0xdb, 0x23, 0xb0, 0xfa, 0x55, 0x65, 0xe7, 0x15, 0xe1,
-	0x8a, 0x59, 0x42, 0xce, 0x70, 0x1d, 0x12, 0x36, 0x99, 0xc2, 0x58, 0xc7, 0xf5, 0x93, 0xaa, 0x23,
-	0xc7, 0x68, 0x83, 0x8d, 0x44, 0x2e, 0x30, 0x49, 0x98, 0xe2, 0x39, 0x8c, 0xd3, 0x0c, 0x42, 0x11,
-	0xa7, 0x7c, 0x0a, 0xb2, 0x69, 0xb7, 0x6d, 0xaf, 0x36, 0x6a, 0x18, 0x32, 0xfc, 0x03, 0x84, 0xe2,
-	0x83, 0x87, 0x59, 0xc2, 0x62, 0x1e, 0xee, 0xf8, 0xcb, 0xda, 0x4f, 0x0a, 0xb4, 0x15, 0xb8, 0x29,
-	0x57, 0x4b, 0xff, 0xed, 0x7e, 0x7f, 0xb1, 0x72, 0xd1, 0x72, 0xe5, 0xa2, 0xaf, 0x95, 0x8b, 0xe6,
-	0x6b, 0xd7, 0x5a, 0xae, 0x5d, 0xeb, 0x63, 0xed, 0x5a, 0xf7, 0x5e, 0xc4, 0xd5, 0xe3, 0xf3, 0xc4,
-	0x0f, 0x45, 0x4c, 0x8b, 0xeb, 0xeb, 0x9a, 0x07, 0x5d, 0xfa, 0x52, 0xfc, 0x84, 0x9a, 0xa5, 0x20,
-	0x27, 0x15, 0x7d, 0xf1, 0xcb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x6f, 0x0a, 0xc1, 0xe6,
-	0x01, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc7, 0xe3, 0x7e, 0xa4, 0xcd, 0xa4, 0xed, 0x4d, 0xa7, 0xb9, 0x57, 0x4e, 0xda, 0x9b, 0xf4,
+	0xe6, 0x6e, 0x2a, 0xa4, 0xda, 0x4d, 0x2a, 0x16, 0x2c, 0x49, 0x3f, 0x90, 0x00, 0xa1, 0x28, 0xc0,
+	0x86, 0x8d, 0x99, 0x38, 0x27, 0xe9, 0xa8, 0xb1, 0xc7, 0xf2, 0x99, 0x44, 0x94, 0x05, 0x1b, 0x60,
+	0xcf, 0x92, 0x47, 0x40, 0xac, 0x78, 0x8c, 0x2e, 0xbb, 0x64, 0x45, 0x51, 0xbb, 0xe0, 0x35, 0x90,
+	0x67, 0x9c, 0xd6, 0x71, 0xc2, 0x06, 0x89, 0x8d, 0xe3, 0xcc, 0xff, 0x3f, 0xbf, 0x73, 0xe6, 0xf8,
+	0xf8, 0x98, 0x6c, 0xc1, 0xc8, 0x13, 0x68, 0x43, 0xe8, 0x36, 0xf6, 0xec, 0x51, 0xdd, 0xee, 0x83,
+	0x0f, 0xc8, 0xd1, 0x0a, 0x42, 0x21, 0x05, 0x5d, 0x53, 0xaa, 0xa5, 0x54, 0x6b, 0x54, 0x2f, 0xaf,
+	0x33, 0x8f, 0xfb, 0xc2, 0x56, 0x57, 0x6d, 0x29, 0x57, 0x5c, 0x81, 0x11, 0xa1, 0xc3, 0x10, 0xec,
+	0x51, 0xbd, 0x03, 0x92, 0xd5, 0x6d, 0x57, 0x70, 0x3f, 0xd6, 0xcb, 0xa9, 0x00, 0x9a, 0xa5, 0xb5,
+	0x62, 0x5f, 0xf4, 0x85, 0xba, 0xb5, 0xa3, 0xbb, 0x31, 0xb1, 0x2f, 0x44, 0x7f, 0x00, 0xb6, 0xfa,
+	0xd7, 0x19, 0xf6, 0xec, 0xee, 0x30, 0x64, 0x92, 0x8b, 0x98, 0x58, 0x7b, 0x9f, 0x25, 0x2b, 0x0f,
+	0x74, 0x9a, 0x4f, 0x25, 0x93, 0x40, 0xef, 0x91, 0x6c, 0xc0, 0x42, 0xe6, 0xa1, 0x69, 0x6c, 0x1b,
+	0x3b, 0xf9, 0xc6, 0x3f, 0xd6, 0x64, 0xda, 0x56, 0x4b, 0xa9, 0xcd, 0xdc, 0xf9, 0xb7, 0x6a, 0xe6,
+	0xd3, 0x8f, 0x2f, 0x77, 0x8c, 0x76, 0xbc, 0x81, 0x1e, 0x91, 0xbc, 0x14, 0xa7, 0xe0, 0x3b, 0x01,
+	0xe3, 0x21, 0x9a, 0x73, 0xdb, 0xf3, 0x3b, 0xf9, 0x46, 0x29, 0xbd, 0xff, 0x59, 0x64, 0x69, 0x31,
+	0x1e, 0x26, 0x11, 0x44, 0x8e, 0x57, 0x91, 0xba, 0xe4, 0xef, 0x10, 0xfa, 0x1c, 0xa5, 0x4e, 0xd4,
+	0xe9, 0x42, 0x20, 0x90, 0x4b, 0x34, 0xe7, 0x15, 0xf0, 0xff, 0x34, 0xb0, 0x9d, 0x30, 0x1f, 0x6a,
+	0x6f, 0x12, 0x5d, 0x0c, 0xa7, 0x75, 0xa4, 0xcf, 0x49, 0xc1, 0x15, 0xfe, 0x08, 0x42, 0x8c, 0x42,
+	0xa0, 0x64, 0x12, 0xcd, 0x05, 0xc5, 0xaf, 0xa6, 0xf9, 0x07, 0x37, 0xbe, 0xa8, 0x42, 0x13, 0x27,
+	0xff, 0xcb, 0x9d, 0xd4, 0x68, 0x93, 0x90, 0x1e, 0x80, 0xa3, 0x4e, 0x83, 0xe6, 0xa2, 0x02, 0x9a,
+	0x69, 0xe0, 0x31, 0x80, 0x2a, 0x42, 0x92, 0x94, 0xeb, 0xc5, 0x8b, 0x48, 0xdb, 0xa4, 0x70, 0xc3,
+	0x70, 0x82, 0x90, 0xbb, 0x80, 0x66, 0x56, 0x91, 0xfe, 0xfd, 0x15, 0xa9, 0x15, 0xb9, 0x92, 0xb8,
+	0xb5, 0x5e, 0x52, 0x41, 0xfa, 0x88, 0xac, 0x06, 0x10, 0x7a, 0x5c, 0x3a, 0xbe, 0xf0, 0x23, 0xe0,
+	0x92, 0x02, 0x6e, 0x4e, 0x3d, 0x5c, 0x65, 0x7a, 0x22, 0xfc, 0x49, 0xdc, 0x4a, 0x70, 0xbb, 0x8e,
+	0xf4, 0x25, 0x29, 0xb2, 0xa1, 0x3c, 0x11, 0x21, 0x7f, 0xcd, 0xe4, 0xb8, 0x7c, 0x80, 0xe6, 0xb2,
+	0x62, 0xd6, 0xd2, 0xcc, 0xfb, 0x49, 0xaf, 0x6a, 0xb2, 0x24, 0x7a, 0x83, 0x4d, 0xc9, 0x48, 0x03,
+	0xb2, 0xd5, 0x63, 0x7c, 0x00, 0x5d, 0x67, 0x76, 0x27, 0xe4, 0x7e, 0xab, 0x13, 0xca, 0x9a, 0x39,
+	0xc3, 0x85, 0xb5, 0x77, 0x8b, 0x24, 0xab, 0x3b, 0x9b, 0xfe, 0x47, 0x56, 0xc0, 0x67, 0x9d, 0x01,
+	0x38, 0x0a, 0xac, 0xde, 0x83, 0xe5, 0x76, 0x5e, 0xaf, 0x1d, 0x45, 0x4b, 0x74, 0x97, 0x50, 0x9f,
+	0x49, 0x3e, 0x02, 0x27, 0x08, 0xc1, 0x15, 0x5e, 0xc0, 0x07, 0xa0, 0xfb, 0x33, 0xd7, 0x5e, 0xd7,
+	0x4a, 0xeb, 0x56, 0xa0, 0x36, 0xd9, 0xe8, 0x9e, 0xf9, 0xcc, 0xe3, 0xee, 0x84, 0x7f, 0x41, 0xf9,
+	0x69, 0x2c, 0x25, 0x37, 0x3c, 0x26, 0xb5, 0x38, 0x05, 0x55, 0x78, 0x8c, 0x1a, 0x6c, 0x00, 0x88,
+	0x13, 0xe5, 0x30, 0x17, 0x55, 0x62, 0xdb, 0xda, 0xd9, 0x9a, 0x30, 0x26, 0xcf, 0x48, 0xdf, 0x1a,
+	0xa4, 0x38, 0xab, 0x8e, 0x71, 0x57, 0x95, 0x2c, 0x3d, 0x75, 0xac, 0x68, 0xea, 0x58, 0xf1, 0xd4,
+	0xb1, 0x0e, 0x04, 0xf7, 0x9b, 0x77, 0xa3, 0xe2, 0x7d, 0xbe, 0xac, 0xee, 0xf4, 0xb9, 0x3c, 0x19,
+	0x76, 0x2c, 0x57, 0x78, 0x76, 0x3c, 0xa2, 0xf4, 0xcf, 0x2e, 0x76, 0x4f, 0x6d, 0x79, 0x16, 0x00,
+	0xaa, 0x0d, 0x18, 0x3f, 0xd3, 0x19, 0xaf, 0x1c, 0x3d, 0x21, 0x9b, 0xb3, 0x92, 0x88, 0x4e, 0xc8,
+	0x45, 0xd7, 0x5c, 0x52, 0xd3, 0xa6, 0x64, 0xe9, 0x79, 0x65, 0x8d, 0xe7, 0x95, 0x75, 0x18, 0xcf,
+	0xab, 0xe6, 0x6a, 0x94, 0xcb, 0xc7, 0xcb, 0xaa, 0xa1, 0x63, 0x94, 0x66, 0xc4, 0x68, 0x29, 0x14,
+	0xdd, 0x9f, 0x1a, 0x20, 0xfe, 0xd9, 0x80, 0xa3, 0x54, 0x0d, 0x9a, 0x4b, 0x0f, 0x04, 0xad, 0xd1,
+	0x37, 0x84, 0x76, 0xc1, 0x17, 0x9e, 0xe3, 0x86, 0xa0, 0xb7, 0xf5, 0x00, 0xcc, 0xdc, 0x1f, 0xaa,
+	0x50, 0x41, 0xc5, 0x3a, 0x88, 0x43, 0x1d, 0x03, 0x3c, 0x5c, 0x58, 0x9e, 0x2b, 0xcc, 0x37, 0x9b,
+	0xe7, 0x57, 0x15, 0xe3, 0xe2, 0xaa, 0x62, 0x7c, 0xbf, 0xaa, 0x18, 0x1f, 0xae, 0x2b, 0x99, 0x8b,
+	0xeb, 0x4a, 0xe6, 0xeb, 0x75, 0x25, 0xf3, 0x22, 0x19, 0x20, 0xfe, 0x0a, 0xa8, 0xeb, 0xa8, 0xb1,
+	0x67, 0xbf, 0x8a, 0xbf, 0x08, 0x2a, 0x4c, 0x27, 0xab, 0x6a, 0xb7, 0xff, 0x73, 0x00, 0x48, 0xd9,
+	0xb7, 0xbc, 0x8e, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +342,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedRegistrationDeposits) > 0 {
+		for iNdEx := len(m.FailedRegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedRegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AuthorizationStates) > 0 {
+		for iNdEx := len(m.AuthorizationStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorizationStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for iNdEx := len(m.PermitNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PermitNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeTokenPrices) > 0 {
+		for iNdEx := len(m.FeeTokenPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokenPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ConversionStats) > 0 {
+		for iNdEx := len(m.ConversionStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RegistrationDeposits) > 0 {
+		for iNdEx := len(m.RegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -245,6 +487,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RegistrationDenylist) > 0 {
+		for iNdEx := len(m.RegistrationDenylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RegistrationDenylist[iNdEx])
+			copy(dAtA[i:], m.RegistrationDenylist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RegistrationDenylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RegistrationDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationDepositPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EnablePermissionlessRegistration {
+		i--
+		if m.EnablePermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -301,6 +598,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegistrationDeposits) > 0 {
+		for _, e := range m.RegistrationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionStats) > 0 {
+		for _, e := range m.ConversionStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokenPrices) > 0 {
+		for _, e := range m.FeeTokenPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PermitNonces) > 0 {
+		for _, e := range m.PermitNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthorizationStates) > 0 {
+		for _, e := range m.AuthorizationStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedRegistrationDeposits) > 0 {
+		for _, e := range m.FailedRegistrationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -325,6 +664,29 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.EnablePermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationDepositPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RegistrationDenylist) > 0 {
+		for _, s := range m.RegistrationDenylist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -430,38 +792,276 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposits", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposits = append(m.RegistrationDeposits, RegistrationDeposit{})
+			if err := m.RegistrationDeposits[len(m.RegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionStats = append(m.ConversionStats, ConversionStats{})
+			if err := m.ConversionStats[len(m.ConversionStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokenPrices = append(m.FeeTokenPrices, FeeTokenPrice{})
+			if err := m.FeeTokenPrices[len(m.FeeTokenPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermitNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermitNonces = append(m.PermitNonces, PermitNonce{})
+			if err := m.PermitNonces[len(m.PermitNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizationStates = append(m.AuthorizationStates, AuthorizationState{})
+			if err := m.AuthorizationStates[len(m.AuthorizationStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRegistrationDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedRegistrationDeposits = append(m.FailedRegistrationDeposits, RegistrationDeposit{})
+			if err := m.FailedRegistrationDeposits[len(m.FailedRegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -564,6 +1164,159 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePermissionlessRegistration = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RegistrationDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDenylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDenylist = append(m.RegistrationDenylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
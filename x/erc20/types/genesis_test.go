@@ -3,6 +3,10 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/stretchr/testify/suite"
@@ -21,6 +25,9 @@ func TestGenesisTestSuite(t *testing.T) {
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	newGen := types.NewGenesisState(types.DefaultParams(), types.DefaultTokenPairs)
+	depositor := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	owner := utiltx.GenerateAddress().Hex()
+	authNonce := common.HexToHash("0x01").Hex()
 
 	testCases := []struct {
 		name     string
@@ -169,6 +176,161 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid genesis - invalid registration deposit depositor",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractTestnet,
+						Depositor:    "bad",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated registration deposit",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractTestnet,
+						Depositor:    depositor,
+					},
+					{
+						Erc20Address: common.HexToAddress(types.WEVMOSContractTestnet).Hex(),
+						Depositor:    depositor,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - registration deposit both pending and failed",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractTestnet,
+						Depositor:    depositor,
+					},
+				},
+				FailedRegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: types.WEVMOSContractTestnet,
+						Depositor:    depositor,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with conversion stats",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ConversionStats: []types.ConversionStats{
+					{
+						Denom:       evmostypes.BaseDenom,
+						Erc20ToCoin: math.NewInt(100),
+						CoinToErc20: math.NewInt(50),
+						Conversions: 2,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - negative conversion volume",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ConversionStats: []types.ConversionStats{
+					{
+						Denom:       evmostypes.BaseDenom,
+						Erc20ToCoin: math.NewInt(-1),
+						CoinToErc20: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated conversion stats",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				ConversionStats: []types.ConversionStats{
+					types.NewConversionStats(evmostypes.BaseDenom),
+					types.NewConversionStats(evmostypes.BaseDenom),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with permit nonces and authorization states",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{Erc20Address: types.WEVMOSContractMainnet, Owner: owner, Nonce: 1},
+				},
+				AuthorizationStates: []types.AuthorizationState{
+					{Erc20Address: types.WEVMOSContractMainnet, Authorizer: owner, Nonce: authNonce},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - permit nonce of unknown token pair",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{Erc20Address: utiltx.GenerateAddress().Hex(), Owner: owner, Nonce: 1},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated permit nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				PermitNonces: []types.PermitNonce{
+					{Erc20Address: types.WEVMOSContractMainnet, Owner: owner, Nonce: 1},
+					{Erc20Address: types.WEVMOSContractMainnet, Owner: owner, Nonce: 2},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid authorization nonce",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				AuthorizationStates: []types.AuthorizationState{
+					{Erc20Address: types.WEVMOSContractMainnet, Authorizer: owner, Nonce: "0x01"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated authorization state",
+			genState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				TokenPairs: types.DefaultTokenPairs,
+				AuthorizationStates: []types.AuthorizationState{
+					{Erc20Address: types.WEVMOSContractMainnet, Authorizer: owner, Nonce: authNonce},
+					{Erc20Address: types.WEVMOSContractMainnet, Authorizer: owner, Nonce: authNonce},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
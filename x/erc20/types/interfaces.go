@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -48,6 +49,12 @@ type EVMKeeper interface {
 	GetAccount(ctx sdk.Context, address common.Address) *statedb.Account
 }
 
+// PacketForwardKeeper defines the expected packet forward middleware keeper
+// interface used to identify the packets it forwarded.
+type PacketForwardKeeper interface {
+	GetAndClearInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64) *pfmtypes.InFlightPacket
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
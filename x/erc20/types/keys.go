@@ -33,12 +33,27 @@ const (
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
 	prefixSTRv2Addresses
+	prefixPermitNonce
+	prefixAuthorizationState
+	prefixRegistrationDeposit
+	prefixConversionStats
+	prefixFeeToken
+	prefixFeeTokenPrice
+	prefixFailedRegistrationDeposit
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
-	KeyPrefixSTRv2Addresses   = []byte{prefixSTRv2Addresses}
+	KeyPrefixTokenPair           = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20    = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom    = []byte{prefixTokenPairByDenom}
+	KeyPrefixSTRv2Addresses      = []byte{prefixSTRv2Addresses}
+	KeyPrefixPermitNonce         = []byte{prefixPermitNonce}
+	KeyPrefixAuthorizationState  = []byte{prefixAuthorizationState}
+	KeyPrefixRegistrationDeposit = []byte{prefixRegistrationDeposit}
+	KeyPrefixConversionStats     = []byte{prefixConversionStats}
+	KeyPrefixFeeToken            = []byte{prefixFeeToken}
+	KeyPrefixFeeTokenPrice       = []byte{prefixFeeTokenPrice}
+
+	KeyPrefixFailedRegistrationDeposit = []byte{prefixFailedRegistrationDeposit}
 )
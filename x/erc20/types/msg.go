@@ -22,10 +22,36 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgSetTokenPairMinter{}
+	_ sdk.Msg              = &MsgCreateDenom{}
+	_ sdk.Msg              = &MsgMint{}
+	_ sdk.Msg              = &MsgBurn{}
+	_ sdk.Msg              = &MsgChangeAdmin{}
+	_ sdk.Msg              = &MsgSetDenomMetadata{}
+	_ sdk.Msg              = &MsgPermissionlessRegisterERC20{}
+	_ sdk.Msg              = &MsgUpdateTokenPairMetadata{}
+	_ sdk.Msg              = &MsgMigrateTokenPair{}
+	_ sdk.Msg              = &MsgRemoveTokenPair{}
+	_ sdk.Msg              = &MsgRegisterFeeToken{}
+	_ sdk.Msg              = &MsgRemoveFeeToken{}
+	_ sdk.Msg              = &MsgUpdateFeeTokenPrice{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgSetTokenPairMinter{}
+	_ sdk.HasValidateBasic = &MsgCreateDenom{}
+	_ sdk.HasValidateBasic = &MsgMint{}
+	_ sdk.HasValidateBasic = &MsgBurn{}
+	_ sdk.HasValidateBasic = &MsgChangeAdmin{}
+	_ sdk.HasValidateBasic = &MsgSetDenomMetadata{}
+	_ sdk.HasValidateBasic = &MsgPermissionlessRegisterERC20{}
+	_ sdk.HasValidateBasic = &MsgUpdateTokenPairMetadata{}
+	_ sdk.HasValidateBasic = &MsgMigrateTokenPair{}
+	_ sdk.HasValidateBasic = &MsgRemoveTokenPair{}
+	_ sdk.HasValidateBasic = &MsgRegisterFeeToken{}
+	_ sdk.HasValidateBasic = &MsgRemoveFeeToken{}
+	_ sdk.HasValidateBasic = &MsgUpdateFeeTokenPrice{}
 )
 
 const (
@@ -108,3 +134,183 @@ func (m *MsgToggleConversion) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetTokenPairMinter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if m.Minter != "" && !common.IsHexAddress(m.Minter) {
+		return errortypes.ErrInvalidAddress.Wrapf("invalid minter address: %s", m.Minter)
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateTokenPairMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return m.Metadata.Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMigrateTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if !common.IsHexAddress(m.NewErc20Address) {
+		return errortypes.ErrInvalidAddress.Wrapf("invalid ERC20 contract address: %s", m.NewErc20Address)
+	}
+
+	if !common.IsHexAddress(m.EscrowRecipient) {
+		return errortypes.ErrInvalidAddress.Wrapf("invalid escrow recipient address: %s", m.EscrowRecipient)
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterFeeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := m.FeeToken.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeToken, err.Error())
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveFeeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateFeeTokenPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if m.Price.IsNil() || !m.Price.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidFeeToken, "price must be positive: %s", m.Price)
+	}
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	denom, err := GetFactoryDenom(m.Sender, m.Subdenom)
+	if err != nil {
+		return err
+	}
+
+	if m.Decimals > MaxFactoryDenomDecimals {
+		return errorsmod.Wrapf(ErrInvalidFactoryDenom, "decimals must be at most %d", MaxFactoryDenomDecimals)
+	}
+
+	return NewFactoryDenomMetadata(denom, m.Name, m.Symbol, m.Decimals).Validate()
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.MintToAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid mint to address")
+		}
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+			return errorsmod.Wrap(err, "invalid new admin address")
+		}
+	}
+
+	_, _, err := DeconstructFactoryDenom(m.Denom)
+	return err
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := m.Metadata.Validate(); err != nil {
+		return err
+	}
+
+	_, _, err := DeconstructFactoryDenom(m.Metadata.Base)
+	return err
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgPermissionlessRegisterERC20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if !common.IsHexAddress(m.Erc20Address) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid ERC20 contract address: %s", m.Erc20Address)
+	}
+	return nil
+}
+
+// validateFactoryCoin checks that the coin is a positive amount of a token
+// factory denomination.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || !coin.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", coin)
+	}
+
+	_, _, err := DeconstructFactoryDenom(coin.Denom)
+	return err
+}
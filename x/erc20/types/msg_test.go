@@ -166,3 +166,164 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgSetTokenPairMinterValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetTokenPairMinter
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgSetTokenPairMinter{
+				Authority: "invalid",
+				Token:     "test",
+				Minter:    utiltx.GenerateAddress().Hex(),
+			},
+			false,
+		},
+		{
+			"fail - invalid minter address",
+			&types.MsgSetTokenPairMinter{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "test",
+				Minter:    "invalid",
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgSetTokenPairMinter{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "test",
+				Minter:    utiltx.GenerateAddress().Hex(),
+			},
+			true,
+		},
+		{
+			"pass - empty minter",
+			&types.MsgSetTokenPairMinter{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Token:     "test",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgPermissionlessRegisterERC20ValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPermissionlessRegisterERC20
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&types.MsgPermissionlessRegisterERC20{
+				Sender:       "invalid",
+				Erc20Address: utiltx.GenerateAddress().Hex(),
+			},
+			false,
+		},
+		{
+			"fail - invalid ERC20 address",
+			&types.MsgPermissionlessRegisterERC20{
+				Sender:       sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				Erc20Address: "invalid",
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgPermissionlessRegisterERC20{
+				Sender:       sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				Erc20Address: utiltx.GenerateAddress().Hex(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgMigrateTokenPairValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgMigrateTokenPair
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&types.MsgMigrateTokenPair{
+				Authority:       "invalid",
+				Token:           "erc20/0x",
+				NewErc20Address: utiltx.GenerateAddress().Hex(),
+				EscrowRecipient: utiltx.GenerateAddress().Hex(),
+			},
+			false,
+		},
+		{
+			"fail - invalid new ERC20 address",
+			&types.MsgMigrateTokenPair{
+				Authority:       authority,
+				Token:           "erc20/0x",
+				NewErc20Address: "invalid",
+				EscrowRecipient: utiltx.GenerateAddress().Hex(),
+			},
+			false,
+		},
+		{
+			"fail - invalid escrow recipient",
+			&types.MsgMigrateTokenPair{
+				Authority:       authority,
+				Token:           "erc20/0x",
+				NewErc20Address: utiltx.GenerateAddress().Hex(),
+				EscrowRecipient: "invalid",
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&types.MsgMigrateTokenPair{
+				Authority:       authority,
+				Token:           "erc20/0x",
+				NewErc20Address: utiltx.GenerateAddress().Hex(),
+				EscrowRecipient: utiltx.GenerateAddress().Hex(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
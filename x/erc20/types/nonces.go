@@ -0,0 +1,42 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Validate performs a stateless validation of the permit nonce
+func (pn PermitNonce) Validate() error {
+	if !common.IsHexAddress(pn.Erc20Address) {
+		return fmt.Errorf("invalid ERC20 contract address %s", pn.Erc20Address)
+	}
+
+	if !common.IsHexAddress(pn.Owner) {
+		return fmt.Errorf("invalid owner address %s", pn.Owner)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the authorization state
+func (as AuthorizationState) Validate() error {
+	if !common.IsHexAddress(as.Erc20Address) {
+		return fmt.Errorf("invalid ERC20 contract address %s", as.Erc20Address)
+	}
+
+	if !common.IsHexAddress(as.Authorizer) {
+		return fmt.Errorf("invalid authorizer address %s", as.Authorizer)
+	}
+
+	nonce, err := hexutil.Decode(as.Nonce)
+	if err != nil || len(nonce) != common.HashLength {
+		return fmt.Errorf("invalid authorization nonce %s", as.Nonce)
+	}
+
+	return nil
+}
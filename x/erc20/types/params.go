@@ -7,7 +7,10 @@ import (
 	"bytes"
 	"fmt"
 	"slices"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/types"
 )
@@ -24,14 +27,32 @@ var (
 	ParamStoreKeyEnableErc20        = []byte("EnableErc20")
 	ParamStoreKeyDynamicPrecompiles = []byte("DynamicPrecompiles")
 	ParamStoreKeyNativePrecompiles  = []byte("NativePrecompiles")
+	// ParamStoreKeyEnablePermissionlessRegistration is the store key of the EnablePermissionlessRegistration param
+	ParamStoreKeyEnablePermissionlessRegistration = []byte("EnablePermissionlessRegistration")
+	// ParamStoreKeyRegistrationDeposit is the store key of the RegistrationDeposit param
+	ParamStoreKeyRegistrationDeposit = []byte("RegistrationDeposit")
+	// ParamStoreKeyRegistrationDepositPeriod is the store key of the RegistrationDepositPeriod param
+	ParamStoreKeyRegistrationDepositPeriod = []byte("RegistrationDepositPeriod")
+	// ParamStoreKeyRegistrationDenylist is the store key of the RegistrationDenylist param
+	ParamStoreKeyRegistrationDenylist = []byte("RegistrationDenylist")
+	// ParamStoreKeyDenomCreationFee is the store key of the DenomCreationFee param
+	ParamStoreKeyDenomCreationFee = []byte("DenomCreationFee")
 	// DefaultNativePrecompiles defines the default precompiles for the wrapped native coin
 	// NOTE: If you modify this, make sure you modify it on the local_node genesis script as well
 	DefaultNativePrecompiles = []string{WEVMOSContractMainnet}
 	// DefaultDynamicPrecompiles defines the default active dynamic precompiles
 	DefaultDynamicPrecompiles []string
+	// DefaultRegistrationDepositPeriod defines the default time a permissionless
+	// registration deposit is held before it is refunded
+	DefaultRegistrationDepositPeriod = 7 * 24 * time.Hour
+	// DefaultDenomCreationFee defines the default fee burned when a token
+	// factory denomination is created (10 EVMOS)
+	DefaultDenomCreationFee = sdk.NewCoins(sdk.NewCoin(types.BaseDenom, math.NewIntWithDecimal(10, 18)))
 )
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object. The permissionless registration is
+// disabled and the registration deposit period and the denom creation fee are
+// set to their default values.
 func NewParams(
 	enableErc20 bool,
 	nativePrecompiles []string,
@@ -40,17 +61,21 @@ func NewParams(
 	slices.Sort(nativePrecompiles)
 	slices.Sort(dynamicPrecompiles)
 	return Params{
-		EnableErc20:        enableErc20,
-		NativePrecompiles:  nativePrecompiles,
-		DynamicPrecompiles: dynamicPrecompiles,
+		EnableErc20:               enableErc20,
+		NativePrecompiles:         nativePrecompiles,
+		DynamicPrecompiles:        dynamicPrecompiles,
+		RegistrationDepositPeriod: DefaultRegistrationDepositPeriod,
+		DenomCreationFee:          DefaultDenomCreationFee,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:        true,
-		NativePrecompiles:  DefaultNativePrecompiles,
-		DynamicPrecompiles: DefaultDynamicPrecompiles,
+		EnableErc20:               true,
+		NativePrecompiles:         DefaultNativePrecompiles,
+		DynamicPrecompiles:        DefaultDynamicPrecompiles,
+		RegistrationDepositPeriod: DefaultRegistrationDepositPeriod,
+		DenomCreationFee:          DefaultDenomCreationFee,
 	}
 }
 
@@ -80,7 +105,50 @@ func (p Params) Validate() error {
 
 	combined := dpAddrs
 	combined = append(combined, npAddrs...)
-	return validatePrecompilesUniqueness(combined)
+	if err := validatePrecompilesUniqueness(combined); err != nil {
+		return err
+	}
+
+	if err := p.RegistrationDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid registration deposit: %w", err)
+	}
+
+	if p.RegistrationDepositPeriod < 0 {
+		return fmt.Errorf("registration deposit period cannot be negative: %s", p.RegistrationDepositPeriod)
+	}
+
+	if err := ValidateRegistrationDenylist(p.RegistrationDenylist); err != nil {
+		return err
+	}
+
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateRegistrationDenylist checks if the denylisted addresses are valid
+// EIP-55 hex addresses, sorted and unique.
+func ValidateRegistrationDenylist(denylist []string) error {
+	for _, addr := range denylist {
+		if !common.IsHexAddress(addr) || common.HexToAddress(addr).Hex() != addr {
+			return fmt.Errorf("invalid denylisted address %s", addr)
+		}
+	}
+
+	// NOTE: Check that the denylist is sorted. This is required
+	// to ensure determinism
+	if !slices.IsSorted(denylist) {
+		return fmt.Errorf("registration denylist needs to be sorted: %s", denylist)
+	}
+
+	for i := 1; i < len(denylist); i++ {
+		if denylist[i] == denylist[i-1] {
+			return fmt.Errorf("duplicate denylisted address %s", denylist[i])
+		}
+	}
+	return nil
 }
 
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
@@ -135,6 +203,23 @@ func (p Params) IsDynamicPrecompile(addr common.Address) bool {
 	return isAddrIncluded(addr, p.DynamicPrecompiles)
 }
 
+// GetContractType returns the kind of ERC20 contract at the given address
+func (p Params) GetContractType(addr common.Address) ContractType {
+	switch {
+	case p.IsNativePrecompile(addr):
+		return CONTRACT_TYPE_NATIVE_PRECOMPILE
+	case p.IsDynamicPrecompile(addr):
+		return CONTRACT_TYPE_DYNAMIC_PRECOMPILE
+	default:
+		return CONTRACT_TYPE_DEPLOYED
+	}
+}
+
+// IsDenylisted checks if the provided address is within the registration denylist
+func (p Params) IsDenylisted(addr common.Address) bool {
+	return isAddrIncluded(addr, p.RegistrationDenylist)
+}
+
 // isAddrIncluded checks if the provided common.Address is within a slice
 // of hex string addresses
 func isAddrIncluded(addr common.Address, strAddrs []string) bool {
@@ -3,7 +3,10 @@ package types_test
 import (
 	"slices"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/erc20/types"
 	"github.com/stretchr/testify/require"
@@ -131,6 +134,69 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			true,
 			"precompiles need to be sorted",
 		},
+		{
+			"invalid registration deposit",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDeposit = sdk.Coins{{Denom: "aevmos", Amount: math.NewInt(-1)}}
+				return params
+			},
+			true,
+			"invalid registration deposit",
+		},
+		{
+			"invalid denom creation fee",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.DenomCreationFee = sdk.Coins{{Denom: "aevmos", Amount: math.NewInt(-1)}}
+				return params
+			},
+			true,
+			"invalid denom creation fee",
+		},
+		{
+			"negative registration deposit period",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDepositPeriod = -time.Second
+				return params
+			},
+			true,
+			"registration deposit period cannot be negative",
+		},
+		{
+			"non EIP-55 denylisted address",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDenylist = []string{"0xcc491f589b45d4a3c679016195b3fb87d7848210"}
+				return params
+			},
+			true,
+			"invalid denylisted address",
+		},
+		{
+			"unsorted denylist",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDenylist = []string{
+					common.HexToAddress(types.WEVMOSContractTestnet).Hex(),
+					types.WEVMOSContractMainnet,
+				}
+				return params
+			},
+			true,
+			"registration denylist needs to be sorted",
+		},
+		{
+			"duplicate denylisted address",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.RegistrationDenylist = []string{types.WEVMOSContractMainnet, types.WEVMOSContractMainnet}
+				return params
+			},
+			true,
+			"duplicate denylisted address",
+		},
 	}
 
 	for _, tc := range testCases {
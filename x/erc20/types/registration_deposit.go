@@ -0,0 +1,59 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MaxRegistrationDepositReleaseAttempts is the number of failed attempts
+	// after which the release of a registration deposit is no longer retried
+	MaxRegistrationDepositReleaseAttempts = 8
+	// RegistrationDepositRetryDelay is the delay before retrying a failed
+	// registration deposit release. It doubles after every failed attempt.
+	RegistrationDepositRetryDelay = time.Hour
+)
+
+// NewRegistrationDeposit returns a new instance of RegistrationDeposit
+func NewRegistrationDeposit(
+	contract common.Address,
+	depositor sdk.AccAddress,
+	amount sdk.Coins,
+	releaseTime time.Time,
+) RegistrationDeposit {
+	return RegistrationDeposit{
+		Erc20Address: contract.String(),
+		Depositor:    depositor.String(),
+		Amount:       amount,
+		ReleaseTime:  releaseTime,
+	}
+}
+
+// Validate performs a stateless validation of a RegistrationDeposit
+func (rd RegistrationDeposit) Validate() error {
+	if !common.IsHexAddress(rd.Erc20Address) {
+		return fmt.Errorf("invalid ERC20 contract address %s", rd.Erc20Address)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(rd.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor address %s: %w", rd.Depositor, err)
+	}
+
+	return rd.Amount.Validate()
+}
+
+// NextReleaseTime returns the time of the next attempt to release the deposit
+// after a release failed at the given time, backing off exponentially with the
+// number of failed attempts.
+func (rd RegistrationDeposit) NextReleaseTime(failedAt time.Time) time.Time {
+	if rd.ReleaseAttempts == 0 {
+		return failedAt
+	}
+	return failedAt.Add(RegistrationDepositRetryDelay << (rd.ReleaseAttempts - 1))
+}
@@ -0,0 +1,115 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// FactoryDenomPrefix is the prefix of the denominations created through the token factory
+	FactoryDenomPrefix = "factory"
+	// MaxSubdenomLength is the maximum length of the subdenom of a token factory denomination
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the creator of a token factory denomination
+	MaxCreatorLength = 59
+	// MaxFactoryDenomDecimals is the maximum number of decimals of a token factory
+	// denomination, which are returned as uint8 by the ERC20 decimals method
+	MaxFactoryDenomDecimals = math.MaxUint8
+)
+
+// GetFactoryDenom returns the token factory denomination for the given creator
+// and subdenom with the format factory/{creator}/{subdenom}.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom length must be between 1 and %d", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "creator length must be at most %d", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructFactoryDenom returns the creator and subdenom of a token factory
+// denomination. It returns an error if the denomination is not a valid token
+// factory denomination.
+func DeconstructFactoryDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "denom %s must have the format %s/{creator}/{subdenom}", denom, FactoryDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	subdenom = parts[2]
+	if len(subdenom) == 0 || len(subdenom) > MaxSubdenomLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom length must be between 1 and %d", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
+
+// IsFactoryDenom returns true if the denomination has the token factory prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// GetFactoryDenomAddress derives the ERC-20 extension address of a token factory
+// denomination from the SHA256 hash of the denomination.
+func GetFactoryDenomAddress(denom string) common.Address {
+	return common.BytesToAddress(tmhash.Sum([]byte(denom)))
+}
+
+// NewFactoryDenomMetadata returns the bank metadata of a token factory denomination.
+// If decimals is greater than zero, the lowercase symbol is added as the display
+// denomination unit with the given exponent.
+func NewFactoryDenomMetadata(denom, name, symbol string, decimals uint32) banktypes.Metadata {
+	metadata := banktypes.Metadata{
+		Description: fmt.Sprintf("Token factory denomination %s", denom),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    name,
+		Symbol:  symbol,
+	}
+
+	if decimals > 0 {
+		display := strings.ToLower(symbol)
+		metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{
+			Denom:    display,
+			Exponent: decimals,
+		})
+		metadata.Display = display
+	}
+
+	return metadata
+}
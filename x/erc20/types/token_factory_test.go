@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expPass  bool
+	}{
+		{"pass", creator, "token", "factory/" + creator + "/token", true},
+		{"pass - subdenom with slash", creator, "my/token", "factory/" + creator + "/my/token", true},
+		{"fail - empty subdenom", creator, "", "", false},
+		{"fail - subdenom too long", creator, strings.Repeat("a", types.MaxSubdenomLength+1), "", false},
+		{"fail - creator with slash", "creator/a", "token", "", false},
+		{"fail - invalid character", creator, "tok*en", "", false},
+	}
+
+	for _, tc := range testCases {
+		denom, err := types.GetFactoryDenom(tc.creator, tc.subdenom)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expDenom, denom, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestDeconstructFactoryDenom(t *testing.T) {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expCreator  string
+		expSubdenom string
+		expPass     bool
+	}{
+		{"pass", "factory/" + creator + "/token", creator, "token", true},
+		{"pass - subdenom with slash", "factory/" + creator + "/my/token", creator, "my/token", true},
+		{"fail - no subdenom", "factory/" + creator, "", "", false},
+		{"fail - empty subdenom", "factory/" + creator + "/", "", "", false},
+		{"fail - invalid prefix", "ibc/" + creator + "/token", "", "", false},
+		{"fail - invalid creator", "factory/creator/token", "", "", false},
+		{"fail - native denom", "aevmos", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		creator, subdenom, err := types.DeconstructFactoryDenom(tc.denom)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expCreator, creator, tc.name)
+			require.Equal(t, tc.expSubdenom, subdenom, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewFactoryDenomMetadata(t *testing.T) {
+	denom := "factory/" + sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String() + "/token"
+
+	metadata := types.NewFactoryDenomMetadata(denom, "Token", "TKN", 0)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, denom, metadata.Display)
+	require.Len(t, metadata.DenomUnits, 1)
+
+	metadata = types.NewFactoryDenomMetadata(denom, "Token", "TKN", 18)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, "tkn", metadata.Display)
+	require.Len(t, metadata.DenomUnits, 2)
+	require.Equal(t, uint32(18), metadata.DenomUnits[1].Exponent)
+
+	metadata = types.NewFactoryDenomMetadata(denom, "", "TKN", 18)
+	require.Error(t, metadata.Validate())
+}
+
+func TestNewTokenPairSTRv2FactoryDenom(t *testing.T) {
+	denom := "factory/" + sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String() + "/token"
+
+	pair, err := types.NewTokenPairSTRv2(denom)
+	require.NoError(t, err)
+	require.Equal(t, types.GetFactoryDenomAddress(denom).String(), pair.Erc20Address)
+	require.Equal(t, types.OWNER_MODULE, pair.ContractOwner)
+	require.NoError(t, pair.Validate())
+}
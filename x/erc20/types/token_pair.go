@@ -4,6 +4,7 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -15,9 +16,18 @@ import (
 // Single Token Representation v2.
 //
 // It derives the ERC-20 address from the hex suffix of the IBC denomination
-// (e.g. ibc/DF63978F803A2E27CA5CC9B7631654CCF0BBC788B3B7F0A10200508E37C70992).
+// (e.g. ibc/DF63978F803A2E27CA5CC9B7631654CCF0BBC788B3B7F0A10200508E37C70992)
+// or from the hash of a token factory denomination.
 func NewTokenPairSTRv2(denom string) (TokenPair, error) {
-	address, err := utils.GetIBCDenomAddress(denom)
+	var (
+		address common.Address
+		err     error
+	)
+	if IsFactoryDenom(denom) {
+		address = GetFactoryDenomAddress(denom)
+	} else {
+		address, err = utils.GetIBCDenomAddress(denom)
+	}
 	if err != nil {
 		return TokenPair{}, err
 	}
@@ -56,7 +66,18 @@ func (tp TokenPair) Validate() error {
 		return err
 	}
 
-	return evmostypes.ValidateAddress(tp.Erc20Address)
+	if err := evmostypes.ValidateAddress(tp.Erc20Address); err != nil {
+		return err
+	}
+
+	if tp.Minter != "" {
+		if !tp.IsNativeCoin() {
+			return errorsmod.Wrap(ErrInvalidMinter, "only module owned token pairs can have a minter")
+		}
+		return evmostypes.ValidateAddress(tp.Minter)
+	}
+
+	return nil
 }
 
 // IsNativeCoin returns true if the owner of the ERC20 contract is the
@@ -65,6 +86,11 @@ func (tp TokenPair) IsNativeCoin() bool {
 	return tp.ContractOwner == OWNER_MODULE
 }
 
+// IsMinter returns true if the given address is the minter of the token pair.
+func (tp TokenPair) IsMinter(address common.Address) bool {
+	return tp.Minter != "" && common.HexToAddress(tp.Minter) == address
+}
+
 // IsNativeERC20 returns true if the owner of the ERC20 contract is an EOA.
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
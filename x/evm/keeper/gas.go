@@ -31,6 +31,18 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+	return k.refundGas(ctx, msg, leftoverGas, denom, msg.From().Bytes())
+}
+
+// RefundGasToFeeGranter transfers the leftover gas to the granter that paid the
+// fees of the message through a fee allowance.
+func (k *Keeper) RefundGasToFeeGranter(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string, granter sdk.AccAddress) error {
+	return k.refundGas(ctx, msg, leftoverGas, denom, granter)
+}
+
+// refundGas transfers the leftover gas of the message to the recipient, exchanged
+// at the gas price of the message.
+func (k *Keeper) refundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string, recipient sdk.AccAddress) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
@@ -42,8 +54,8 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		// refund from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -55,6 +67,31 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// RefundGasInFeeToken transfers the leftover gas to the sender of the message in
+// the fee token used to pay the transaction fees, exchanged at the rate the fees
+// were paid with.
+func (k *Keeper) RefundGasInFeeToken(ctx sdk.Context, msg core.Message, leftoverGas uint64, feeToken sdk.DecCoin) error {
+	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
+	if remaining.Sign() < 0 {
+		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
+	}
+
+	amount := types.FeeTokenAmount(remaining, feeToken.Amount, false)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	refundedCoins := sdk.Coins{sdk.NewCoin(feeToken.Denom, amount)}
+
+	// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+	if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins); err != nil {
+		err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
+		return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+	}
+
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
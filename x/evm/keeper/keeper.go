@@ -331,3 +331,85 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// ResetTransientTxFees reset the fees paid to prepare for execution of current cosmos tx, called in ante handler.
+func (k Keeper) ResetTransientTxFees(ctx sdk.Context) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Delete(types.KeyPrefixTransientTxFees)
+}
+
+// GetTransientTxFees returns the fees paid by the eth msgs of current cosmos tx, after
+// the leftover gas is refunded. The fees are expressed with 18 decimals.
+func (k Keeper) GetTransientTxFees(ctx sdk.Context) *big.Int {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientTxFees)
+	if len(bz) == 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).SetBytes(bz)
+}
+
+// AddTransientTxFees accumulate the fees paid by each eth msgs included in current cosmos tx.
+func (k Keeper) AddTransientTxFees(ctx sdk.Context, fees *big.Int) {
+	result := new(big.Int).Add(k.GetTransientTxFees(ctx), fees)
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientTxFees, result.Bytes())
+}
+
+// GetTransientFeeToken returns the fee token used to pay the fees of the eth tx
+// with the given hash, with its amount set to the conversion rate. It returns
+// false if the fees are paid in the EVM coin.
+func (k Keeper) GetTransientFeeToken(ctx sdk.Context, txHash common.Hash) (sdk.DecCoin, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeToken)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return sdk.DecCoin{}, false
+	}
+
+	var feeToken sdk.DecCoin
+	k.cdc.MustUnmarshal(bz, &feeToken)
+	return feeToken, true
+}
+
+// SetTransientFeeToken sets the fee token used to pay the fees of the eth tx
+// with the given hash, called in ante handler. The amount of the coin is the
+// conversion rate, so that the leftover gas is refunded in the same token.
+func (k Keeper) SetTransientFeeToken(ctx sdk.Context, txHash common.Hash, feeToken sdk.DecCoin) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeToken)
+	store.Set(txHash.Bytes(), k.cdc.MustMarshal(&feeToken))
+}
+
+// GetTransientFeeGranter returns the granter that paid the fees of the eth tx with
+// the given hash through a fee allowance. It returns false if the fees are paid by
+// the sender.
+func (k Keeper) GetTransientFeeGranter(ctx sdk.Context, txHash common.Hash) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeGranter)
+	bz := store.Get(txHash.Bytes())
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetTransientFeeGranter sets the granter that paid the fees of the eth tx with
+// the given hash, called in ante handler, so that the leftover gas is refunded
+// to the granter.
+func (k Keeper) SetTransientFeeGranter(ctx sdk.Context, txHash common.Hash, granter sdk.AccAddress) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientFeeGranter)
+	store.Set(txHash.Bytes(), granter.Bytes())
+}
+
+// IsTransientBundleTx returns true if the eth tx with the given hash is part of
+// an atomic bundle of ethereum transactions.
+func (k Keeper) IsTransientBundleTx(ctx sdk.Context, txHash common.Hash) bool {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBundleTx)
+	return store.Has(txHash.Bytes())
+}
+
+// SetTransientBundleTx marks the eth tx with the given hash as part of an atomic
+// bundle of ethereum transactions, called in ante handler, so that the whole
+// bundle is reverted if the transaction fails.
+func (k Keeper) SetTransientBundleTx(ctx sdk.Context, txHash common.Hash) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBundleTx)
+	store.Set(txHash.Bytes(), []byte{1})
+}
@@ -49,6 +49,11 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// a failed transaction of a bundle reverts all the transactions of the bundle
+	if response.Failed() && k.IsTransientBundleTx(ctx, tx.Hash()) {
+		return nil, errorsmod.Wrapf(types.ErrBundleTxFailed, "transaction %s: %s", response.Hash, response.VmError)
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
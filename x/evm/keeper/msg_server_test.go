@@ -9,7 +9,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -82,6 +84,93 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	suite.enableFeemarket = false
 }
 
+func (suite *KeeperTestSuite) TestEthereumTxBundle() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+
+	// revertingCode is the init code of a contract that reverts on deployment
+	revertingCode := common.FromHex("0x60006000fd")
+	value := big.NewInt(1000)
+
+	testCases := []struct {
+		name      string
+		secondTx  func(recipient common.Address) types.EvmTxArgs
+		expPass   bool
+		expAmount *big.Int
+	}{
+		{
+			"success - all the transactions are executed",
+			func(recipient common.Address) types.EvmTxArgs {
+				return types.EvmTxArgs{To: &recipient, Amount: value}
+			},
+			true,
+			new(big.Int).Mul(value, big.NewInt(2)),
+		},
+		{
+			"fail - a failed transaction reverts the whole bundle",
+			func(common.Address) types.EvmTxArgs {
+				return types.EvmTxArgs{Input: revertingCode, GasLimit: 100000}
+			},
+			false,
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			recipient := utiltx.GenerateAddress()
+
+			firstMsg, err := suite.factory.GenerateSignedMsgEthereumTx(
+				suite.keyring.GetPrivKey(0),
+				types.EvmTxArgs{To: &recipient, Amount: value},
+			)
+			suite.Require().NoError(err)
+			secondMsg, err := suite.factory.GenerateSignedMsgEthereumTx(suite.keyring.GetPrivKey(1), tc.secondTx(recipient))
+			suite.Require().NoError(err)
+
+			txConfig := suite.network.App.GetTxConfig()
+			tx, err := types.BuildBundleTx(
+				txConfig.NewTxBuilder(),
+				[]*types.MsgEthereumTx{&firstMsg, &secondMsg},
+				types.GetEVMCoinDenom(),
+			)
+			suite.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			suite.Require().NoError(err)
+
+			blockRes, err := suite.network.NextBlockWithTxs(txBytes)
+			suite.Require().NoError(err)
+			suite.Require().Len(blockRes.TxResults, 1)
+			res := blockRes.TxResults[0]
+
+			if tc.expPass {
+				suite.Require().True(res.IsOK(), res.Log)
+
+				// each executed transaction of the bundle emits its own ethereum tx event with the gas used
+				var executedTxs int
+				for _, event := range res.Events {
+					if event.Type != types.EventTypeEthereumTx {
+						continue
+					}
+					for _, attr := range event.Attributes {
+						if attr.Key == types.AttributeKeyTxGasUsed {
+							executedTxs++
+						}
+					}
+				}
+				suite.Require().Equal(2, executedTxs)
+			} else {
+				suite.Require().False(res.IsOK())
+				suite.Require().Contains(res.Log, types.ErrBundleTxFailed.Error())
+			}
+
+			balance := suite.network.App.EvmKeeper.GetBalance(suite.network.GetContext(), recipient)
+			suite.Require().Equal(tc.expAmount, balance)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	suite.SetupTest()
 	testCases := []struct {
@@ -190,8 +190,24 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	evmDenom := types.GetEVMCoinDenom()
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, evmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	// When the fees were paid in a fee token, the leftover gas is refunded in the same token and
+	// the fees are kept by the fee collector instead of being split in the post handler.
+	if feeToken, found := k.GetTransientFeeToken(ctx, tx.Hash()); found {
+		if err = k.RefundGasInFeeToken(ctx, msg, msg.Gas()-res.GasUsed, feeToken); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+		}
+	} else {
+		// the leftover gas of sponsored transactions is refunded to the fee granter
+		if granter, found := k.GetTransientFeeGranter(ctx, tx.Hash()); found {
+			if err = k.RefundGasToFeeGranter(ctx, msg, msg.Gas()-res.GasUsed, evmDenom, granter); err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to fee granter %s", granter)
+			}
+		} else if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, evmDenom); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+		}
+
+		// track the fees paid for the gas used, so that they can be split in the post handler
+		k.AddTransientTxFees(ctx, new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), msg.GasPrice()))
 	}
 
 	if len(logs) > 0 {
@@ -474,6 +474,290 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRefundGasToFeeGranter() {
+	// FeeCollector account is pre-funded with enough tokens
+	// for refund to work
+	baseDenom := types.GetEVMCoinDenom()
+	feeCollectorBalance := sdkmath.NewInt(6e18)
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(baseDenom, feeCollectorBalance)),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testkeyring.New(3)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(1)
+	granter := keyring.GetAccAddr(2)
+
+	testCases := []struct {
+		name        string
+		leftoverGas uint64
+		gasPrice    *big.Int
+		expRefund   sdkmath.Int
+		expPass     bool
+	}{
+		{
+			name:        "pass - leftover gas refunded to the granter",
+			leftoverGas: 1000,
+			gasPrice:    big.NewInt(100),
+			expRefund:   sdkmath.NewInt(100_000),
+			expPass:     true,
+		},
+		{
+			name:        "pass - no leftover gas",
+			leftoverGas: 0,
+			gasPrice:    big.NewInt(100),
+			expRefund:   sdkmath.ZeroInt(),
+			expPass:     true,
+		},
+		{
+			name:        "fail - negative gas price",
+			leftoverGas: 1000,
+			gasPrice:    big.NewInt(-100),
+			expPass:     false,
+		},
+		{
+			name:        "fail - insufficient fee collector balance",
+			leftoverGas: 1000,
+			gasPrice:    feeCollectorBalance.BigInt(),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := unitNetwork.GetContext().CacheContext()
+
+			coreMsg, err := txFactory.GenerateGethCoreMsg(
+				sender.Priv,
+				types.EvmTxArgs{
+					To:       &recipient,
+					Amount:   big.NewInt(100),
+					GasPrice: tc.gasPrice,
+				},
+			)
+			suite.Require().NoError(err)
+
+			prevGranterBalance := unitNetwork.App.BankKeeper.GetBalance(ctx, granter, baseDenom)
+			prevSenderBalance := unitNetwork.App.BankKeeper.GetBalance(ctx, sender.AccAddr, baseDenom)
+
+			err = unitNetwork.App.EvmKeeper.RefundGasToFeeGranter(
+				ctx,
+				coreMsg,
+				tc.leftoverGas,
+				baseDenom,
+				granter,
+			)
+
+			granterBalance := unitNetwork.App.BankKeeper.GetBalance(ctx, granter, baseDenom)
+			senderBalance := unitNetwork.App.BankKeeper.GetBalance(ctx, sender.AccAddr, baseDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(prevGranterBalance.Amount.Add(tc.expRefund), granterBalance.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(prevGranterBalance, granterBalance)
+			}
+			// the sender never receives the refund of a sponsored transaction
+			suite.Require().Equal(prevSenderBalance, senderBalance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundGasInFeeToken() {
+	// FeeCollector account is pre-funded with enough fee tokens
+	// for refund to work
+	feeTokenDenom := "afeetoken"
+	feeCollectorBalance := sdkmath.NewInt(1e18)
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(feeTokenDenom, feeCollectorBalance)),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(1)
+
+	testCases := []struct {
+		name        string
+		leftoverGas uint64
+		gasPrice    *big.Int
+		rate        sdkmath.LegacyDec
+		expRefund   sdkmath.Int
+		expPass     bool
+	}{
+		{
+			name:        "pass - refund at a rate of one",
+			leftoverGas: 1000,
+			gasPrice:    big.NewInt(100),
+			rate:        sdkmath.LegacyOneDec(),
+			expRefund:   sdkmath.NewInt(100_000),
+			expPass:     true,
+		},
+		{
+			name:        "pass - refund is rounded down",
+			leftoverGas: 1000,
+			gasPrice:    big.NewInt(100),
+			rate:        sdkmath.LegacyNewDec(3),
+			expRefund:   sdkmath.NewInt(33_333),
+			expPass:     true,
+		},
+		{
+			name:        "pass - refund lower than one fee token unit is not refunded",
+			leftoverGas: 1,
+			gasPrice:    big.NewInt(100),
+			rate:        sdkmath.LegacyNewDec(1000),
+			expRefund:   sdkmath.ZeroInt(),
+			expPass:     true,
+		},
+		{
+			name:        "pass - no leftover gas",
+			leftoverGas: 0,
+			gasPrice:    big.NewInt(100),
+			rate:        sdkmath.LegacyOneDec(),
+			expRefund:   sdkmath.ZeroInt(),
+			expPass:     true,
+		},
+		{
+			name:        "fail - negative gas price",
+			leftoverGas: 1000,
+			gasPrice:    big.NewInt(-100),
+			rate:        sdkmath.LegacyOneDec(),
+			expPass:     false,
+		},
+		{
+			name:        "fail - insufficient fee collector balance",
+			leftoverGas: 1000,
+			gasPrice:    feeCollectorBalance.BigInt(),
+			rate:        sdkmath.LegacyOneDec(),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := unitNetwork.GetContext().CacheContext()
+
+			coreMsg, err := txFactory.GenerateGethCoreMsg(
+				sender.Priv,
+				types.EvmTxArgs{
+					To:       &recipient,
+					Amount:   big.NewInt(100),
+					GasPrice: tc.gasPrice,
+				},
+			)
+			suite.Require().NoError(err)
+
+			prevBalance := unitNetwork.App.BankKeeper.GetBalance(ctx, sender.AccAddr, feeTokenDenom)
+
+			err = unitNetwork.App.EvmKeeper.RefundGasInFeeToken(
+				ctx,
+				coreMsg,
+				tc.leftoverGas,
+				sdk.NewDecCoinFromDec(feeTokenDenom, tc.rate),
+			)
+
+			balance := unitNetwork.App.BankKeeper.GetBalance(ctx, sender.AccAddr, feeTokenDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(prevBalance.Amount.Add(tc.expRefund), balance.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(prevBalance, balance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionWithFeeToken() {
+	feeTokenDenom := "afeetoken"
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(feeTokenDenom, sdkmath.NewInt(1e18))),
+		},
+	}
+	customGenesis := network.CustomGenesisState{}
+	customGenesis[banktypes.ModuleName] = bankGenesis
+
+	keyring := testkeyring.New(2)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+
+	sender := keyring.GetKey(0)
+	recipient := keyring.GetAddr(1)
+	gasLimit := uint64(100_000)
+	gasPrice := big.NewInt(1_000_000_001)
+	rate := sdkmath.LegacyNewDec(3)
+
+	msg, err := txFactory.GenerateSignedMsgEthereumTx(
+		sender.Priv,
+		types.EvmTxArgs{
+			To:       &recipient,
+			Amount:   big.NewInt(100),
+			GasLimit: gasLimit,
+			GasPrice: gasPrice,
+		},
+	)
+	suite.Require().NoError(err)
+	tx := msg.AsTransaction()
+
+	ctx := unitNetwork.GetContext()
+	evmKeeper := unitNetwork.App.EvmKeeper
+	evmKeeper.ResetTransientTxFees(ctx)
+	evmKeeper.SetTransientFeeToken(ctx, tx.Hash(), sdk.NewDecCoinFromDec(feeTokenDenom, rate))
+
+	prevBalance := unitNetwork.App.BankKeeper.GetBalance(ctx, sender.AccAddr, feeTokenDenom)
+
+	res, err := evmKeeper.ApplyTransaction(ctx, tx)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+	suite.Require().Less(res.GasUsed, gasLimit)
+
+	// the leftover gas is refunded in the fee token, rounded down
+	leftoverFees := new(big.Int).Mul(new(big.Int).SetUint64(gasLimit-res.GasUsed), gasPrice)
+	expRefund := new(big.Int).Quo(leftoverFees, rate.TruncateInt().BigInt())
+	suite.Require().NotZero(new(big.Int).Rem(leftoverFees, rate.TruncateInt().BigInt()).Sign(), "expected a remainder to check the rounding")
+
+	balance := unitNetwork.App.BankKeeper.GetBalance(ctx, sender.AccAddr, feeTokenDenom)
+	suite.Require().Equal(prevBalance.Amount.Add(sdkmath.NewIntFromBigInt(expRefund)), balance.Amount)
+
+	// the fees paid in fee tokens are not tracked to be split in the post handler
+	suite.Require().Zero(evmKeeper.GetTransientTxFees(ctx).Sign())
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	suite.SetupTest()
 	testCases := []struct {
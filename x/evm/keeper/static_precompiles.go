@@ -21,6 +21,7 @@ import (
 	ics20precompile "github.com/evmos/evmos/v20/precompiles/ics20"
 	"github.com/evmos/evmos/v20/precompiles/p256"
 	stakingprecompile "github.com/evmos/evmos/v20/precompiles/staking"
+	tokenfactoryprecompile "github.com/evmos/evmos/v20/precompiles/tokenfactory"
 	vestingprecompile "github.com/evmos/evmos/v20/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
@@ -95,6 +96,11 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
 	}
 
+	tokenFactoryPrecompile, err := tokenfactoryprecompile.NewPrecompile(erc20Keeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate token factory precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -106,6 +112,7 @@ func NewAvailableStaticPrecompiles(
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles[tokenFactoryPrecompile.Address()] = tokenFactoryPrecompile
 	return precompiles
 }
 
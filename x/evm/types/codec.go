@@ -4,6 +4,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,7 +26,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName              = "ethermint/MsgUpdateParams"
+	allowedContractsAllowanceName = "ethermint/AllowedContractsAllowance"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -45,6 +47,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
 	)
+	registry.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&AllowedContractsAllowance{},
+	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
@@ -91,4 +97,5 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&AllowedContractsAllowance{}, allowedContractsAllowanceName, nil)
 }
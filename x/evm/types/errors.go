@@ -32,6 +32,7 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrBundleTxFailed
 )
 
 var (
@@ -88,6 +89,9 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrBundleTxFailed returns an error if an ethereum transaction of a bundle fails, reverting the whole bundle
+	ErrBundleTxFailed = errorsmod.Register(ModuleName, codeErrBundleTxFailed, "bundle transaction failed")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
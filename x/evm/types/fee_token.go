@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// FeeTokenAmount converts an amount of EVM coin, in its 18 decimals
+// representation, into the amount of fee token worth it at the given rate.
+// The rate is the amount of EVM coin worth one base unit of the fee token.
+// Fees are rounded up, so that they are never undercharged, while refunds are
+// rounded down.
+func FeeTokenAmount(amount *big.Int, rate sdkmath.LegacyDec, roundUp bool) sdkmath.Int {
+	converted := sdkmath.LegacyNewDecFromBigInt(amount).Quo(rate)
+	if roundUp {
+		return converted.Ceil().TruncateInt()
+	}
+	return converted.TruncateInt()
+}
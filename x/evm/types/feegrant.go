@@ -0,0 +1,171 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/feegrant"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/types"
+)
+
+// gasCostPerIteration is the gas consumed for each allowed contract or message
+// checked when accepting the fees, matching the feegrant module.
+const gasCostPerIteration = uint64(10)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*AllowedContractsAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*AllowedContractsAllowance)(nil)
+)
+
+// FeeGrantSignHash returns the hash signed by the sender of an Ethereum
+// transaction to agree that its fees are paid by the given fee granter. It is the
+// EIP-191 personal message hash of the chain ID, the transaction hash and the
+// granter address.
+func FeeGrantSignHash(chainID *big.Int, txHash common.Hash, granter sdk.AccAddress) []byte {
+	data := make([]byte, 0, common.HashLength*2+len(granter))
+	data = append(data, common.LeftPadBytes(chainID.Bytes(), common.HashLength)...)
+	data = append(data, txHash.Bytes()...)
+	data = append(data, granter.Bytes()...)
+	return accounts.TextHash(data)
+}
+
+// NewAllowedContractsAllowance creates a new fee allowance that only covers the
+// fees of Ethereum transactions calling the given contracts.
+func NewAllowedContractsAllowance(allowance feegrant.FeeAllowanceI, allowedContracts []string) (*AllowedContractsAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	anyAllowance, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AllowedContractsAllowance{
+		Allowance:        anyAllowance,
+		AllowedContracts: allowedContracts,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedContractsAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *AllowedContractsAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *AllowedContractsAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	var err error
+	a.Allowance, err = codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks that all the messages are Ethereum transactions calling one of
+// the allowed contracts, and deducts the fees from the wrapped allowance.
+func (a *AllowedContractsAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if !a.allContractsAllowed(sdk.UnwrapSDKContext(ctx), msgs) {
+		return false, errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "message does not call an allowed contract")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedContractsAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedContracts) == 0 {
+		return errorsmod.Wrap(feegrant.ErrNoMessages, "allowed contracts shouldn't be empty")
+	}
+
+	for _, contract := range a.AllowedContracts {
+		if err := types.ValidateNonZeroAddress(contract); err != nil {
+			return errorsmod.Wrapf(err, "invalid allowed contract %s", contract)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the wrapped allowance.
+func (a *AllowedContractsAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// allContractsAllowed returns true if all the messages are Ethereum
+// transactions calling one of the allowed contracts. Contract deployments are
+// never allowed.
+func (a *AllowedContractsAllowance) allContractsAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
+	contracts := make(map[common.Address]bool, len(a.AllowedContracts))
+	for _, contract := range a.AllowedContracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check contract")
+		contracts[common.HexToAddress(contract)] = true
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		ethMsg, ok := msg.(*MsgEthereumTx)
+		if !ok {
+			return false
+		}
+
+		tx := ethMsg.AsTransaction()
+		if tx == nil || tx.To() == nil || !contracts[*tx.To()] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,383 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/feegrant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AllowedContractsAllowance creates a fee allowance that only covers the fees of
+// Ethereum transactions calling the specified contracts.
+type AllowedContractsAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_contracts are the hex addresses of the contracts the grantee can call
+	// with the allowance.
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+}
+
+func (m *AllowedContractsAllowance) Reset()         { *m = AllowedContractsAllowance{} }
+func (m *AllowedContractsAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedContractsAllowance) ProtoMessage()    {}
+func (*AllowedContractsAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c023f2958185f28, []int{0}
+}
+func (m *AllowedContractsAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedContractsAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedContractsAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedContractsAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedContractsAllowance.Merge(m, src)
+}
+func (m *AllowedContractsAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedContractsAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedContractsAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedContractsAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AllowedContractsAllowance)(nil), "ethermint.evm.v1.AllowedContractsAllowance")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/feegrant.proto", fileDescriptor_6c023f2958185f28) }
+
+var fileDescriptor_6c023f2958185f28 = []byte{
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4a, 0x33, 0x41,
+	0x10, 0xc7, 0x6f, 0xbf, 0x0f, 0x84, 0x9c, 0x4d, 0x12, 0x52, 0x24, 0x29, 0x36, 0x41, 0x51, 0xa2,
+	0xe2, 0xae, 0x17, 0x3b, 0x2b, 0x13, 0x41, 0xb0, 0x0d, 0xd8, 0x08, 0x12, 0xf6, 0xce, 0xc9, 0x26,
+	0x90, 0xdd, 0x09, 0x77, 0x9b, 0xd5, 0xbc, 0x81, 0x58, 0xf9, 0x08, 0x3e, 0x82, 0x85, 0x0f, 0x21,
+	0x56, 0x29, 0x2d, 0x25, 0x29, 0x7c, 0x0b, 0x91, 0xdc, 0xe6, 0x12, 0x10, 0x04, 0x9b, 0x61, 0x7e,
+	0x37, 0x73, 0xff, 0xff, 0xcc, 0xac, 0x5f, 0x03, 0xd3, 0x87, 0x58, 0x0d, 0xb4, 0xe1, 0x60, 0x15,
+	0xb7, 0x01, 0xef, 0x01, 0xc8, 0x58, 0x68, 0xc3, 0x46, 0x31, 0x1a, 0x2c, 0xe6, 0x57, 0x0d, 0x0c,
+	0xac, 0x62, 0x36, 0xa8, 0x16, 0x84, 0x1a, 0x68, 0xe4, 0x69, 0x74, 0x4d, 0xd5, 0x4a, 0x84, 0x89,
+	0xc2, 0xa4, 0x9b, 0x12, 0x77, 0xb0, 0x2c, 0x95, 0x24, 0x4a, 0x74, 0xdf, 0x17, 0x59, 0xf6, 0x83,
+	0x44, 0x94, 0x43, 0xe0, 0x29, 0x85, 0xe3, 0x1e, 0x17, 0x7a, 0xe2, 0x4a, 0x5b, 0x5f, 0xc4, 0xaf,
+	0xb4, 0x86, 0x43, 0xbc, 0x85, 0x9b, 0x33, 0xd4, 0x26, 0x16, 0x91, 0x49, 0x52, 0x16, 0x3a, 0x82,
+	0xe2, 0xb5, 0x9f, 0x13, 0x19, 0x94, 0x49, 0x9d, 0x34, 0x36, 0x9b, 0x25, 0xe6, 0xc4, 0x58, 0x26,
+	0xc6, 0x5a, 0x7a, 0xd2, 0xde, 0x7b, 0x7b, 0x39, 0xdc, 0x59, 0x4e, 0xb2, 0x5a, 0xc9, 0x06, 0x21,
+	0x18, 0x11, 0xb0, 0x73, 0x80, 0x95, 0xe4, 0x45, 0x67, 0xad, 0x58, 0x3c, 0xf0, 0x0b, 0xc2, 0x79,
+	0x77, 0xa3, 0xcc, 0xbc, 0xfc, 0xaf, 0xfe, 0xbf, 0x91, 0xeb, 0xe4, 0xc5, 0x8f, 0xa1, 0x4e, 0x2e,
+	0xef, 0x9f, 0x6a, 0xde, 0x9f, 0x6d, 0x1e, 0x3e, 0x9f, 0xf7, 0xb7, 0xd7, 0xd7, 0xfe, 0x75, 0xc5,
+	0xf6, 0xe9, 0xeb, 0x8c, 0x92, 0xe9, 0x8c, 0x92, 0x8f, 0x19, 0x25, 0x8f, 0x73, 0xea, 0x4d, 0xe7,
+	0xd4, 0x7b, 0x9f, 0x53, 0xef, 0x6a, 0x57, 0x0e, 0x4c, 0x7f, 0x1c, 0xb2, 0x08, 0xd5, 0xe2, 0xb5,
+	0x30, 0x59, 0x46, 0xdb, 0x3c, 0xe2, 0x77, 0x8b, 0x9c, 0x9b, 0xc9, 0x08, 0x92, 0x70, 0x23, 0xbd,
+	0xc4, 0xf1, 0xf7, 0x00, 0x30, 0x9c, 0x73, 0xec, 0xdd, 0x01, 0x00, 0x00,
+}
+
+func (m *AllowedContractsAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedContractsAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedContractsAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllowedContractsAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowedContractsAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedContractsAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedContractsAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
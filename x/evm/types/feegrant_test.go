@@ -0,0 +1,151 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedContractsAllowanceValidateBasic(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	expiration := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		allowance feegrant.FeeAllowanceI
+		contracts []string
+		expPass   bool
+	}{
+		{
+			"pass - valid allowance",
+			&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)), Expiration: &expiration},
+			[]string{contract.Hex()},
+			true,
+		},
+		{
+			"fail - no allowed contracts",
+			&feegrant.BasicAllowance{},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid contract address",
+			&feegrant.BasicAllowance{},
+			[]string{"invalid"},
+			false,
+		},
+		{
+			"fail - zero contract address",
+			&feegrant.BasicAllowance{},
+			[]string{common.Address{}.Hex()},
+			false,
+		},
+		{
+			"fail - invalid wrapped allowance",
+			&feegrant.BasicAllowance{SpendLimit: sdk.Coins{{Denom: "aevmos", Amount: math.NewInt(-1)}}},
+			[]string{contract.Hex()},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowance, err := types.NewAllowedContractsAllowance(tc.allowance, tc.contracts)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAllowedContractsAllowanceAccept(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 40))
+
+	newMsg := func(to *common.Address) sdk.Msg {
+		return types.NewTx(&types.EvmTxArgs{
+			ChainID:  big.NewInt(9000),
+			To:       to,
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		expRemove bool
+		expPass   bool
+	}{
+		{
+			"pass - call to an allowed contract",
+			[]sdk.Msg{newMsg(&contract)},
+			false,
+			true,
+		},
+		{
+			"pass - spend limit is exhausted",
+			[]sdk.Msg{newMsg(&contract), newMsg(&contract)},
+			true,
+			true,
+		},
+		{
+			"fail - call to another contract",
+			[]sdk.Msg{newMsg(&contract), newMsg(&other)},
+			false,
+			false,
+		},
+		{
+			"fail - contract deployment",
+			[]sdk.Msg{newMsg(nil)},
+			false,
+			false,
+		},
+		{
+			"fail - not an ethereum transaction",
+			[]sdk.Msg{&banktypes.MsgSend{}},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+			allowance, err := types.NewAllowedContractsAllowance(
+				&feegrant.BasicAllowance{SpendLimit: fee.MulInt(math.NewInt(2))},
+				[]string{contract.Hex()},
+			)
+			require.NoError(t, err)
+
+			remove, err := allowance.Accept(ctx, fee.MulInt(math.NewInt(int64(len(tc.msgs)))), tc.msgs)
+			if !tc.expPass {
+				require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expRemove, remove)
+		})
+	}
+}
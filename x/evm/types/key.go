@@ -37,6 +37,10 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientTxFees
+	prefixTransientFeeToken
+	prefixTransientFeeGranter
+	prefixTransientBundleTx
 )
 
 // KVStore key prefixes
@@ -49,10 +53,14 @@ var (
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom      = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex    = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize    = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed    = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientTxFees     = []byte{prefixTransientTxFees}
+	KeyPrefixTransientFeeToken   = []byte{prefixTransientFeeToken}
+	KeyPrefixTransientFeeGranter = []byte{prefixTransientFeeGranter}
+	KeyPrefixTransientBundleTx   = []byte{prefixTransientBundleTx}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
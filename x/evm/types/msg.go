@@ -362,6 +362,51 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 	return tx, nil
 }
 
+// BuildBundleTx builds a cosmos tx wrapping the ethereum transactions as an
+// atomic bundle, so that either all of them are executed or none of them.
+func BuildBundleTx(b client.TxBuilder, msgs []*MsgEthereumTx, evmDenom string) (signing.Tx, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("empty bundle")
+	}
+
+	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return nil, errors.New("unsupported builder")
+	}
+
+	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{Bundle: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		fees     = sdk.Coins{}
+		gasLimit uint64
+		sdkMsgs  = make([]sdk.Msg, 0, len(msgs))
+	)
+	for _, msg := range msgs {
+		txData, err := UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		fees = fees.Add(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(txData.Fee())))
+		gasLimit += msg.GetGas()
+
+		// A valid msg should have empty `From`
+		msg.From = ""
+		sdkMsgs = append(sdkMsgs, msg)
+	}
+
+	builder.SetExtensionOptions(option)
+
+	if err := builder.SetMsgs(sdkMsgs...); err != nil {
+		return nil, err
+	}
+	builder.SetFeeAmount(fees)
+	builder.SetGasLimit(gasLimit)
+	return builder.GetTx(), nil
+}
+
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -134,6 +135,61 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestBuildBundleTx() {
+	newMsg := func(nonce uint64) *types.MsgEthereumTx {
+		return types.NewTx(&types.EvmTxArgs{
+			Nonce:    nonce,
+			To:       &suite.to,
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+	}
+
+	testCases := []struct {
+		name     string
+		msgs     []*types.MsgEthereumTx
+		expError bool
+	}{
+		{
+			"build bundle - pass",
+			[]*types.MsgEthereumTx{newMsg(0), newMsg(1)},
+			false,
+		},
+		{
+			"build bundle - fail: empty bundle",
+			nil,
+			true,
+		},
+		{
+			"build bundle - fail: nil data",
+			[]*types.MsgEthereumTx{newMsg(0), {}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		baseDenom := types.GetEVMCoinDenom()
+
+		tx, err := types.BuildBundleTx(suite.clientCtx.TxConfig.NewTxBuilder(), tc.msgs, baseDenom)
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Len(tx.GetMsgs(), len(tc.msgs))
+		suite.Require().Equal(uint64(200000), tx.GetGas())
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(baseDenom, sdkmath.NewInt(200000))), tx.GetFee())
+
+		extTx, ok := tx.(authante.HasExtensionOptionsTx)
+		suite.Require().True(ok)
+		suite.Require().Len(extTx.GetExtensionOptions(), 1)
+		option, ok := extTx.GetExtensionOptions()[0].GetCachedValue().(*types.ExtensionOptionsEthereumTx)
+		suite.Require().True(ok)
+		suite.Require().True(option.Bundle)
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	var (
 		hundredInt   = big.NewInt(100)
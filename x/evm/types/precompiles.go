@@ -15,6 +15,7 @@ const (
 	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
+	TokenFactoryPrecompileAddress = "0x0000000000000000000000000000000000000806"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -30,4 +31,5 @@ var AvailableStaticPrecompiles = []string{
 	VestingPrecompileAddress,
 	BankPrecompileAddress,
 	GovPrecompileAddress,
+	TokenFactoryPrecompileAddress,
 }
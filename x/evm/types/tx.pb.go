@@ -261,6 +261,14 @@ var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
+	// bundle defines if the ethereum transactions are executed atomically, so that
+	// the whole transaction is reverted if any of them fails.
+	Bundle bool `protobuf:"varint,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// fee_grant_signatures defines, for each ethereum transaction, the signature by
+	// its sender of the fee granter of the transaction, so that the fees can only be
+	// charged to the granter the sender agreed to. The signatures are EIP-191
+	// personal signatures of the chain ID, the transaction hash and the granter.
+	FeeGrantSignatures [][]byte `protobuf:"bytes,2,rep,name=fee_grant_signatures,json=feeGrantSignatures,proto3" json:"fee_grant_signatures,omitempty"`
 }
 
 func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthereumTx{} }
@@ -455,72 +463,75 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 1038 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xeb, 0xc4, 0xf9, 0x37, 0xc9, 0xef, 0x47, 0xb1, 0x5a, 0xea, 0x64, 0x21, 0xce, 0x1a,
-	0x16, 0xd2, 0x4a, 0xb5, 0xd9, 0x22, 0x21, 0x6d, 0xb8, 0xd0, 0x6c, 0xbb, 0x68, 0x51, 0x2b, 0x56,
-	0x26, 0x7b, 0x41, 0x48, 0x61, 0xea, 0x4c, 0x9d, 0x11, 0xb1, 0xc7, 0xf2, 0x4c, 0xac, 0x84, 0x13,
-	0xda, 0x13, 0xe2, 0x84, 0xc4, 0x95, 0x03, 0x07, 0x0e, 0x2b, 0x4e, 0x3d, 0x2c, 0xbc, 0x86, 0x15,
-	0xa7, 0x15, 0x5c, 0x10, 0x87, 0x80, 0x52, 0x50, 0xa5, 0x1e, 0x79, 0x05, 0x68, 0x66, 0x9c, 0x26,
-	0x69, 0x68, 0xbb, 0xac, 0x04, 0x97, 0x6a, 0x9e, 0x79, 0xfe, 0xcc, 0x93, 0xcf, 0xf3, 0xed, 0x8c,
-	0x41, 0x19, 0xb1, 0x2e, 0x8a, 0x7c, 0x1c, 0x30, 0x1b, 0xc5, 0xbe, 0x1d, 0xdf, 0xb4, 0xd9, 0xc0,
-	0x0a, 0x23, 0xc2, 0x88, 0xb6, 0x7c, 0xe6, 0xb2, 0x50, 0xec, 0x5b, 0xf1, 0xcd, 0xca, 0xf3, 0xd0,
-	0xc7, 0x01, 0xb1, 0xc5, 0x5f, 0x19, 0x54, 0x59, 0x73, 0x09, 0xf5, 0x09, 0xb5, 0x7d, 0xea, 0xf1,
-	0x64, 0x9f, 0x7a, 0x89, 0xa3, 0x2c, 0x1d, 0x6d, 0x61, 0xd9, 0xd2, 0x48, 0x5c, 0x95, 0x85, 0x33,
-	0x79, 0x7d, 0xe9, 0x5b, 0xf1, 0x88, 0x47, 0x64, 0x0e, 0x5f, 0x25, 0xbb, 0x2f, 0x7a, 0x84, 0x78,
-	0x3d, 0x64, 0xc3, 0x10, 0xdb, 0x30, 0x08, 0x08, 0x83, 0x0c, 0x93, 0x60, 0x52, 0xaf, 0x9c, 0x78,
-	0x85, 0x75, 0xd0, 0x3f, 0xb4, 0x61, 0x30, 0x94, 0x2e, 0xf3, 0x3b, 0x05, 0xfc, 0x6f, 0x9f, 0x7a,
-	0xbb, 0xfc, 0x40, 0xd4, 0xf7, 0x5b, 0x03, 0xad, 0x0e, 0xd4, 0x0e, 0x64, 0x50, 0x57, 0x6a, 0x4a,
-	0xbd, 0xb8, 0xb5, 0x62, 0xc9, 0x5c, 0x6b, 0x92, 0x6b, 0x6d, 0x07, 0x43, 0x47, 0x44, 0x68, 0x55,
-	0xa0, 0x52, 0xfc, 0x09, 0xd2, 0x53, 0x35, 0xa5, 0xae, 0x34, 0xc1, 0xe9, 0xc8, 0x50, 0x36, 0x1f,
-	0x9e, 0x1c, 0x6d, 0x28, 0x8e, 0xd8, 0xd7, 0x5e, 0x01, 0x6a, 0x17, 0xd2, 0xae, 0x9e, 0xae, 0x29,
-	0xf5, 0x42, 0x73, 0xf9, 0xcf, 0x91, 0x91, 0x8b, 0x7a, 0x61, 0xc3, 0xdc, 0x34, 0x93, 0x28, 0xee,
-	0xd5, 0x34, 0xa0, 0x1e, 0x46, 0xc4, 0xd7, 0x55, 0x1e, 0xe5, 0x88, 0x75, 0xa3, 0xf6, 0xd9, 0xd7,
-	0xc6, 0xd2, 0xe7, 0x27, 0x47, 0x1b, 0x6b, 0x53, 0x12, 0x73, 0x5d, 0x9a, 0x0f, 0x53, 0x20, 0xbf,
-	0x87, 0x3c, 0xe8, 0x0e, 0x5b, 0x03, 0x6d, 0x05, 0x64, 0x02, 0x12, 0xb8, 0x48, 0xf4, 0xac, 0x3a,
-	0xd2, 0xd0, 0xde, 0x04, 0x05, 0x0f, 0x72, 0xbe, 0xd8, 0x95, 0x3d, 0x16, 0x9a, 0xe5, 0x5f, 0x46,
-	0xc6, 0xaa, 0x44, 0x4d, 0x3b, 0x1f, 0x5b, 0x98, 0xd8, 0x3e, 0x64, 0x5d, 0xeb, 0x6e, 0xc0, 0x9c,
-	0xbc, 0x07, 0xe9, 0x3d, 0x1e, 0xaa, 0x55, 0x41, 0xda, 0x83, 0x54, 0x74, 0xad, 0x36, 0x4b, 0xe3,
-	0x91, 0x91, 0x7f, 0x07, 0xd2, 0x3d, 0xec, 0x63, 0xe6, 0x70, 0x87, 0xf6, 0x7f, 0x90, 0x62, 0x24,
-	0x69, 0x37, 0xc5, 0x88, 0x76, 0x0b, 0x64, 0x62, 0xd8, 0xeb, 0x23, 0x3d, 0x23, 0xce, 0x78, 0xf9,
-	0xc2, 0x33, 0xc6, 0x23, 0x23, 0xbb, 0xed, 0x93, 0x7e, 0xc0, 0x1c, 0x99, 0xc1, 0x7f, 0xbb, 0x60,
-	0x9d, 0xad, 0x29, 0xf5, 0x52, 0x42, 0xb5, 0x04, 0x94, 0x58, 0xcf, 0x89, 0x0d, 0x25, 0xe6, 0x56,
-	0xa4, 0xe7, 0xa5, 0x15, 0x71, 0x8b, 0xea, 0x05, 0x69, 0xd1, 0xc6, 0x0d, 0x4e, 0xe9, 0x87, 0x47,
-	0x9b, 0xd9, 0xd6, 0x60, 0x07, 0x32, 0xc8, 0x79, 0x69, 0x53, 0x5e, 0x13, 0x3a, 0xe6, 0x28, 0x0d,
-	0x4a, 0xdb, 0xae, 0x8b, 0x28, 0xdd, 0xc3, 0x94, 0xb5, 0x06, 0xda, 0xbb, 0x20, 0xef, 0x76, 0x21,
-	0x0e, 0xda, 0xb8, 0x23, 0x88, 0x15, 0x9a, 0xf6, 0x65, 0x3d, 0xe7, 0x6e, 0xf3, 0xe0, 0xbb, 0x3b,
-	0xa7, 0x23, 0x23, 0xe7, 0xca, 0xa5, 0x93, 0x2c, 0x3a, 0x53, 0xf4, 0xa9, 0x0b, 0xd1, 0xa7, 0xff,
-	0x31, 0x7a, 0xf5, 0x72, 0xf4, 0x99, 0x45, 0xf4, 0xd9, 0x67, 0x46, 0x9f, 0x9b, 0x41, 0xff, 0x11,
-	0xc8, 0x43, 0x01, 0x0a, 0x51, 0x3d, 0x5f, 0x4b, 0xd7, 0x8b, 0x5b, 0x2f, 0x59, 0xe7, 0xff, 0xc7,
-	0x2d, 0x89, 0xb2, 0xd5, 0x0f, 0x7b, 0xa8, 0x79, 0xe3, 0xf1, 0xc8, 0x58, 0x3a, 0x1d, 0x19, 0x00,
-	0x9e, 0xf1, 0xfd, 0xf6, 0x57, 0x03, 0x4c, 0x69, 0x4b, 0xa1, 0x9f, 0x55, 0x95, 0xc3, 0x2d, 0xcc,
-	0x0d, 0x17, 0xcc, 0x0d, 0xb7, 0x38, 0x19, 0xee, 0xfa, 0xe2, 0x70, 0x5f, 0x98, 0x0e, 0x77, 0x76,
-	0x9e, 0xe6, 0x57, 0x2a, 0x28, 0xed, 0x0c, 0x03, 0xe8, 0x63, 0xf7, 0x0e, 0x42, 0xff, 0xc9, 0x80,
-	0x6f, 0x81, 0x22, 0x1f, 0x30, 0xc3, 0x61, 0xdb, 0x85, 0xe1, 0xd5, 0x23, 0xe6, 0x72, 0x68, 0xe1,
-	0xf0, 0x36, 0x0c, 0x27, 0xa9, 0x87, 0x08, 0x89, 0x54, 0xf5, 0x69, 0x52, 0xef, 0x20, 0xc4, 0x53,
-	0x13, 0x79, 0x64, 0x2e, 0x97, 0x47, 0x76, 0x51, 0x1e, 0xb9, 0x67, 0x96, 0x47, 0xfe, 0x02, 0x79,
-	0x14, 0xfe, 0x3d, 0x79, 0x80, 0x39, 0x79, 0x14, 0xe7, 0xe4, 0x51, 0x7a, 0x3a, 0x79, 0xcc, 0xaa,
-	0xc1, 0x34, 0x41, 0x65, 0x77, 0xc0, 0x50, 0x40, 0x31, 0x09, 0xde, 0x0b, 0xc5, 0xbb, 0x30, 0xbd,
-	0x48, 0x1b, 0x2a, 0x2f, 0x64, 0x7e, 0xa3, 0x80, 0xd5, 0xb9, 0x0b, 0xd6, 0x41, 0x34, 0x24, 0x01,
-	0x15, 0x20, 0xc4, 0x25, 0xae, 0xc8, 0xeb, 0x59, 0x5c, 0xd9, 0xeb, 0x40, 0xed, 0x11, 0x8f, 0xea,
-	0x29, 0x01, 0x61, 0x75, 0x11, 0xc2, 0x1e, 0xf1, 0x1c, 0x11, 0xa2, 0x2d, 0x83, 0x74, 0x84, 0x98,
-	0x10, 0x48, 0xc9, 0xe1, 0x4b, 0xad, 0x0c, 0xf2, 0xb1, 0xdf, 0x46, 0x51, 0x44, 0xa2, 0xe4, 0x12,
-	0xcd, 0xc5, 0xfe, 0x2e, 0x37, 0xb9, 0x8b, 0x4b, 0xa3, 0x4f, 0x51, 0x47, 0x0e, 0xd9, 0xc9, 0x79,
-	0x90, 0xde, 0xa7, 0xa8, 0x93, 0xb4, 0xf9, 0xbd, 0x02, 0x9e, 0xdb, 0xa7, 0xde, 0xfd, 0xb0, 0x03,
-	0x19, 0xba, 0x07, 0x23, 0xe8, 0x53, 0x7e, 0xd7, 0xc0, 0x3e, 0xeb, 0x92, 0x08, 0xb3, 0x61, 0xa2,
-	0x76, 0xfd, 0xc7, 0x47, 0x9b, 0x2b, 0xc9, 0x8b, 0xba, 0xdd, 0xe9, 0x44, 0x88, 0xd2, 0xf7, 0x59,
-	0x84, 0x03, 0xcf, 0x99, 0x86, 0x6a, 0x6f, 0x81, 0x6c, 0x28, 0x2a, 0x08, 0x65, 0x17, 0xb7, 0xf4,
-	0xc5, 0x9f, 0x21, 0x4f, 0x68, 0x16, 0xf8, 0x18, 0xe5, 0xa8, 0x92, 0x94, 0x86, 0xf5, 0xe0, 0xe4,
-	0x68, 0x63, 0x5a, 0x8c, 0xe3, 0xbf, 0x86, 0x62, 0xfe, 0xce, 0x0f, 0xc4, 0x93, 0x7d, 0xae, 0x49,
-	0xb3, 0x0c, 0xd6, 0xce, 0x6d, 0x4d, 0x00, 0x6f, 0xfd, 0xa1, 0x80, 0xf4, 0x3e, 0xf5, 0xb4, 0x21,
-	0x00, 0x33, 0xaf, 0xb0, 0xb1, 0xd8, 0xcd, 0xdc, 0x7c, 0x2a, 0xaf, 0x5d, 0x11, 0x30, 0xa9, 0x6f,
-	0x5e, 0x7f, 0xf0, 0xd3, 0xef, 0x5f, 0xa6, 0xae, 0x99, 0x65, 0x5b, 0x36, 0x38, 0xf9, 0xa2, 0x48,
-	0x22, 0xdb, 0x6c, 0xa0, 0x7d, 0x08, 0x4a, 0x73, 0x48, 0xaf, 0xff, 0x6d, 0xed, 0xd9, 0x90, 0xca,
-	0xfa, 0x95, 0x21, 0x93, 0x06, 0x2a, 0x99, 0x4f, 0x39, 0xba, 0xe6, 0xdb, 0x8f, 0xc7, 0x55, 0xe5,
-	0xc9, 0xb8, 0xaa, 0xfc, 0x36, 0xae, 0x2a, 0x5f, 0x1c, 0x57, 0x97, 0x9e, 0x1c, 0x57, 0x97, 0x7e,
-	0x3e, 0xae, 0x2e, 0x7d, 0xf0, 0xaa, 0x87, 0x59, 0xb7, 0x7f, 0x60, 0xb9, 0xc4, 0x9f, 0xf6, 0x48,
-	0xa8, 0x1d, 0x6f, 0xbd, 0x9e, 0xe0, 0x64, 0xc3, 0x10, 0xd1, 0x83, 0xac, 0xf8, 0x06, 0x79, 0xe3,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x94, 0x7d, 0xc7, 0x93, 0x09, 0x00, 0x00,
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x1f, 0xcd, 0xda, 0xeb, 0x5f, 0x63, 0x7f, 0xbf, 0x84, 0x51, 0xd2, 0xac, 0x5d, 0xf0, 0xba, 0x0b,
+	0x05, 0x27, 0x52, 0x76, 0x5b, 0x23, 0x21, 0x35, 0x5c, 0x88, 0x9b, 0xb4, 0x2a, 0x4a, 0x44, 0xb5,
+	0x75, 0x2f, 0x08, 0xc9, 0x4c, 0xd6, 0x93, 0xf5, 0x0a, 0xef, 0xce, 0x6a, 0x67, 0xbc, 0xb2, 0x39,
+	0xa1, 0x9e, 0x10, 0x27, 0x24, 0xae, 0x1c, 0x38, 0x70, 0xa8, 0x38, 0xe5, 0x50, 0xf8, 0x1b, 0x2a,
+	0x4e, 0x15, 0x5c, 0x10, 0x07, 0x83, 0x12, 0x50, 0xa4, 0x1c, 0xf9, 0x0b, 0xd0, 0xcc, 0xac, 0x63,
+	0x3b, 0x26, 0x49, 0xa9, 0x04, 0x17, 0x6b, 0x3e, 0xfb, 0xf9, 0x31, 0xcf, 0xef, 0xbd, 0x9d, 0x59,
+	0x50, 0xc6, 0xac, 0x8b, 0x23, 0xdf, 0x0b, 0x98, 0x85, 0x63, 0xdf, 0x8a, 0x6f, 0x5a, 0x6c, 0x60,
+	0x86, 0x11, 0x61, 0x04, 0x2e, 0x9e, 0xa6, 0x4c, 0x1c, 0xfb, 0x66, 0x7c, 0xb3, 0xf2, 0x32, 0xf2,
+	0xbd, 0x80, 0x58, 0xe2, 0x57, 0x16, 0x55, 0x56, 0x1c, 0x42, 0x7d, 0x42, 0x2d, 0x9f, 0xba, 0xbc,
+	0xd9, 0xa7, 0x6e, 0x92, 0x28, 0xcb, 0x44, 0x5b, 0x44, 0x96, 0x0c, 0x92, 0x54, 0x65, 0x6e, 0x4f,
+	0x3e, 0x5f, 0xe6, 0x96, 0x5c, 0xe2, 0x12, 0xd9, 0xc3, 0x57, 0xc9, 0xd3, 0x57, 0x5c, 0x42, 0xdc,
+	0x1e, 0xb6, 0x50, 0xe8, 0x59, 0x28, 0x08, 0x08, 0x43, 0xcc, 0x23, 0xc1, 0x78, 0x5e, 0x39, 0xc9,
+	0x8a, 0x68, 0xaf, 0xbf, 0x6f, 0xa1, 0x60, 0x28, 0x53, 0xc6, 0x77, 0x0a, 0xf8, 0xdf, 0x2e, 0x75,
+	0xb7, 0xf9, 0x86, 0xb8, 0xef, 0xb7, 0x06, 0xb0, 0x0e, 0xd4, 0x0e, 0x62, 0x48, 0x53, 0x6a, 0x4a,
+	0xbd, 0xd8, 0x58, 0x32, 0x65, 0xaf, 0x39, 0xee, 0x35, 0x37, 0x83, 0xa1, 0x2d, 0x2a, 0x60, 0x15,
+	0xa8, 0xd4, 0xfb, 0x04, 0x6b, 0xa9, 0x9a, 0x52, 0x57, 0x9a, 0xe0, 0x64, 0xa4, 0x2b, 0xeb, 0x8f,
+	0x8f, 0x0f, 0xd6, 0x14, 0x5b, 0x3c, 0x87, 0xaf, 0x03, 0xb5, 0x8b, 0x68, 0x57, 0x4b, 0xd7, 0x94,
+	0x7a, 0xa1, 0xb9, 0xf8, 0xe7, 0x48, 0xcf, 0x45, 0xbd, 0x70, 0xc3, 0x58, 0x37, 0x92, 0x2a, 0x9e,
+	0x85, 0x10, 0xa8, 0xfb, 0x11, 0xf1, 0x35, 0x95, 0x57, 0xd9, 0x62, 0xbd, 0x51, 0xfb, 0xec, 0x6b,
+	0x7d, 0xe1, 0xf3, 0xe3, 0x83, 0xb5, 0x95, 0x09, 0x13, 0x33, 0x28, 0x8d, 0xc7, 0x29, 0x90, 0xdf,
+	0xc1, 0x2e, 0x72, 0x86, 0xad, 0x01, 0x5c, 0x02, 0x99, 0x80, 0x04, 0x0e, 0x16, 0x98, 0x55, 0x5b,
+	0x06, 0xf0, 0x6d, 0x50, 0x70, 0x11, 0xe7, 0xd7, 0x73, 0x24, 0xc6, 0x42, 0xb3, 0xfc, 0xcb, 0x48,
+	0x5f, 0x96, 0x54, 0xd3, 0xce, 0xc7, 0xa6, 0x47, 0x2c, 0x1f, 0xb1, 0xae, 0x79, 0x2f, 0x60, 0x76,
+	0xde, 0x45, 0xf4, 0x3e, 0x2f, 0x85, 0x55, 0x90, 0x76, 0x11, 0x15, 0xa8, 0xd5, 0x66, 0xe9, 0x70,
+	0xa4, 0xe7, 0xef, 0x22, 0xba, 0xe3, 0xf9, 0x1e, 0xb3, 0x79, 0x02, 0xfe, 0x1f, 0xa4, 0x18, 0x49,
+	0xe0, 0xa6, 0x18, 0x81, 0xb7, 0x40, 0x26, 0x46, 0xbd, 0x3e, 0xd6, 0x32, 0x62, 0x8f, 0xd7, 0xce,
+	0xdd, 0xe3, 0x70, 0xa4, 0x67, 0x37, 0x7d, 0xd2, 0x0f, 0x98, 0x2d, 0x3b, 0xf8, 0x7f, 0x17, 0x5c,
+	0x67, 0x6b, 0x4a, 0xbd, 0x94, 0xb0, 0x5a, 0x02, 0x4a, 0xac, 0xe5, 0xc4, 0x03, 0x25, 0xe6, 0x51,
+	0xa4, 0xe5, 0x65, 0x14, 0xf1, 0x88, 0x6a, 0x05, 0x19, 0xd1, 0x8d, 0xeb, 0x9c, 0xa5, 0x1f, 0x9e,
+	0xac, 0x67, 0x5b, 0x83, 0x2d, 0xc4, 0x10, 0xe7, 0x0b, 0x4e, 0xf8, 0x1a, 0xb3, 0x63, 0x8c, 0xd2,
+	0xa0, 0xb4, 0xe9, 0x38, 0x98, 0xd2, 0x1d, 0x8f, 0xb2, 0xd6, 0x00, 0xbe, 0x07, 0xf2, 0x4e, 0x17,
+	0x79, 0x41, 0xdb, 0xeb, 0x08, 0xc6, 0x0a, 0x4d, 0xeb, 0x22, 0xcc, 0xb9, 0xdb, 0xbc, 0xf8, 0xde,
+	0xd6, 0xc9, 0x48, 0xcf, 0x39, 0x72, 0x69, 0x27, 0x8b, 0xce, 0x84, 0xfa, 0xd4, 0xb9, 0xd4, 0xa7,
+	0xff, 0x31, 0xf5, 0xea, 0xc5, 0xd4, 0x67, 0xe6, 0xa9, 0xcf, 0xbe, 0x30, 0xf5, 0xb9, 0x29, 0xea,
+	0x3f, 0x02, 0x79, 0x24, 0x88, 0xc2, 0x54, 0xcb, 0xd7, 0xd2, 0xf5, 0x62, 0xe3, 0x55, 0xf3, 0xec,
+	0x3b, 0x6e, 0x4a, 0x2a, 0x5b, 0xfd, 0xb0, 0x87, 0x9b, 0xd7, 0x9f, 0x8e, 0xf4, 0x85, 0x93, 0x91,
+	0x0e, 0xd0, 0x29, 0xbf, 0xdf, 0xfe, 0xaa, 0x83, 0x09, 0xdb, 0xd2, 0xe8, 0xa7, 0x53, 0xa5, 0xb8,
+	0x85, 0x19, 0x71, 0xc1, 0x8c, 0xb8, 0xc5, 0xb1, 0xb8, 0xab, 0xf3, 0xe2, 0x5e, 0x99, 0x88, 0x3b,
+	0xad, 0xa7, 0xf1, 0x95, 0x0a, 0x4a, 0x5b, 0xc3, 0x00, 0xf9, 0x9e, 0x73, 0x07, 0xe3, 0xff, 0x44,
+	0xe0, 0x5b, 0xa0, 0xc8, 0x05, 0x66, 0x5e, 0xd8, 0x76, 0x50, 0x78, 0xb9, 0xc4, 0xdc, 0x0e, 0x2d,
+	0x2f, 0xbc, 0x8d, 0xc2, 0x71, 0xeb, 0x3e, 0xc6, 0xa2, 0x55, 0x7d, 0x9e, 0xd6, 0x3b, 0x18, 0xf3,
+	0xd6, 0xc4, 0x1e, 0x99, 0x8b, 0xed, 0x91, 0x9d, 0xb7, 0x47, 0xee, 0x85, 0xed, 0x91, 0x3f, 0xc7,
+	0x1e, 0x85, 0x7f, 0xcf, 0x1e, 0x60, 0xc6, 0x1e, 0xc5, 0x19, 0x7b, 0x94, 0x9e, 0xcf, 0x1e, 0xd3,
+	0x6e, 0x30, 0x7a, 0xa0, 0xb2, 0x3d, 0x60, 0x38, 0xa0, 0x1e, 0x09, 0xde, 0x0f, 0xc5, 0xbd, 0x30,
+	0x75, 0xdc, 0x5f, 0x01, 0xd9, 0xbd, 0x7e, 0xd0, 0xe9, 0xc9, 0xc3, 0x33, 0x6f, 0x27, 0x11, 0xbc,
+	0x01, 0x96, 0xb8, 0x44, 0x6e, 0x84, 0x02, 0xd6, 0xa6, 0x9e, 0x1b, 0x20, 0xd6, 0x8f, 0x30, 0xd5,
+	0x52, 0xb5, 0x74, 0xbd, 0x64, 0xc3, 0x7d, 0x8c, 0xef, 0xf2, 0xd4, 0x83, 0xd3, 0xcc, 0x86, 0xca,
+	0x21, 0x19, 0xdf, 0x28, 0x60, 0x79, 0xe6, 0xa8, 0xb6, 0x31, 0x0d, 0x49, 0x40, 0x05, 0xa5, 0xe2,
+	0x3a, 0x50, 0xe4, 0x41, 0xcf, 0xd7, 0x70, 0x15, 0xa8, 0x3d, 0xe2, 0xca, 0xa9, 0xc5, 0xc6, 0xf2,
+	0x3c, 0x9d, 0x3b, 0xc4, 0xb5, 0x45, 0x09, 0x5c, 0x04, 0xe9, 0x08, 0x33, 0x61, 0xb5, 0x92, 0xcd,
+	0x97, 0xb0, 0x0c, 0xf2, 0xb1, 0xdf, 0xc6, 0x51, 0x44, 0xa2, 0xe4, 0x38, 0xce, 0xc5, 0xfe, 0x36,
+	0x0f, 0x79, 0x8a, 0x9b, 0xac, 0x4f, 0x71, 0x47, 0xda, 0xc5, 0xce, 0xb9, 0x88, 0x3e, 0xa4, 0xb8,
+	0x93, 0xc0, 0xfc, 0x5e, 0x01, 0x2f, 0xed, 0x52, 0xf7, 0x61, 0xd8, 0x41, 0x0c, 0xdf, 0x47, 0x11,
+	0xf2, 0x29, 0x3f, 0xb5, 0x50, 0x9f, 0x75, 0x49, 0xe4, 0xb1, 0x61, 0xf2, 0xde, 0x68, 0x3f, 0x3e,
+	0x59, 0x5f, 0x4a, 0xee, 0xe6, 0xcd, 0x4e, 0x27, 0xc2, 0x94, 0x3e, 0x60, 0x91, 0x17, 0xb8, 0xf6,
+	0xa4, 0x14, 0xbe, 0x03, 0xb2, 0xa1, 0x98, 0x20, 0xde, 0x91, 0x62, 0x43, 0x9b, 0xff, 0x1b, 0x72,
+	0x87, 0x66, 0x81, 0x1b, 0x42, 0x8a, 0x9e, 0xb4, 0x6c, 0x98, 0x8f, 0x8e, 0x0f, 0xd6, 0x26, 0xc3,
+	0xb8, 0x90, 0x57, 0x71, 0xcc, 0xbf, 0x18, 0x06, 0xe2, 0xf2, 0x3f, 0x03, 0xd2, 0x28, 0x83, 0x95,
+	0x33, 0x8f, 0xc6, 0x04, 0x37, 0xfe, 0x50, 0x40, 0x7a, 0x97, 0xba, 0x70, 0x08, 0xc0, 0x94, 0xc0,
+	0xfa, 0x3c, 0x9a, 0x19, 0x7d, 0x2a, 0x6f, 0x5e, 0x52, 0x30, 0x9e, 0x6f, 0x5c, 0x7b, 0xf4, 0xd3,
+	0xef, 0x5f, 0xa6, 0xae, 0x1a, 0x65, 0x4b, 0x02, 0x1c, 0x7f, 0x9b, 0x24, 0x95, 0x6d, 0x36, 0x80,
+	0x1f, 0x82, 0xd2, 0x0c, 0xa5, 0xd7, 0xfe, 0x76, 0xf6, 0x74, 0x49, 0x65, 0xf5, 0xd2, 0x92, 0x31,
+	0x80, 0x4a, 0xe6, 0x53, 0x4e, 0x5d, 0xf3, 0xdd, 0xa7, 0x87, 0x55, 0xe5, 0xd9, 0x61, 0x55, 0xf9,
+	0xed, 0xb0, 0xaa, 0x7c, 0x71, 0x54, 0x5d, 0x78, 0x76, 0x54, 0x5d, 0xf8, 0xf9, 0xa8, 0xba, 0xf0,
+	0xc1, 0x1b, 0xae, 0xc7, 0xba, 0xfd, 0x3d, 0xd3, 0x21, 0xfe, 0x04, 0x23, 0xa1, 0x56, 0xdc, 0xb8,
+	0x91, 0xd0, 0xc9, 0x86, 0x21, 0xa6, 0x7b, 0x59, 0xf1, 0x35, 0xf3, 0xd6, 0x5f, 0x03, 0x00, 0xb8,
+	0xf8, 0xdb, 0x68, 0xdd, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -628,6 +639,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1060,6 +1072,25 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeGrantSignatures) > 0 {
+		for iNdEx := len(m.FeeGrantSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeeGrantSignatures[iNdEx])
+			copy(dAtA[i:], m.FeeGrantSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FeeGrantSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Bundle {
+		i--
+		if m.Bundle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1383,6 +1414,15 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Bundle {
+		n += 2
+	}
+	if len(m.FeeGrantSignatures) > 0 {
+		for _, b := range m.FeeGrantSignatures {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2796,6 +2836,58 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bundle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bundle = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrantSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrantSignatures = append(m.FeeGrantSignatures, make([]byte, postIndex-iNdEx))
+			copy(m.FeeGrantSignatures[len(m.FeeGrantSignatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
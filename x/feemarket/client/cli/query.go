@@ -3,10 +3,14 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -25,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetBlockFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -116,3 +121,47 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetBlockFeeHistoryCmd queries the base fee and block gas kept in the fee
+// history over a range of block heights
+func GetBlockFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-fee-history START_HEIGHT END_HEIGHT",
+		Short: "Get the base fee and block gas over a range of block heights",
+		Long: `Get the base fee, gas wanted, gas used and gas limit of the blocks between the start and end heights (inclusive).
+Only the blocks kept in the fee history are returned.`,
+		Example: fmt.Sprintf("%s query %s block-fee-history 100 200", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockFeeHistory(cmd.Context(), &types.QueryBlockFeeHistoryRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
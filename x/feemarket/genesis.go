@@ -24,13 +24,34 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	for _, block := range data.BlockGasWindow {
+		k.SetBlockGasWindow(ctx, block.Height, block.GasWanted, data.Params.AimdWindow)
+	}
+
+	if data.BaseFeeControllerState != nil {
+		k.SetBaseFeeControllerState(ctx, *data.BaseFeeControllerState)
+	}
+
+	for _, history := range data.FeeHistory {
+		k.SetBlockFeeHistory(ctx, history, data.Params.FeeHistorySize)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var controllerState *types.BaseFeeControllerState
+	// the state is only set while the target utilization algorithm is selected
+	if state := k.GetBaseFeeControllerState(ctx); !state.SmoothedUtilization.IsNil() {
+		controllerState = &state
+	}
+
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:                 k.GetParams(ctx),
+		BlockGas:               k.GetBlockGasWanted(ctx),
+		BlockGasWindow:         k.GetBlockGasWindow(ctx),
+		BaseFeeControllerState: controllerState,
+		FeeHistory:             k.GetAllBlockFeeHistory(ctx),
 	}
 }
@@ -16,7 +16,7 @@ import (
 
 // BeginBlock updates base fee
 func (k *Keeper) BeginBlock(ctx sdk.Context) error {
-	baseFee := k.CalculateBaseFee(ctx)
+	baseFee, controllerState := k.calculateBaseFee(ctx)
 
 	// return immediately if base fee is nil
 	if baseFee == nil {
@@ -25,6 +25,14 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 
 	k.SetBaseFee(ctx, baseFee)
 
+	// keep the controller state only while the target utilization algorithm is
+	// selected
+	if controllerState != nil {
+		k.SetBaseFeeControllerState(ctx, *controllerState)
+	} else {
+		k.DeleteBaseFeeControllerState(ctx)
+	}
+
 	defer func() {
 		telemetry.SetGauge(float32(baseFee.Int64()), "feemarket", "base_fee")
 	}()
@@ -68,11 +76,36 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
-	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
+	params := k.GetParams(ctx)
+	limitedGasWanted := math.LegacyNewDec(gasWanted.Int64()).Mul(params.MinGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// the AIMD base fee algorithm averages the gas wanted over a window of blocks
+	if params.BaseFeeAlgorithm == types.BASE_FEE_ALGORITHM_AIMD {
+		k.SetBlockGasWindow(ctx, ctx.BlockHeight(), updatedGasWanted, params.AimdWindow)
+	} else {
+		k.ClearBlockGasWindow(ctx)
+	}
+
+	baseFee := math.ZeroInt()
+	if bf := k.GetBaseFee(ctx); bf != nil {
+		baseFee = math.NewIntFromBigInt(bf)
+	}
+
+	var gasLimit uint64
+	if consParams := ctx.ConsensusParams(); consParams.Block != nil && consParams.Block.MaxGas > 0 {
+		gasLimit = uint64(consParams.Block.MaxGas) //nolint:gosec // G115 -- checked above
+	}
+
+	k.SetBlockFeeHistory(ctx, types.BlockFeeHistory{
+		Height:    ctx.BlockHeight(),
+		BaseFee:   baseFee,
+		GasWanted: updatedGasWanted,
+		GasUsed:   gasUsed.Uint64(),
+		GasLimit:  gasLimit,
+	}, params.FeeHistorySize)
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
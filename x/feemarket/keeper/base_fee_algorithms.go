@@ -0,0 +1,103 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// calculateAIMDBaseFee adjusts the base fee with the additive-increase /
+// multiplicative-decrease algorithm. The base fee increases by a fixed amount
+// while the average gas wanted over the window is above the gas target, and is
+// multiplied by a factor lower than one while it is below. Averaging over a
+// window keeps the base fee from oscillating on bursty load.
+func calculateAIMDBaseFee(params types.Params, parentBaseFee *big.Int, averageGasWanted sdkmath.LegacyDec, gasTarget uint64) *big.Int {
+	baseFee := sdkmath.NewIntFromBigInt(parentBaseFee)
+	target := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gasTarget))
+
+	switch {
+	case averageGasWanted.GT(target):
+		baseFee = baseFee.Add(params.AimdAdditiveIncrease)
+	case averageGasWanted.LT(target):
+		baseFee = sdkmath.LegacyNewDecFromInt(baseFee).Mul(params.AimdMultiplicativeDecrease).TruncateInt()
+	}
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	return sdkmath.MaxInt(baseFee, params.MinGasPrice.TruncateInt()).BigInt()
+}
+
+// calculateTargetUtilizationBaseFee adjusts the base fee with a PID controller
+// that steers the smoothed block utilization towards the gas target. The
+// utilization of a block is its gas wanted relative to the gas target, smoothed
+// with an exponential moving average. The relative change of the base fee is
+// bounded by 1 / BaseFeeChangeDenominator, and the error is not accumulated
+// while the change is bounded to keep the integral term from winding up.
+// It returns the base fee and the controller state for the next block.
+func calculateTargetUtilizationBaseFee(
+	params types.Params,
+	state types.BaseFeeControllerState,
+	parentBaseFee *big.Int,
+	parentGasUsed uint64,
+	gasTarget uint64,
+) (*big.Int, types.BaseFeeControllerState) {
+	one := sdkmath.LegacyOneDec()
+
+	utilization := one
+	if gasTarget > 0 {
+		utilization = sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(parentGasUsed)).
+			Quo(sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gasTarget)))
+	}
+
+	// the first block of the controller starts the moving average
+	smoothed := utilization
+	if !state.SmoothedUtilization.IsNil() {
+		smoothed = params.UtilizationSmoothing.Mul(utilization).
+			Add(one.Sub(params.UtilizationSmoothing).Mul(state.SmoothedUtilization))
+	}
+
+	integral := sdkmath.LegacyZeroDec()
+	if !state.Integral.IsNil() {
+		integral = state.Integral
+	}
+
+	derivative := sdkmath.LegacyZeroDec()
+	currentErr := smoothed.Sub(one)
+	if !state.PreviousError.IsNil() {
+		derivative = currentErr.Sub(state.PreviousError)
+	}
+
+	maxChange := one.QuoInt64(int64(params.BaseFeeChangeDenominator))
+	adjustment := params.ProportionalGain.Mul(currentErr).
+		Add(params.IntegralGain.Mul(integral.Add(currentErr))).
+		Add(params.DerivativeGain.Mul(derivative))
+
+	switch {
+	case adjustment.GT(maxChange):
+		adjustment = maxChange
+	case adjustment.LT(maxChange.Neg()):
+		adjustment = maxChange.Neg()
+	default:
+		integral = integral.Add(currentErr)
+	}
+
+	parentBaseFeeInt := sdkmath.NewIntFromBigInt(parentBaseFee)
+	baseFee := sdkmath.LegacyNewDecFromInt(parentBaseFeeInt).Mul(one.Add(adjustment)).TruncateInt()
+	// make sure the base fee increases when the utilization is above the target
+	if adjustment.IsPositive() && baseFee.LTE(parentBaseFeeInt) {
+		baseFee = parentBaseFeeInt.AddRaw(1)
+	}
+
+	// Set global min gas price as lower bound of the base fee, transactions below
+	// the min gas price don't even reach the mempool.
+	baseFee = sdkmath.MaxInt(baseFee, params.MinGasPrice.TruncateInt())
+
+	return baseFee.BigInt(), types.BaseFeeControllerState{
+		SmoothedUtilization: smoothed,
+		Integral:            integral,
+		PreviousError:       currentErr,
+	}
+}
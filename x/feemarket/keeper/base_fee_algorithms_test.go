@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBaseFeeAlgorithm returns a context with a block gas limit of 100 (gas
+// target of 50) and the given base fee algorithm selected.
+func setupBaseFeeAlgorithm(t *testing.T, algorithm types.BaseFeeAlgorithm) (*network.UnitTestNetwork, sdk.Context) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	params := nw.App.FeeMarketKeeper.GetParams(ctx)
+	params.BaseFeeAlgorithm = algorithm
+	params.BaseFee = math.NewInt(1000000000)
+	params.MinGasPrice = math.LegacyZeroDec()
+	require.NoError(t, nw.App.FeeMarketKeeper.SetParams(ctx, params))
+
+	consParams := tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 100, MaxBytes: 10}}
+	return nw, ctx.WithConsensusParams(consParams)
+}
+
+func TestCalculateAIMDBaseFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		gasWindow   []uint64
+		parentGas   uint64
+		minGasPrice math.LegacyDec
+		expFee      *big.Int
+	}{
+		{
+			"empty window - parent block above target",
+			nil,
+			100,
+			math.LegacyZeroDec(),
+			big.NewInt(1125000000),
+		},
+		{
+			"average above target - additive increase",
+			[]uint64{100, 0, 60},
+			0,
+			math.LegacyZeroDec(),
+			big.NewInt(1125000000),
+		},
+		{
+			"average on target - unchanged",
+			[]uint64{100, 0, 50},
+			100,
+			math.LegacyZeroDec(),
+			big.NewInt(1000000000),
+		},
+		{
+			"average below target - multiplicative decrease",
+			[]uint64{100, 0, 20},
+			100,
+			math.LegacyZeroDec(),
+			big.NewInt(875000000),
+		},
+		{
+			"average below target - bounded by min gas price",
+			[]uint64{0, 0, 0},
+			0,
+			math.LegacyNewDec(950000000),
+			big.NewInt(950000000),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw, ctx := setupBaseFeeAlgorithm(t, types.BASE_FEE_ALGORITHM_AIMD)
+			params := nw.App.FeeMarketKeeper.GetParams(ctx)
+			params.MinGasPrice = tc.minGasPrice
+			require.NoError(t, nw.App.FeeMarketKeeper.SetParams(ctx, params))
+
+			for i, gas := range tc.gasWindow {
+				nw.App.FeeMarketKeeper.SetBlockGasWindow(ctx, int64(i+1), gas, params.AimdWindow)
+			}
+			nw.App.FeeMarketKeeper.SetBlockGasWanted(ctx, tc.parentGas)
+
+			fee := nw.App.FeeMarketKeeper.CalculateBaseFee(ctx.WithBlockHeight(int64(len(tc.gasWindow) + 1)))
+			require.Equal(t, tc.expFee, fee)
+		})
+	}
+}
+
+func TestBlockGasWindowPruning(t *testing.T) {
+	nw, ctx := setupBaseFeeAlgorithm(t, types.BASE_FEE_ALGORITHM_AIMD)
+	k := nw.App.FeeMarketKeeper
+
+	// only the last 3 blocks are kept
+	for height := int64(1); height <= 5; height++ {
+		k.SetBlockGasWindow(ctx, height, uint64(height*10), 3)
+	}
+	require.Equal(t, math.LegacyNewDec(40), k.GetBlockGasWindowAverage(ctx, 0))
+
+	k.ClearBlockGasWindow(ctx)
+	require.Equal(t, math.LegacyNewDec(7), k.GetBlockGasWindowAverage(ctx, 7))
+}
+
+func TestCalculateTargetUtilizationBaseFee(t *testing.T) {
+	testCases := []struct {
+		name      string
+		state     types.BaseFeeControllerState
+		parentGas uint64
+		expFee    *big.Int
+		expState  types.BaseFeeControllerState
+	}{
+		{
+			"first block on target - unchanged",
+			types.BaseFeeControllerState{},
+			50,
+			big.NewInt(1000000000),
+			types.BaseFeeControllerState{
+				SmoothedUtilization: math.LegacyOneDec(),
+				Integral:            math.LegacyZeroDec(),
+				PreviousError:       math.LegacyZeroDec(),
+			},
+		},
+		{
+			"first block full - increase bounded by the change denominator",
+			types.BaseFeeControllerState{},
+			100,
+			big.NewInt(1125000000),
+			types.BaseFeeControllerState{
+				SmoothedUtilization: math.LegacyNewDec(2),
+				Integral:            math.LegacyZeroDec(),
+				PreviousError:       math.LegacyOneDec(),
+			},
+		},
+		{
+			"smoothed utilization above target - proportional and integral increase",
+			types.BaseFeeControllerState{
+				SmoothedUtilization: math.LegacyOneDec(),
+				Integral:            math.LegacyZeroDec(),
+				PreviousError:       math.LegacyZeroDec(),
+			},
+			75,
+			// smoothed = 0.2 * 1.5 + 0.8 * 1 = 1.1, error = 0.1
+			// adjustment = 0.125 * 0.1 + 0.0125 * 0.1 = 0.01375
+			big.NewInt(1013750000),
+			types.BaseFeeControllerState{
+				SmoothedUtilization: math.LegacyNewDecWithPrec(11, 1),
+				Integral:            math.LegacyNewDecWithPrec(1, 1),
+				PreviousError:       math.LegacyNewDecWithPrec(1, 1),
+			},
+		},
+		{
+			"empty block - decrease bounded by the change denominator",
+			types.BaseFeeControllerState{
+				SmoothedUtilization: math.LegacyZeroDec(),
+				Integral:            math.LegacyNewDec(-1),
+				PreviousError:       math.LegacyNewDec(-1),
+			},
+			0,
+			big.NewInt(875000000),
+			types.BaseFeeControllerState{
+				SmoothedUtilization: math.LegacyZeroDec(),
+				Integral:            math.LegacyNewDec(-1),
+				PreviousError:       math.LegacyNewDec(-1),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw, ctx := setupBaseFeeAlgorithm(t, types.BASE_FEE_ALGORITHM_TARGET_UTILIZATION)
+			ctx = ctx.WithBlockHeight(2)
+			k := nw.App.FeeMarketKeeper
+
+			if !tc.state.SmoothedUtilization.IsNil() {
+				k.SetBaseFeeControllerState(ctx, tc.state)
+			} else {
+				k.DeleteBaseFeeControllerState(ctx)
+			}
+			k.SetBlockGasWanted(ctx, tc.parentGas)
+
+			require.Equal(t, tc.expFee, k.CalculateBaseFee(ctx))
+
+			// the controller state is only updated in BeginBlock
+			require.NoError(t, k.BeginBlock(ctx))
+			require.Equal(t, tc.expFee, k.GetParams(ctx).BaseFee.BigInt())
+			require.Equal(t, tc.expState, k.GetBaseFeeControllerState(ctx))
+		})
+	}
+}
+
+func TestBaseFeeControllerStateCleared(t *testing.T) {
+	nw, ctx := setupBaseFeeAlgorithm(t, types.BASE_FEE_ALGORITHM_TARGET_UTILIZATION)
+	ctx = ctx.WithBlockHeight(2)
+	k := nw.App.FeeMarketKeeper
+
+	require.NoError(t, k.BeginBlock(ctx))
+	require.False(t, k.GetBaseFeeControllerState(ctx).SmoothedUtilization.IsNil())
+
+	params := k.GetParams(ctx)
+	params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_EIP1559
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, k.BeginBlock(ctx.WithBlockHeight(3)))
+	require.True(t, k.GetBaseFeeControllerState(ctx).SmoothedUtilization.IsNil())
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
@@ -13,14 +14,21 @@ import (
 
 // CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
 // block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
-// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
-// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+// The base fee is adjusted with the algorithm selected in the BaseFeeAlgorithm parameter.
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
+	baseFee, _ := k.calculateBaseFee(ctx)
+	return baseFee
+}
+
+// calculateBaseFee returns the base fee for the current block together with
+// the controller state that has to be stored for the next block. The state is
+// nil unless the target utilization algorithm is selected.
+func (k Keeper) calculateBaseFee(ctx sdk.Context) (*big.Int, *types.BaseFeeControllerState) {
 	params := k.GetParams(ctx)
 
 	// Ignore the calculation if not enabled
 	if !params.IsBaseFeeEnabled(ctx.BlockHeight()) {
-		return nil
+		return nil, nil
 	}
 
 	consParams := ctx.ConsensusParams()
@@ -29,7 +37,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
 	if ctx.BlockHeight() == params.EnableHeight {
-		return params.BaseFee.BigInt()
+		return params.BaseFee.BigInt(), nil
 	}
 
 	// get the block gas used and the base fee values for the parent block.
@@ -38,7 +46,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// persistent KVStore after EndBlock (ABCI Commit).
 	parentBaseFee := params.BaseFee.BigInt()
 	if parentBaseFee == nil {
-		return nil
+		return nil, nil
 	}
 
 	parentGasUsed := k.GetBlockGasWanted(ctx)
@@ -54,9 +62,27 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	// validation
 	parentGasTargetBig := new(big.Int).Div(gasLimit, new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
 	if !parentGasTargetBig.IsUint64() {
-		return nil
+		return nil, nil
+	}
+
+	switch params.BaseFeeAlgorithm {
+	case types.BASE_FEE_ALGORITHM_AIMD:
+		averageGasWanted := k.GetBlockGasWindowAverage(ctx, parentGasUsed)
+		return calculateAIMDBaseFee(params, parentBaseFee, averageGasWanted, parentGasTargetBig.Uint64()), nil
+	case types.BASE_FEE_ALGORITHM_TARGET_UTILIZATION:
+		baseFee, state := calculateTargetUtilizationBaseFee(
+			params, k.GetBaseFeeControllerState(ctx), parentBaseFee, parentGasUsed, parentGasTargetBig.Uint64(),
+		)
+		return baseFee, &state
+	default:
+		return calculateEIP1559BaseFee(params, parentBaseFee, parentGasUsed, parentGasTargetBig), nil
 	}
+}
 
+// calculateEIP1559BaseFee adjusts the base fee with the EIP-1559 formula.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
+// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+func calculateEIP1559BaseFee(params types.Params, parentBaseFee *big.Int, parentGasUsed uint64, parentGasTargetBig *big.Int) *big.Int {
 	parentGasTarget := parentGasTargetBig.Uint64()
 	baseFeeChangeDenominator := new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator))
 
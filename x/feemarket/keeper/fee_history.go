@@ -0,0 +1,93 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// SetBlockFeeHistory adds the base fee and block gas of a block to the fee
+// history, and prunes the blocks that are older than the fee history size.
+// If the size is zero, the whole fee history is deleted.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockFeeHistory(ctx sdk.Context, history types.BlockFeeHistory, size uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeHistory)
+	if size == 0 {
+		deleteBelow(store, nil)
+		return
+	}
+
+	store.Set(blockFeeHistoryKey(history.Height), k.cdc.MustMarshal(&history))
+
+	oldestHeight := history.Height - int64(size) + 1
+	if oldestHeight <= 0 {
+		return
+	}
+
+	deleteBelow(store, blockFeeHistoryKey(oldestHeight))
+}
+
+// GetBlockFeeHistory returns the fee history of the block at the given height
+// and whether it is kept in the store.
+func (k Keeper) GetBlockFeeHistory(ctx sdk.Context, height int64) (types.BlockFeeHistory, bool) {
+	var history types.BlockFeeHistory
+	if height <= 0 {
+		return history, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeHistory)
+	bz := store.Get(blockFeeHistoryKey(height))
+	if len(bz) == 0 {
+		return history, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+// GetBlockFeeHistoryRange returns the fee history of the blocks between the
+// start and end heights (inclusive) that are kept in the store, ordered by
+// ascending height.
+func (k Keeper) GetBlockFeeHistoryRange(ctx sdk.Context, startHeight, endHeight int64) []types.BlockFeeHistory {
+	if startHeight <= 0 || endHeight < startHeight {
+		return nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeHistory)
+	iterator := store.Iterator(blockFeeHistoryKey(startHeight), blockFeeHistoryKey(endHeight+1))
+	defer iterator.Close()
+
+	var history []types.BlockFeeHistory
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BlockFeeHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		history = append(history, entry)
+	}
+
+	return history
+}
+
+// GetAllBlockFeeHistory returns the fee history of all the blocks kept in the
+// store, ordered by ascending height.
+func (k Keeper) GetAllBlockFeeHistory(ctx sdk.Context) []types.BlockFeeHistory {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockFeeHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var history []types.BlockFeeHistory
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BlockFeeHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		history = append(history, entry)
+	}
+
+	return history
+}
+
+// blockFeeHistoryKey returns the fee history key of a block height.
+func blockFeeHistoryKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height)) //nolint:gosec // G115 -- block height is positive
+}
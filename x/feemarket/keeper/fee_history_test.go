@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetBlockFeeHistory(t *testing.T) {
+	testCases := []struct {
+		name       string
+		size       uint32
+		heights    []int64
+		expHeights []int64
+	}{
+		{
+			"pass - blocks within the history size",
+			5,
+			[]int64{101, 102, 103},
+			[]int64{101, 102, 103},
+		},
+		{
+			"pass - older blocks are pruned",
+			3,
+			[]int64{101, 102, 103, 104, 105},
+			[]int64{103, 104, 105},
+		},
+		{
+			"pass - history disabled",
+			0,
+			[]int64{101, 102, 103},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			k := nw.App.FeeMarketKeeper
+
+			for _, height := range tc.heights {
+				k.SetBlockFeeHistory(ctx, types.BlockFeeHistory{
+					Height:  height,
+					BaseFee: sdkmath.NewInt(height),
+				}, tc.size)
+			}
+
+			history := k.GetBlockFeeHistoryRange(ctx, 1, 1000)
+			var heights []int64
+			for _, entry := range history {
+				require.Equal(t, sdkmath.NewInt(entry.Height), entry.BaseFee)
+				heights = append(heights, entry.Height)
+			}
+			require.Equal(t, tc.expHeights, heights)
+
+			for _, height := range tc.expHeights {
+				_, found := k.GetBlockFeeHistory(ctx, height)
+				require.True(t, found)
+			}
+		})
+	}
+}
+
+func TestGetBlockFeeHistoryRange(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+
+	for height := int64(101); height <= 110; height++ {
+		k.SetBlockFeeHistory(ctx, types.BlockFeeHistory{Height: height, BaseFee: sdkmath.OneInt()}, 10)
+	}
+
+	history := k.GetBlockFeeHistoryRange(ctx, 103, 105)
+	require.Len(t, history, 3)
+	require.Equal(t, int64(103), history[0].Height)
+	require.Equal(t, int64(105), history[2].Height)
+
+	require.Len(t, k.GetBlockFeeHistoryRange(ctx, 109, 120), 2)
+	require.Empty(t, k.GetBlockFeeHistoryRange(ctx, 105, 103))
+	require.Empty(t, k.GetBlockFeeHistoryRange(ctx, 0, 0))
+}
+
+func TestEndBlockFeeHistory(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+
+	meter := storetypes.NewGasMeter(uint64(1000000000))
+	meter.ConsumeGas(1000, "test")
+	ctx = ctx.WithBlockGasMeter(meter)
+	k.SetTransientBlockGasWanted(ctx, 5000000)
+
+	require.NoError(t, k.EndBlock(ctx))
+
+	history, found := k.GetBlockFeeHistory(ctx, ctx.BlockHeight())
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight(), history.Height)
+	require.Equal(t, sdkmath.NewIntFromBigInt(k.GetBaseFee(ctx)), history.BaseFee)
+	require.Equal(t, k.GetBlockGasWanted(ctx), history.GasWanted)
+	require.Equal(t, uint64(1000), history.GasUsed)
+}
+
+func TestGenesisBaseFeeAlgorithmState(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+
+	genesis := *feemarket.ExportGenesis(ctx, k)
+	genesis.BlockGasWindow = []types.BlockGasWanted{{Height: 101, GasWanted: 1000}, {Height: 102, GasWanted: 2000}}
+	genesis.BaseFeeControllerState = &types.BaseFeeControllerState{
+		SmoothedUtilization: sdkmath.LegacyNewDecWithPrec(5, 1),
+		Integral:            sdkmath.LegacyNewDecWithPrec(1, 2),
+		PreviousError:       sdkmath.LegacyNewDecWithPrec(-2, 2),
+	}
+	genesis.FeeHistory = []types.BlockFeeHistory{
+		{Height: 101, BaseFee: sdkmath.NewInt(1000), GasWanted: 1000, GasUsed: 900, GasLimit: 10000},
+		{Height: 102, BaseFee: sdkmath.NewInt(1100), GasWanted: 2000, GasUsed: 1800, GasLimit: 10000},
+	}
+	require.NoError(t, genesis.Validate())
+
+	feemarket.InitGenesis(ctx, k, genesis)
+
+	exported := feemarket.ExportGenesis(ctx, k)
+	require.Equal(t, genesis.BlockGasWindow, exported.BlockGasWindow)
+	require.Equal(t, genesis.BaseFeeControllerState, exported.BaseFeeControllerState)
+	require.Equal(t, genesis.FeeHistory, exported.FeeHistory)
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -52,3 +54,28 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// BlockFeeHistory implements the Query/BlockFeeHistory gRPC method
+func (k Keeper) BlockFeeHistory(c context.Context, req *types.QueryBlockFeeHistoryRequest) (*types.QueryBlockFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartHeight <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "start height must be positive: %d", req.StartHeight)
+	}
+
+	if req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is lower than start height %d", req.EndHeight, req.StartHeight)
+	}
+
+	if req.EndHeight-req.StartHeight >= types.MaxFeeHistorySize {
+		return nil, status.Errorf(codes.InvalidArgument, "height range cannot be larger than %d blocks", types.MaxFeeHistorySize)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlockFeeHistoryResponse{
+		History: k.GetBlockFeeHistoryRange(ctx, req.StartHeight, req.EndHeight),
+	}, nil
+}
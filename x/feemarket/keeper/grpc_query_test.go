@@ -135,3 +135,71 @@ func TestQueryBlockGas(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBlockFeeHistory(t *testing.T) {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+	testCases := []struct {
+		name       string
+		req        *types.QueryBlockFeeHistoryRequest
+		expHeights []int64
+		expPass    bool
+	}{
+		{
+			"fail - start height is zero",
+			&types.QueryBlockFeeHistoryRequest{StartHeight: 0, EndHeight: 10},
+			nil,
+			false,
+		},
+		{
+			"fail - end height lower than start height",
+			&types.QueryBlockFeeHistoryRequest{StartHeight: 10, EndHeight: 5},
+			nil,
+			false,
+		},
+		{
+			"fail - height range too large",
+			&types.QueryBlockFeeHistoryRequest{StartHeight: 1, EndHeight: types.MaxFeeHistorySize + 1},
+			nil,
+			false,
+		},
+		{
+			"pass - blocks in range",
+			&types.QueryBlockFeeHistoryRequest{StartHeight: 102, EndHeight: 103},
+			[]int64{102, 103},
+			true,
+		},
+		{
+			"pass - range partially kept in the history",
+			&types.QueryBlockFeeHistoryRequest{StartHeight: 103, EndHeight: 200},
+			[]int64{103, 104},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// reset network and context
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			qc := nw.GetFeeMarketClient()
+
+			for height := int64(101); height <= 104; height++ {
+				nw.App.FeeMarketKeeper.SetBlockFeeHistory(ctx, types.BlockFeeHistory{Height: height, BaseFee: sdkmath.OneInt()}, 10)
+			}
+
+			res, err := qc.BlockFeeHistory(ctx.Context(), tc.req)
+			if tc.expPass {
+				require.NoError(t, err)
+				var heights []int64
+				for _, history := range res.History {
+					heights = append(heights, history.Height)
+				}
+				require.Equal(t, tc.expHeights, heights)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
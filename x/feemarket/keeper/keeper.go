@@ -6,6 +6,8 @@ import (
 	"math/big"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -77,6 +79,115 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// SetBlockGasWindow adds the block gas wanted of the given height to the
+// window of blocks used by the AIMD base fee algorithm, and prunes the blocks
+// that are out of the window.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockGasWindow(ctx sdk.Context, height int64, gas uint64, window uint32) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWindow)
+	store.Set(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(gas)) //nolint:gosec // G115 -- block height is positive
+
+	oldestHeight := height - int64(window) + 1
+	if oldestHeight <= 0 {
+		return
+	}
+
+	deleteBelow(store, sdk.Uint64ToBigEndian(uint64(oldestHeight))) //nolint:gosec // G115 -- checked above
+}
+
+// ClearBlockGasWindow deletes all the blocks of the AIMD window.
+func (k Keeper) ClearBlockGasWindow(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWindow)
+	deleteBelow(store, nil)
+}
+
+// GetBlockGasWindow returns the gas wanted of the blocks in the AIMD window,
+// ordered by ascending height.
+func (k Keeper) GetBlockGasWindow(ctx sdk.Context) []types.BlockGasWanted {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWindow)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var window []types.BlockGasWanted
+	for ; iterator.Valid(); iterator.Next() {
+		window = append(window, types.BlockGasWanted{
+			Height:    int64(sdk.BigEndianToUint64(iterator.Key())), //nolint:gosec // G115 -- block height is positive
+			GasWanted: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return window
+}
+
+// deleteBelow deletes the entries of the store below the end key. A nil end
+// key deletes all the entries.
+func deleteBelow(store prefix.Store, end []byte) {
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBlockGasWindowAverage returns the average gas wanted of the blocks in the
+// AIMD window. If the window is empty it returns the given parent block gas
+// wanted.
+func (k Keeper) GetBlockGasWindowAverage(ctx sdk.Context, parentGasWanted uint64) math.LegacyDec {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockGasWindow)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	total := math.ZeroInt()
+	count := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		total = total.Add(math.NewIntFromUint64(sdk.BigEndianToUint64(iterator.Value())))
+		count++
+	}
+
+	if count == 0 {
+		return math.LegacyNewDecFromInt(math.NewIntFromUint64(parentGasWanted))
+	}
+
+	return math.LegacyNewDecFromInt(total).QuoInt64(count)
+}
+
+// GetBaseFeeControllerState returns the state of the target utilization base
+// fee algorithm. The fields are nil if the algorithm hasn't run yet.
+func (k Keeper) GetBaseFeeControllerState(ctx sdk.Context) types.BaseFeeControllerState {
+	var state types.BaseFeeControllerState
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBaseFeeControllerState)
+	if len(bz) == 0 {
+		return state
+	}
+
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// SetBaseFeeControllerState sets the state of the target utilization base fee
+// algorithm.
+// CONTRACT: this should be only called during BeginBlock.
+func (k Keeper) SetBaseFeeControllerState(ctx sdk.Context, state types.BaseFeeControllerState) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBaseFeeControllerState, k.cdc.MustMarshal(&state))
+}
+
+// DeleteBaseFeeControllerState deletes the state of the target utilization base
+// fee algorithm, so that the controller starts over when selected again.
+func (k Keeper) DeleteBaseFeeControllerState(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.KeyPrefixBaseFeeControllerState) {
+		store.Delete(types.KeyPrefixBaseFeeControllerState)
+	}
+}
+
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
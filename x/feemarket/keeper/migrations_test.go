@@ -37,6 +37,10 @@ func TestMigrations(t *testing.T) {
 			"Run Migrate3to4",
 			migrator.Migrate3to4,
 		},
+		{
+			"Run Migrate4to5",
+			migrator.Migrate4to5,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,60 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version
+// 4 to version 5. Specifically, it keeps the EIP-1559 base fee algorithm and
+// sets the default parameters of the AIMD and target utilization algorithms
+// and of the fee history and fee splits.
+//
+// NOTE: the default fee splits keep the current fee distribution: the fees of
+// Ethereum transactions are distributed to the stakers and the fees of Cosmos
+// transactions are burned. Any other split requires a governance proposal.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var (
+		store  = ctx.KVStore(storeKey)
+		params types.Params
+	)
+
+	bz := store.Get(types.ParamsKey)
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.BaseFeeAlgorithm = types.BASE_FEE_ALGORITHM_EIP1559
+	params.AimdWindow = types.DefaultAIMDWindow
+	params.AimdAdditiveIncrease = types.DefaultAIMDAdditiveIncrease
+	params.AimdMultiplicativeDecrease = types.DefaultAIMDMultiplicativeDecrease
+	params.UtilizationSmoothing = types.DefaultUtilizationSmoothing
+	params.ProportionalGain = types.DefaultProportionalGain
+	params.IntegralGain = types.DefaultIntegralGain
+	params.DerivativeGain = types.DefaultDerivativeGain
+	params.FeeHistorySize = types.DefaultFeeHistorySize
+	params.BaseFeeSplit = types.DefaultBaseFeeSplit
+	params.PriorityFeeSplit = types.DefaultPriorityFeeSplit
+	params.CosmosFeeSplit = types.DefaultCosmosFeeSplit
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/evmos/evmos/v20/encoding"
+	v5 "github.com/evmos/evmos/v20/x/feemarket/migrations/v5"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored before the base fee algorithms were added
+	legacyParams := types.Params{
+		NoBaseFee:                false,
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		BaseFee:                  math.NewInt(1000000000),
+		EnableHeight:             0,
+		MinGasPrice:              math.LegacyNewDec(10),
+		MinGasMultiplier:         math.LegacyNewDecWithPrec(5, 1),
+	}
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&legacyParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &params)
+
+	expParams := types.DefaultParams()
+	expParams.BaseFeeChangeDenominator = legacyParams.BaseFeeChangeDenominator
+	expParams.ElasticityMultiplier = legacyParams.ElasticityMultiplier
+	expParams.BaseFee = legacyParams.BaseFee
+	expParams.MinGasPrice = legacyParams.MinGasPrice
+	expParams.MinGasMultiplier = legacyParams.MinGasMultiplier
+	require.Equal(t, expParams, params)
+
+	// the fees of Cosmos transactions are still burned, while the fees of
+	// Ethereum transactions are still kept by the fee collector
+	require.Equal(t, math.LegacyOneDec(), params.CosmosFeeSplit.Burn)
+	require.True(t, params.CosmosFeeSplit.Proposer.IsZero())
+	require.True(t, params.CosmosFeeSplit.CommunityPool.IsZero())
+	require.Equal(t, types.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), params.BaseFeeSplit)
+	require.Equal(t, types.NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), params.PriorityFeeSplit)
+}
@@ -5,6 +5,12 @@ package types
 // feemarket module events
 const (
 	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeSplit  = "fee_split"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee        = "base_fee"
+	AttributeKeyPriorityFee    = "priority_fee"
+	AttributeKeyBurned         = "burned"
+	AttributeKeyProposer       = "proposer"
+	AttributeKeyProposerReward = "proposer_reward"
+	AttributeKeyCommunityPool  = "community_pool"
 )
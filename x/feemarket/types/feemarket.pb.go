@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +26,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeAlgorithm defines the algorithm used to adjust the base fee between
+// blocks
+type BaseFeeAlgorithm int32
+
+const (
+	// BASE_FEE_ALGORITHM_EIP1559 adjusts the base fee with the EIP-1559 formula
+	// based on the gas wanted by the parent block
+	BASE_FEE_ALGORITHM_EIP1559 BaseFeeAlgorithm = 0
+	// BASE_FEE_ALGORITHM_AIMD increases the base fee additively while the average
+	// gas wanted over a window of blocks is above the gas target, and decreases
+	// it multiplicatively while it is below
+	BASE_FEE_ALGORITHM_AIMD BaseFeeAlgorithm = 1
+	// BASE_FEE_ALGORITHM_TARGET_UTILIZATION steers the base fee towards the gas
+	// target with a PID controller on the smoothed block utilization
+	BASE_FEE_ALGORITHM_TARGET_UTILIZATION BaseFeeAlgorithm = 2
+)
+
+var BaseFeeAlgorithm_name = map[int32]string{
+	0: "BASE_FEE_ALGORITHM_EIP1559",
+	1: "BASE_FEE_ALGORITHM_AIMD",
+	2: "BASE_FEE_ALGORITHM_TARGET_UTILIZATION",
+}
+
+var BaseFeeAlgorithm_value = map[string]int32{
+	"BASE_FEE_ALGORITHM_EIP1559":            0,
+	"BASE_FEE_ALGORITHM_AIMD":               1,
+	"BASE_FEE_ALGORITHM_TARGET_UTILIZATION": 2,
+}
+
+func (x BaseFeeAlgorithm) String() string {
+	return proto.EnumName(BaseFeeAlgorithm_name, int32(x))
+}
+
+func (BaseFeeAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the feemarket module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -44,6 +82,47 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_algorithm defines the algorithm used to adjust the base fee
+	// between blocks
+	BaseFeeAlgorithm BaseFeeAlgorithm `protobuf:"varint,9,opt,name=base_fee_algorithm,json=baseFeeAlgorithm,proto3,enum=ethermint.feemarket.v1.BaseFeeAlgorithm" json:"base_fee_algorithm,omitempty"`
+	// aimd_window defines the number of blocks over which the average block gas
+	// wanted is computed by the AIMD algorithm
+	AimdWindow uint32 `protobuf:"varint,10,opt,name=aimd_window,json=aimdWindow,proto3" json:"aimd_window,omitempty"`
+	// aimd_additive_increase defines the amount the base fee increases by the
+	// AIMD algorithm when the window is above the gas target
+	AimdAdditiveIncrease cosmossdk_io_math.Int `protobuf:"bytes,11,opt,name=aimd_additive_increase,json=aimdAdditiveIncrease,proto3,customtype=cosmossdk.io/math.Int" json:"aimd_additive_increase"`
+	// aimd_multiplicative_decrease defines the factor the base fee is multiplied
+	// with by the AIMD algorithm when the window is below the gas target
+	AimdMultiplicativeDecrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=aimd_multiplicative_decrease,json=aimdMultiplicativeDecrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"aimd_multiplicative_decrease"`
+	// utilization_smoothing defines the weight of the last block in the
+	// exponential moving average of the block utilization used by the target
+	// utilization algorithm
+	UtilizationSmoothing cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=utilization_smoothing,json=utilizationSmoothing,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilization_smoothing"`
+	// proportional_gain defines the proportional gain of the target utilization
+	// controller
+	ProportionalGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=proportional_gain,json=proportionalGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proportional_gain"`
+	// integral_gain defines the integral gain of the target utilization
+	// controller
+	IntegralGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=integral_gain,json=integralGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral_gain"`
+	// derivative_gain defines the derivative gain of the target utilization
+	// controller
+	DerivativeGain cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=derivative_gain,json=derivativeGain,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"derivative_gain"`
+	// fee_history_size defines the number of blocks for which the base fee and
+	// block gas are kept in the store. Zero disables the fee history.
+	FeeHistorySize uint32 `protobuf:"varint,17,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
+	// base_fee_split defines how the base fee paid by the Ethereum transactions
+	// is split between burning, the block proposer and the community pool
+	BaseFeeSplit FeeSplit `protobuf:"bytes,18,opt,name=base_fee_split,json=baseFeeSplit,proto3" json:"base_fee_split"`
+	// priority_fee_split defines how the priority fee paid by the Ethereum
+	// transactions is split between burning, the block proposer and the
+	// community pool
+	PriorityFeeSplit FeeSplit `protobuf:"bytes,19,opt,name=priority_fee_split,json=priorityFeeSplit,proto3" json:"priority_fee_split"`
+	// message_fee_overrides defines the minimum fee overrides of Cosmos
+	// transactions containing the given message types
+	MessageFeeOverrides []MessageFeeOverride `protobuf:"bytes,20,rep,name=message_fee_overrides,json=messageFeeOverrides,proto3" json:"message_fee_overrides"`
+	// cosmos_fee_split defines how the fees paid by the Cosmos transactions are
+	// split between burning, the block proposer and the community pool
+	CosmosFeeSplit FeeSplit `protobuf:"bytes,21,opt,name=cosmos_fee_split,json=cosmosFeeSplit,proto3" json:"cosmos_fee_split"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,8 +186,345 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeAlgorithm() BaseFeeAlgorithm {
+	if m != nil {
+		return m.BaseFeeAlgorithm
+	}
+	return BASE_FEE_ALGORITHM_EIP1559
+}
+
+func (m *Params) GetAimdWindow() uint32 {
+	if m != nil {
+		return m.AimdWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFeeHistorySize() uint32 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeSplit() FeeSplit {
+	if m != nil {
+		return m.BaseFeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetPriorityFeeSplit() FeeSplit {
+	if m != nil {
+		return m.PriorityFeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetMessageFeeOverrides() []MessageFeeOverride {
+	if m != nil {
+		return m.MessageFeeOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetCosmosFeeSplit() FeeSplit {
+	if m != nil {
+		return m.CosmosFeeSplit
+	}
+	return FeeSplit{}
+}
+
+// MessageFeeOverride defines the minimum fee of the Cosmos transactions that
+// contain a message type. The overrides can only raise the minimum fee above
+// the one defined by the min_gas_price parameter.
+type MessageFeeOverride struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /ibc.applications.transfer.v1.MsgTransfer
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// min_gas_price defines the minimum gas price of the transactions containing
+	// the message. The highest of this value and the min_gas_price parameter is
+	// used.
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+	// fixed_fee defines a fee added to the minimum fee for each occurrence of
+	// the message in the transaction
+	FixedFee cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=fixed_fee,json=fixedFee,proto3,customtype=cosmossdk.io/math.Int" json:"fixed_fee"`
+	// gas_multiplier multiplies the gas limit used to compute the minimum fee of
+	// the transactions containing the message. Zero disables the multiplier.
+	GasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=gas_multiplier,json=gasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_multiplier"`
+}
+
+func (m *MessageFeeOverride) Reset()         { *m = MessageFeeOverride{} }
+func (m *MessageFeeOverride) String() string { return proto.CompactTextString(m) }
+func (*MessageFeeOverride) ProtoMessage()    {}
+func (*MessageFeeOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *MessageFeeOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageFeeOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageFeeOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageFeeOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageFeeOverride.Merge(m, src)
+}
+func (m *MessageFeeOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageFeeOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageFeeOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageFeeOverride proto.InternalMessageInfo
+
+func (m *MessageFeeOverride) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// FeeSplit defines the fractions of a transaction fee that are burned, paid to
+// the block proposer and sent to the community pool. The remaining fraction is
+// kept by the fee collector and distributed to the stakers.
+type FeeSplit struct {
+	// burn is the fraction of the fee that is burned
+	Burn cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=burn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn"`
+	// proposer is the fraction of the fee that is paid to the block proposer
+	Proposer cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=proposer,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proposer"`
+	// community_pool is the fraction of the fee that is sent to the community
+	// pool
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+// BaseFeeControllerState defines the state kept between blocks by the target
+// utilization algorithm
+type BaseFeeControllerState struct {
+	// smoothed_utilization is the exponential moving average of the block
+	// utilization
+	SmoothedUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=smoothed_utilization,json=smoothedUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"smoothed_utilization"`
+	// integral is the accumulated error of the controller
+	Integral cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=integral,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral"`
+	// previous_error is the error of the controller in the previous block
+	PreviousError cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=previous_error,json=previousError,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"previous_error"`
+}
+
+func (m *BaseFeeControllerState) Reset()         { *m = BaseFeeControllerState{} }
+func (m *BaseFeeControllerState) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeControllerState) ProtoMessage()    {}
+func (*BaseFeeControllerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{3}
+}
+func (m *BaseFeeControllerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeControllerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeControllerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeControllerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeControllerState.Merge(m, src)
+}
+func (m *BaseFeeControllerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeControllerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeControllerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeControllerState proto.InternalMessageInfo
+
+// BlockGasWanted defines the gas wanted of a block in the window averaged by
+// the AIMD algorithm
+type BlockGasWanted struct {
+	// height is the block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_wanted is the block gas wanted
+	GasWanted uint64 `protobuf:"varint,2,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+}
+
+func (m *BlockGasWanted) Reset()         { *m = BlockGasWanted{} }
+func (m *BlockGasWanted) String() string { return proto.CompactTextString(m) }
+func (*BlockGasWanted) ProtoMessage()    {}
+func (*BlockGasWanted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{4}
+}
+func (m *BlockGasWanted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasWanted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasWanted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasWanted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasWanted.Merge(m, src)
+}
+func (m *BlockGasWanted) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasWanted) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasWanted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasWanted proto.InternalMessageInfo
+
+func (m *BlockGasWanted) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockGasWanted) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+// BlockFeeHistory defines the base fee and gas of a block kept in the fee
+// history
+type BlockFeeHistory struct {
+	// height is the block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the base fee of the block
+	BaseFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee"`
+	// gas_wanted is the block gas wanted used by the base fee algorithms
+	GasWanted uint64 `protobuf:"varint,3,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_used is the gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_limit is the block gas limit, zero if the block gas is unlimited
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BlockFeeHistory) Reset()         { *m = BlockFeeHistory{} }
+func (m *BlockFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeHistory) ProtoMessage()    {}
+func (*BlockFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{5}
+}
+func (m *BlockFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeHistory.Merge(m, src)
+}
+func (m *BlockFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeHistory proto.InternalMessageInfo
+
+func (m *BlockFeeHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeAlgorithm", BaseFeeAlgorithm_name, BaseFeeAlgorithm_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*MessageFeeOverride)(nil), "ethermint.feemarket.v1.MessageFeeOverride")
+	proto.RegisterType((*FeeSplit)(nil), "ethermint.feemarket.v1.FeeSplit")
+	proto.RegisterType((*BaseFeeControllerState)(nil), "ethermint.feemarket.v1.BaseFeeControllerState")
+	proto.RegisterType((*BlockGasWanted)(nil), "ethermint.feemarket.v1.BlockGasWanted")
+	proto.RegisterType((*BlockFeeHistory)(nil), "ethermint.feemarket.v1.BlockFeeHistory")
 }
 
 func init() {
@@ -116,35 +532,81 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4b, 0xdc, 0x40,
-	0x14, 0xc7, 0x77, 0xba, 0xba, 0xae, 0x63, 0x17, 0xb6, 0x83, 0x96, 0xa0, 0x34, 0x2e, 0x16, 0x4a,
-	0x90, 0x92, 0xd4, 0x7a, 0x2b, 0x78, 0xd9, 0x8a, 0xfd, 0x81, 0x05, 0xc9, 0xa1, 0x87, 0x5e, 0x86,
-	0x49, 0x7c, 0x26, 0x0f, 0x33, 0x33, 0x4b, 0x66, 0x0c, 0xdd, 0x7f, 0xa1, 0xa7, 0xfe, 0x19, 0x3d,
-	0xfa, 0x67, 0x78, 0xf4, 0x58, 0x0a, 0x95, 0xb2, 0x7b, 0xf0, 0xdf, 0x28, 0x66, 0x34, 0xb1, 0xf4,
-	0xe4, 0xe5, 0xf1, 0xf2, 0xbe, 0xdf, 0xf7, 0xc9, 0x4b, 0xde, 0xa3, 0x2f, 0xc0, 0xe6, 0x50, 0x4a,
-	0x54, 0x36, 0x3a, 0x01, 0x90, 0xa2, 0x3c, 0x05, 0x1b, 0x55, 0x3b, 0xed, 0x43, 0x38, 0x29, 0xb5,
-	0xd5, 0xec, 0x69, 0xe3, 0x0b, 0x5b, 0xa9, 0xda, 0x59, 0x7f, 0x22, 0x24, 0x2a, 0x1d, 0xd5, 0xd1,
-	0x59, 0xd7, 0x57, 0x33, 0x9d, 0xe9, 0x3a, 0x8d, 0x6e, 0x32, 0x57, 0xdd, 0xfa, 0xdd, 0xa5, 0xbd,
-	0x23, 0x51, 0x0a, 0x69, 0x98, 0x4f, 0x57, 0x94, 0xe6, 0x89, 0x30, 0xc0, 0x4f, 0x00, 0x3c, 0x32,
-	0x22, 0x41, 0x3f, 0x5e, 0x56, 0x7a, 0x2c, 0x0c, 0x1c, 0x00, 0xb0, 0x3d, 0xba, 0x71, 0x27, 0xf2,
-	0x34, 0x17, 0x2a, 0x03, 0x7e, 0x0c, 0x4a, 0x4b, 0x54, 0xc2, 0xea, 0xd2, 0x7b, 0x34, 0x22, 0xc1,
-	0x20, 0xf6, 0x12, 0xe7, 0x7e, 0x5b, 0x1b, 0xf6, 0x5b, 0x9d, 0xed, 0xd2, 0x35, 0x28, 0x84, 0xb1,
-	0x98, 0xa2, 0x9d, 0x72, 0x79, 0x56, 0x58, 0x9c, 0x14, 0x08, 0xa5, 0xd7, 0xad, 0x1b, 0x57, 0x5b,
-	0xf1, 0x53, 0xa3, 0xb1, 0xe7, 0x74, 0x00, 0x4a, 0x24, 0x05, 0xf0, 0x1c, 0x30, 0xcb, 0xad, 0xb7,
-	0x38, 0x22, 0x41, 0x37, 0x7e, 0xec, 0x8a, 0xef, 0xeb, 0x1a, 0xdb, 0xa3, 0xfd, 0x66, 0xea, 0xde,
-	0x88, 0x04, 0xcb, 0xe3, 0xad, 0x8b, 0xab, 0xcd, 0xce, 0xaf, 0xab, 0xcd, 0xb5, 0x54, 0x1b, 0xa9,
-	0x8d, 0x39, 0x3e, 0x0d, 0x51, 0x47, 0x52, 0xd8, 0x3c, 0xfc, 0xa0, 0xec, 0x8f, 0xeb, 0xf3, 0x6d,
-	0x12, 0x2f, 0xdd, 0x4e, 0xca, 0x0e, 0xe9, 0x40, 0xa2, 0xe2, 0x99, 0x30, 0x7c, 0x52, 0x62, 0x0a,
-	0xde, 0x52, 0xcd, 0x08, 0x6e, 0x19, 0x1b, 0xff, 0x33, 0x0e, 0x21, 0x13, 0xe9, 0x74, 0x1f, 0x52,
-	0x47, 0x5a, 0x91, 0xa8, 0xde, 0x09, 0x73, 0x74, 0xd3, 0xcc, 0x3e, 0x53, 0x76, 0x47, 0xbb, 0xf7,
-	0x8d, 0xfd, 0x07, 0x22, 0x87, 0x0e, 0xd9, 0xfe, 0x89, 0x37, 0xcf, 0xbe, 0x5d, 0x9f, 0x6f, 0x7b,
-	0x50, 0x49, 0x6d, 0xa2, 0xaf, 0xf7, 0x8e, 0xc2, 0x2d, 0xef, 0xe3, 0x42, 0x7f, 0x61, 0xb8, 0x18,
-	0x0f, 0x51, 0xa1, 0x45, 0x51, 0x34, 0x5b, 0x1c, 0x1f, 0x5c, 0xcc, 0x7c, 0x72, 0x39, 0xf3, 0xc9,
-	0x9f, 0x99, 0x4f, 0xbe, 0xcf, 0xfd, 0xce, 0xe5, 0xdc, 0xef, 0xfc, 0x9c, 0xfb, 0x9d, 0x2f, 0x2f,
-	0x33, 0xb4, 0xf9, 0x59, 0x12, 0xa6, 0x5a, 0x46, 0x0e, 0xeb, 0x62, 0xf5, 0xfa, 0xd5, 0x3f, 0x2f,
-	0xb0, 0xd3, 0x09, 0x98, 0xa4, 0x57, 0x9f, 0xcb, 0xee, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x01,
-	0x98, 0x94, 0x6d, 0x99, 0x02, 0x00, 0x00,
+	// 1172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xb3, 0x89, 0x9b, 0xda, 0x93, 0xd8, 0xdd, 0x4e, 0x93, 0xfc, 0xb6, 0xc9, 0xaf, 0xae,
+	0x65, 0x04, 0x32, 0x11, 0xd8, 0x6d, 0xaa, 0x1e, 0x40, 0xf4, 0xe0, 0x34, 0xb6, 0x6b, 0x88, 0x49,
+	0xba, 0xb1, 0x5b, 0x51, 0x84, 0x56, 0x63, 0xef, 0x93, 0xf5, 0xd0, 0xdd, 0x1d, 0x6b, 0x66, 0xec,
+	0xd6, 0x3d, 0x71, 0x44, 0x88, 0x03, 0x2f, 0x80, 0x1b, 0x1c, 0x38, 0xf6, 0xc0, 0x8b, 0xe8, 0x05,
+	0xa9, 0xe2, 0x84, 0x38, 0x14, 0xd4, 0x1e, 0xfa, 0x36, 0xd0, 0xce, 0xfe, 0xb1, 0x9b, 0xb4, 0x48,
+	0x5b, 0x2e, 0x96, 0xe7, 0xf9, 0xf3, 0x99, 0x79, 0x9e, 0x7d, 0xf6, 0xbb, 0x83, 0xde, 0x03, 0x39,
+	0x04, 0xee, 0x51, 0x5f, 0xd6, 0x8e, 0x01, 0x3c, 0xc2, 0xef, 0x83, 0xac, 0x4d, 0xae, 0xce, 0x16,
+	0xd5, 0x11, 0x67, 0x92, 0xe1, 0x8d, 0x24, 0xae, 0x3a, 0x73, 0x4d, 0xae, 0x6e, 0x9e, 0x27, 0x1e,
+	0xf5, 0x59, 0x4d, 0xfd, 0x86, 0xa1, 0x9b, 0x17, 0x07, 0x4c, 0x78, 0x4c, 0x58, 0x6a, 0x55, 0x0b,
+	0x17, 0x91, 0x6b, 0xcd, 0x61, 0x0e, 0x0b, 0xed, 0xc1, 0xbf, 0xd0, 0x5a, 0xfe, 0x71, 0x15, 0x2d,
+	0x1f, 0x12, 0x4e, 0x3c, 0x81, 0x8b, 0x68, 0xc5, 0x67, 0x56, 0x9f, 0x08, 0xb0, 0x8e, 0x01, 0x0c,
+	0xad, 0xa4, 0x55, 0xb2, 0x66, 0xce, 0x67, 0xbb, 0x44, 0x40, 0x13, 0x00, 0xdf, 0x40, 0x5b, 0xb1,
+	0xd3, 0x1a, 0x0c, 0x89, 0xef, 0x80, 0x65, 0x83, 0xcf, 0x3c, 0xea, 0x13, 0xc9, 0xb8, 0xb1, 0x58,
+	0xd2, 0x2a, 0x79, 0xd3, 0xe8, 0x87, 0xd1, 0x37, 0x55, 0xc0, 0xde, 0xcc, 0x8f, 0xaf, 0xa1, 0x75,
+	0x70, 0x89, 0x90, 0x74, 0x40, 0xe5, 0xd4, 0xf2, 0xc6, 0xae, 0xa4, 0x23, 0x97, 0x02, 0x37, 0x96,
+	0x54, 0xe2, 0xda, 0xcc, 0xd9, 0x49, 0x7c, 0xf8, 0x1d, 0x94, 0x07, 0x9f, 0xf4, 0x5d, 0xb0, 0x86,
+	0x40, 0x9d, 0xa1, 0x34, 0xce, 0x94, 0xb4, 0xca, 0x92, 0xb9, 0x1a, 0x1a, 0x6f, 0x29, 0x1b, 0xbe,
+	0x81, 0xb2, 0xc9, 0xa9, 0x97, 0x4b, 0x5a, 0x25, 0xb7, 0x5b, 0x7e, 0xf2, 0xec, 0xf2, 0xc2, 0x9f,
+	0xcf, 0x2e, 0xaf, 0x87, 0x1d, 0x10, 0xf6, 0xfd, 0x2a, 0x65, 0x35, 0x8f, 0xc8, 0x61, 0xb5, 0xed,
+	0xcb, 0x5f, 0x5e, 0x3e, 0xde, 0xd6, 0xcc, 0xb3, 0xd1, 0x49, 0xf1, 0x3e, 0xca, 0x7b, 0xd4, 0xb7,
+	0x1c, 0x12, 0xb4, 0x8d, 0x0e, 0xc0, 0x38, 0xab, 0x18, 0x95, 0x88, 0xb1, 0x75, 0x9a, 0xb1, 0x0f,
+	0x0e, 0x19, 0x4c, 0xf7, 0x60, 0x10, 0x92, 0x56, 0x3c, 0xea, 0xb7, 0x88, 0x38, 0x0c, 0x92, 0xf1,
+	0x1d, 0x84, 0x63, 0xda, 0x5c, 0x8d, 0xd9, 0x94, 0x48, 0x3d, 0x44, 0xce, 0x75, 0xe2, 0x0e, 0xc2,
+	0x49, 0xf7, 0x89, 0xeb, 0x30, 0x4e, 0xe5, 0xd0, 0x33, 0x72, 0x25, 0xad, 0x52, 0xd8, 0xa9, 0x54,
+	0x5f, 0x3f, 0x21, 0xd5, 0xe8, 0xd1, 0xd5, 0xe3, 0x78, 0x53, 0xef, 0x9f, 0xb0, 0xe0, 0xcb, 0x68,
+	0x85, 0x50, 0xcf, 0xb6, 0x1e, 0x50, 0xdf, 0x66, 0x0f, 0x0c, 0xa4, 0x1e, 0x06, 0x0a, 0x4c, 0x77,
+	0x95, 0x05, 0x1f, 0xa3, 0x0d, 0x15, 0x40, 0x6c, 0x9b, 0x4a, 0x3a, 0x01, 0x8b, 0xfa, 0x03, 0x0e,
+	0x44, 0x80, 0xb1, 0xa2, 0x8a, 0xba, 0xf2, 0xaf, 0xbd, 0xfe, 0xfd, 0xd7, 0x0f, 0x51, 0x34, 0x86,
+	0x49, 0xe7, 0xd7, 0x02, 0x5e, 0x3d, 0xc2, 0xb5, 0x23, 0x1a, 0xfe, 0x1a, 0xfd, 0x5f, 0xed, 0x13,
+	0x77, 0x6d, 0x40, 0xd4, 0x6e, 0x36, 0x44, 0xbb, 0xad, 0xa6, 0x6c, 0xe1, 0x66, 0x40, 0xeb, 0xbc,
+	0x02, 0xdb, 0x8b, 0x58, 0xf8, 0x2b, 0xb4, 0x3e, 0x96, 0xd4, 0xa5, 0x8f, 0x88, 0xa4, 0xcc, 0xb7,
+	0x84, 0xc7, 0x98, 0x1c, 0x52, 0xdf, 0x31, 0xf2, 0x29, 0x37, 0x59, 0x9b, 0xc3, 0x1c, 0xc5, 0x14,
+	0xdc, 0x43, 0xe7, 0x47, 0x9c, 0x8d, 0x18, 0x0f, 0xcc, 0xc4, 0xb5, 0x1c, 0x42, 0x7d, 0xa3, 0x90,
+	0x76, 0x04, 0xe6, 0x11, 0x2d, 0x42, 0x7d, 0xdc, 0x41, 0x79, 0xea, 0x4b, 0x70, 0x78, 0x8c, 0x3c,
+	0x97, 0x12, 0xb9, 0x1a, 0xa7, 0x2b, 0xdc, 0x6d, 0x74, 0xce, 0x06, 0x4e, 0x27, 0x61, 0x9f, 0x15,
+	0x50, 0x4f, 0x09, 0x2c, 0xcc, 0x00, 0x0a, 0x59, 0x41, 0x7a, 0x30, 0x9f, 0x43, 0x2a, 0x24, 0xe3,
+	0x53, 0x4b, 0xd0, 0x47, 0x60, 0x9c, 0x57, 0x13, 0x55, 0x38, 0x06, 0xb8, 0x15, 0x9a, 0x8f, 0xe8,
+	0x23, 0xc0, 0xb7, 0x51, 0x21, 0x19, 0x67, 0x31, 0x72, 0xa9, 0x34, 0x70, 0x49, 0xab, 0xac, 0xec,
+	0x94, 0xde, 0x34, 0xca, 0x4d, 0x80, 0xa3, 0x20, 0x6e, 0x37, 0x17, 0x9c, 0x2e, 0xaa, 0x27, 0x9a,
+	0x66, 0xe5, 0xc0, 0x5f, 0x20, 0x3c, 0xe2, 0x34, 0x18, 0xeb, 0xe9, 0x1c, 0xf6, 0x42, 0x7a, 0xac,
+	0x1e, 0x63, 0x12, 0x34, 0x45, 0xeb, 0x1e, 0x08, 0x41, 0x9c, 0xf0, 0xc0, 0x6c, 0x02, 0x9c, 0x53,
+	0x1b, 0x84, 0xb1, 0x56, 0x5a, 0xaa, 0xac, 0xec, 0x6c, 0xbf, 0x89, 0xde, 0x09, 0x93, 0x9a, 0x00,
+	0x07, 0x51, 0xca, 0xfc, 0x3e, 0x17, 0xbc, 0x53, 0x6e, 0x81, 0x7b, 0x48, 0x8f, 0x34, 0x7c, 0x56,
+	0xc3, 0x7a, 0xfa, 0x1a, 0x0a, 0x21, 0x24, 0x76, 0x7d, 0x7c, 0xe9, 0xbb, 0x97, 0x8f, 0xb7, 0x0d,
+	0x98, 0x78, 0x4c, 0xd4, 0x1e, 0xce, 0x7d, 0x6e, 0x42, 0xed, 0xff, 0x34, 0x93, 0xcd, 0xe8, 0x67,
+	0x4c, 0x9d, 0xfa, 0x54, 0x52, 0xe2, 0x26, 0x1f, 0x81, 0xf2, 0xcf, 0x8b, 0x08, 0x9f, 0x2e, 0x02,
+	0x97, 0xd0, 0xaa, 0x27, 0x1c, 0x4b, 0x4e, 0x47, 0x60, 0x8d, 0xb9, 0xab, 0xbe, 0x15, 0x39, 0x13,
+	0x79, 0xc2, 0xe9, 0x4e, 0x47, 0xd0, 0xe3, 0xee, 0x69, 0x51, 0x5d, 0xfc, 0x2f, 0xa2, 0xda, 0x41,
+	0xb9, 0x63, 0xfa, 0x10, 0x6c, 0x25, 0xf1, 0x4b, 0x6f, 0x29, 0x3b, 0x59, 0x85, 0x08, 0x14, 0xff,
+	0x00, 0x15, 0x4e, 0xe8, 0x73, 0x26, 0xe5, 0xe9, 0xf2, 0xce, 0xbc, 0x38, 0x97, 0xff, 0xd2, 0x50,
+	0x36, 0x19, 0x96, 0x4f, 0x50, 0xa6, 0x3f, 0xe6, 0xbe, 0xa1, 0xa5, 0x64, 0xaa, 0x2c, 0xbc, 0x87,
+	0xb2, 0xea, 0xc5, 0x17, 0xc0, 0x53, 0xf7, 0x2c, 0xc9, 0x0c, 0x2a, 0x1c, 0x30, 0xcf, 0x1b, 0xfb,
+	0xc1, 0xcb, 0x30, 0x62, 0xcc, 0x35, 0x96, 0x52, 0xb2, 0xf2, 0x49, 0xfe, 0x21, 0x63, 0x6e, 0xf9,
+	0xfb, 0x45, 0xb4, 0x11, 0x7d, 0x4d, 0x6e, 0x32, 0x5f, 0x72, 0xe6, 0xba, 0xc0, 0x8f, 0x24, 0x91,
+	0x80, 0xbf, 0x44, 0x6b, 0xa1, 0x80, 0x82, 0x6d, 0xcd, 0xc9, 0x61, 0xea, 0xfa, 0x2f, 0xc4, 0x94,
+	0xde, 0x0c, 0x12, 0xb4, 0x23, 0x16, 0xad, 0xf4, 0xed, 0x88, 0x33, 0x83, 0x76, 0x8c, 0x38, 0x4c,
+	0x28, 0x1b, 0x0b, 0x0b, 0x38, 0x67, 0x3c, 0x7d, 0x3b, 0xe2, 0xfc, 0x46, 0x90, 0x5e, 0x6e, 0xa1,
+	0xc2, 0xae, 0xcb, 0x06, 0xf7, 0x5b, 0x44, 0xdc, 0x25, 0xbe, 0x04, 0x1b, 0x6f, 0xa0, 0xe5, 0xe8,
+	0x8a, 0xa2, 0xa9, 0x2b, 0x4a, 0xb4, 0xc2, 0x97, 0x10, 0x0a, 0x66, 0xed, 0x81, 0x8a, 0x52, 0x25,
+	0x64, 0xcc, 0x9c, 0x13, 0xa7, 0x95, 0x7f, 0xd3, 0xd0, 0x39, 0x45, 0x6a, 0x26, 0xfa, 0xf8, 0x46,
+	0xd4, 0x67, 0x73, 0xf7, 0x9c, 0xc5, 0xb7, 0x7c, 0x09, 0x92, 0x5b, 0xcf, 0xab, 0xe7, 0x5a, 0x3a,
+	0x71, 0x2e, 0x7c, 0x11, 0x65, 0x03, 0xf7, 0x58, 0x80, 0xad, 0x5e, 0x8e, 0x8c, 0x79, 0xd6, 0x21,
+	0xa2, 0x27, 0xc0, 0xc6, 0x5b, 0x28, 0x88, 0xb3, 0x5c, 0xea, 0xd1, 0xf0, 0x3e, 0x96, 0x31, 0x83,
+	0xd8, 0xfd, 0x60, 0xbd, 0xfd, 0x8d, 0x86, 0xf4, 0x93, 0xb7, 0x0e, 0x5c, 0x44, 0x9b, 0xbb, 0xf5,
+	0xa3, 0x86, 0xd5, 0x6c, 0x34, 0xac, 0xfa, 0x7e, 0xeb, 0xc0, 0x6c, 0x77, 0x6f, 0x75, 0xac, 0x46,
+	0xfb, 0xf0, 0xea, 0xf5, 0xeb, 0x1f, 0xe9, 0x0b, 0x78, 0x0b, 0xfd, 0xef, 0x35, 0xfe, 0x7a, 0xbb,
+	0xb3, 0xa7, 0x6b, 0xf8, 0x7d, 0xf4, 0xee, 0x6b, 0x9c, 0xdd, 0xba, 0xd9, 0x6a, 0x74, 0xad, 0x5e,
+	0xb7, 0xbd, 0xdf, 0xbe, 0x57, 0xef, 0xb6, 0x0f, 0x3e, 0xd7, 0x17, 0x37, 0x33, 0xdf, 0xfe, 0x54,
+	0x5c, 0xd8, 0x6d, 0x3e, 0x79, 0x5e, 0xd4, 0x9e, 0x3e, 0x2f, 0x6a, 0x7f, 0x3f, 0x2f, 0x6a, 0x3f,
+	0xbc, 0x28, 0x2e, 0x3c, 0x7d, 0x51, 0x5c, 0xf8, 0xe3, 0x45, 0x71, 0xe1, 0xde, 0x07, 0x0e, 0x95,
+	0xc3, 0x71, 0xbf, 0x3a, 0x60, 0x5e, 0x2d, 0x94, 0xc2, 0xf0, 0x77, 0xb2, 0x73, 0xe5, 0x15, 0x51,
+	0x0c, 0x74, 0x4d, 0xf4, 0x97, 0xd5, 0x0d, 0xf9, 0xda, 0x3f, 0x03, 0x00, 0xb5, 0xe7, 0x09, 0x3a,
+	0xa7, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,49 +630,180 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size := m.MinGasMultiplier.Size()
+		size, err := m.CosmosFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.MinGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.MessageFeeOverrides) > 0 {
+		for iNdEx := len(m.MessageFeeOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageFeeOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	{
+		size, err := m.PriorityFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
 	{
-		size := m.MinGasPrice.Size()
+		size, err := m.BaseFeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.DerivativeGain.Size()
+		i -= size
+		if _, err := m.DerivativeGain.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	{
-		size := m.BaseFee.Size()
+		size := m.IntegralGain.Size()
 		i -= size
-		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.IntegralGain.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
-	if m.EnableHeight != 0 {
-		i = encodeVarintFeemarket(dAtA, i, uint64(m.EnableHeight))
-		i--
-		dAtA[i] = 0x28
+	dAtA[i] = 0x7a
+	{
+		size := m.ProportionalGain.Size()
+		i -= size
+		if _, err := m.ProportionalGain.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.ElasticityMultiplier != 0 {
-		i = encodeVarintFeemarket(dAtA, i, uint64(m.ElasticityMultiplier))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.UtilizationSmoothing.Size()
+		i -= size
+		if _, err := m.UtilizationSmoothing.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.AimdMultiplicativeDecrease.Size()
+		i -= size
+		if _, err := m.AimdMultiplicativeDecrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.AimdAdditiveIncrease.Size()
+		i -= size
+		if _, err := m.AimdAdditiveIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.AimdWindow != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.AimdWindow))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x50
 	}
-	if m.BaseFeeChangeDenominator != 0 {
-		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+	if m.BaseFeeAlgorithm != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeAlgorithm))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinGasMultiplier.Size()
+		i -= size
+		if _, err := m.MinGasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EnableHeight != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.EnableHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x10
 	}
 	if m.NoBaseFee {
 		i--
@@ -225,6 +818,258 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageFeeOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageFeeOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageFeeOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasMultiplier.Size()
+		i -= size
+		if _, err := m.GasMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FixedFee.Size()
+		i -= size
+		if _, err := m.FixedFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Proposer.Size()
+		i -= size
+		if _, err := m.Proposer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeControllerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeControllerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeControllerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PreviousError.Size()
+		i -= size
+		if _, err := m.PreviousError.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Integral.Size()
+		i -= size
+		if _, err := m.Integral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SmoothedUtilization.Size()
+		i -= size
+		if _, err := m.SmoothedUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockGasWanted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasWanted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasWanted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -260,16 +1105,1095 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeAlgorithm != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeAlgorithm))
+	}
+	if m.AimdWindow != 0 {
+		n += 1 + sovFeemarket(uint64(m.AimdWindow))
+	}
+	l = m.AimdAdditiveIncrease.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.AimdMultiplicativeDecrease.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.UtilizationSmoothing.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.ProportionalGain.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.IntegralGain.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.DerivativeGain.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	if m.FeeHistorySize != 0 {
+		n += 2 + sovFeemarket(uint64(m.FeeHistorySize))
+	}
+	l = m.BaseFeeSplit.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.PriorityFeeSplit.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	if len(m.MessageFeeOverrides) > 0 {
+		for _, e := range m.MessageFeeOverrides {
+			l = e.Size()
+			n += 2 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = m.CosmosFeeSplit.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
 	return n
 }
 
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MessageFeeOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.FixedFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.GasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burn.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Proposer.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BaseFeeControllerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SmoothedUtilization.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.Integral.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.PreviousError.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BlockGasWanted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	return n
+}
+
+func (m *BlockFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+			}
+			m.EnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeAlgorithm", wireType)
+			}
+			m.BaseFeeAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeAlgorithm |= BaseFeeAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AimdWindow", wireType)
+			}
+			m.AimdWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AimdWindow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AimdAdditiveIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AimdAdditiveIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AimdMultiplicativeDecrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AimdMultiplicativeDecrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationSmoothing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UtilizationSmoothing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProportionalGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProportionalGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegralGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IntegralGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeGain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivativeGain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriorityFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageFeeOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageFeeOverrides = append(m.MessageFeeOverrides, MessageFeeOverride{})
+			if err := m.MessageFeeOverrides[len(m.MessageFeeOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosFeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CosmosFeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageFeeOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageFeeOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageFeeOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BaseFeeControllerState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -292,17 +2216,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaseFeeControllerState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaseFeeControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothedUtilization", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -312,17 +2236,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoBaseFee = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothedUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
 			}
-			m.BaseFeeChangeDenominator = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -332,16 +2270,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Integral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousError", wireType)
 			}
-			m.ElasticityMultiplier = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -351,16 +2304,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockGasWanted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasWanted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasWanted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.EnableHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -370,16 +2388,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnableHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
 			}
-			var stringLen uint64
+			m.GasWanted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -389,29 +2407,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasWanted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeemarket
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthFeemarket
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -439,15 +2511,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
 			}
-			var stringLen uint64
+			m.GasWanted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -457,26 +2529,49 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasWanted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeemarket
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeemarket
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
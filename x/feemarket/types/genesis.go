@@ -2,6 +2,8 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -21,5 +23,40 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenWindow := make(map[int64]bool)
+	for _, block := range gs.BlockGasWindow {
+		if block.Height <= 0 {
+			return fmt.Errorf("invalid block gas window height %d", block.Height)
+		}
+		if seenWindow[block.Height] {
+			return fmt.Errorf("block gas window height duplicated on genesis: %d", block.Height)
+		}
+		seenWindow[block.Height] = true
+	}
+
+	if state := gs.BaseFeeControllerState; state != nil {
+		if state.SmoothedUtilization.IsNil() || state.Integral.IsNil() || state.PreviousError.IsNil() {
+			return fmt.Errorf("base fee controller state fields cannot be nil: %s", state)
+		}
+	}
+
+	seenHistory := make(map[int64]bool)
+	for _, history := range gs.FeeHistory {
+		if history.Height <= 0 {
+			return fmt.Errorf("invalid fee history height %d", history.Height)
+		}
+		if history.BaseFee.IsNil() || history.BaseFee.IsNegative() {
+			return fmt.Errorf("invalid fee history base fee at height %d: %s", history.Height, history.BaseFee)
+		}
+		if seenHistory[history.Height] {
+			return fmt.Errorf("fee history height duplicated on genesis: %d", history.Height)
+		}
+		seenHistory[history.Height] = true
+	}
+
+	return nil
 }
@@ -31,6 +31,14 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// block_gas_window is the gas wanted of the blocks in the window of the AIMD
+	// algorithm. Empty unless the AIMD algorithm is selected.
+	BlockGasWindow []BlockGasWanted `protobuf:"bytes,4,rep,name=block_gas_window,json=blockGasWindow,proto3" json:"block_gas_window"`
+	// base_fee_controller_state is the state of the target utilization algorithm.
+	// Nil unless the target utilization algorithm is selected.
+	BaseFeeControllerState *BaseFeeControllerState `protobuf:"bytes,5,opt,name=base_fee_controller_state,json=baseFeeControllerState,proto3" json:"base_fee_controller_state,omitempty"`
+	// fee_history is the base fee and gas of the blocks kept in the fee history.
+	FeeHistory []BlockFeeHistory `protobuf:"bytes,6,rep,name=fee_history,json=feeHistory,proto3" json:"fee_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +88,27 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBlockGasWindow() []BlockGasWanted {
+	if m != nil {
+		return m.BlockGasWindow
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBaseFeeControllerState() *BaseFeeControllerState {
+	if m != nil {
+		return m.BaseFeeControllerState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeHistory() []BlockFeeHistory {
+	if m != nil {
+		return m.FeeHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -89,24 +118,31 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x6a, 0x38, 0x0c, 0x44, 0xe8, 0x83, 0xa8, 0x13, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x1d, 0x17, 0x8f, 0x3b, 0xc4,
-	0xe6, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x47, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0x2e, 0xd1, 0x0b, 0x00, 0xab, 0x72,
-	0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x8d, 0x42, 0xd2,
-	0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5, 0x12, 0xcc, 0x0a, 0x8c, 0x1a,
-	0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e, 0x26, 0x01, 0xe6, 0x20, 0x8e,
-	0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x4f, 0x2d, 0xcb, 0xcd, 0x2f, 0x86, 0x92, 0x65, 0x46, 0x06, 0xfa, 0x15, 0x48, 0x5e, 0x2d, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x4a,
-	0xda, 0x75, 0x5f, 0x01, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0x6e, 0x6f, 0xb9, 0x04, 0x86, 0x9b, 0x1b, 0x6c, 0x0c, 0xa9, 0x98, 0x54, 0x62, 0x0c, 0x12,
+	0x63, 0x5a, 0xc1, 0x27, 0x10, 0x13, 0x30, 0xae, 0x4c, 0x59, 0x90, 0xe8, 0xa2, 0x99, 0xc2, 0xa1,
+	0x4c, 0xa0, 0x1d, 0x32, 0x33, 0x16, 0x79, 0x0b, 0xf7, 0xbe, 0x80, 0x4b, 0x1f, 0x83, 0x25, 0x4b,
+	0x57, 0xc6, 0xc0, 0xc2, 0xd7, 0x30, 0x4c, 0xa1, 0xb2, 0x00, 0x37, 0x27, 0x27, 0x5f, 0xbf, 0x9f,
+	0x7e, 0x33, 0x83, 0x4e, 0x40, 0xf4, 0x81, 0x05, 0x24, 0x14, 0x76, 0x0f, 0x20, 0xc0, 0x6c, 0x00,
+	0xc2, 0x8e, 0xaa, 0xb6, 0x0f, 0x21, 0x70, 0xc2, 0xad, 0x11, 0xa3, 0x82, 0xea, 0x85, 0x84, 0x65,
+	0x25, 0x2c, 0x2b, 0xaa, 0x16, 0xf7, 0x70, 0x40, 0x42, 0x6a, 0xcb, 0x19, 0x53, 0x8b, 0xe5, 0x1d,
+	0x86, 0x3f, 0xba, 0x98, 0xb7, 0xef, 0x53, 0x9f, 0xca, 0xd5, 0x5e, 0x6e, 0x31, 0x7a, 0xfc, 0xa2,
+	0xa1, 0x7f, 0xcd, 0x38, 0xba, 0x25, 0xb0, 0x00, 0xfd, 0x0a, 0xa5, 0x47, 0x98, 0xe1, 0x80, 0x1b,
+	0x6a, 0x49, 0xad, 0xe4, 0x6a, 0xa6, 0xb5, 0xfd, 0x57, 0xac, 0x3b, 0xc9, 0xaa, 0x67, 0xa7, 0x1f,
+	0x47, 0xca, 0xeb, 0xd7, 0xdb, 0x99, 0xea, 0xac, 0x84, 0xfa, 0x21, 0xca, 0x7a, 0x43, 0xda, 0x19,
+	0xb8, 0x3e, 0xe6, 0x86, 0x56, 0x52, 0x2b, 0x29, 0x27, 0x23, 0x81, 0x26, 0xe6, 0xfa, 0x03, 0xca,
+	0x27, 0x1f, 0xdd, 0x31, 0x09, 0xbb, 0x74, 0x6c, 0xa4, 0x4a, 0x5a, 0x25, 0x57, 0x2b, 0xef, 0x4a,
+	0xaa, 0xaf, 0xb4, 0x6d, 0x1c, 0x0a, 0xe8, 0x6e, 0x26, 0xfe, 0x5f, 0xdb, 0xb6, 0xa5, 0x91, 0x4e,
+	0xd0, 0x81, 0x87, 0x39, 0xb8, 0x3d, 0x00, 0xb7, 0x43, 0x43, 0xc1, 0xe8, 0x70, 0x08, 0xcc, 0xe5,
+	0xcb, 0x66, 0xc6, 0x5f, 0xd9, 0xc7, 0xda, 0x99, 0x82, 0x39, 0x34, 0x00, 0xae, 0x13, 0x99, 0x3c,
+	0x0f, 0xa7, 0xe0, 0x6d, 0xc5, 0xf5, 0x16, 0xca, 0x2d, 0x53, 0xfa, 0x84, 0x0b, 0xca, 0x26, 0x46,
+	0x5a, 0x56, 0x38, 0xfd, 0xb5, 0x42, 0x03, 0xe0, 0x26, 0xa6, 0x6f, 0x76, 0x40, 0xbd, 0x04, 0xbe,
+	0x4d, 0x65, 0xfe, 0xe4, 0x35, 0x27, 0xb3, 0xee, 0x50, 0x6f, 0x4c, 0xe7, 0xa6, 0x3a, 0x9b, 0x9b,
+	0xea, 0xe7, 0xdc, 0x54, 0x9f, 0x17, 0xa6, 0x32, 0x5b, 0x98, 0xca, 0xfb, 0xc2, 0x54, 0xee, 0xcf,
+	0x7d, 0x22, 0xfa, 0x8f, 0x9e, 0xd5, 0xa1, 0x81, 0x0d, 0x51, 0x40, 0xf9, 0x6a, 0x46, 0xb5, 0x0b,
+	0xfb, 0x69, 0xe3, 0x21, 0x88, 0xc9, 0x08, 0xb8, 0x97, 0x96, 0x97, 0x7d, 0xf9, 0x3d, 0x00, 0xe4,
+	0x76, 0x5a, 0xe1, 0x7d, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +165,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeHistory) > 0 {
+		for iNdEx := len(m.FeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BaseFeeControllerState != nil {
+		{
+			size, err := m.BaseFeeControllerState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockGasWindow) > 0 {
+		for iNdEx := len(m.BlockGasWindow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockGasWindow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -169,6 +245,22 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.BlockGasWindow) > 0 {
+		for _, e := range m.BlockGasWindow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BaseFeeControllerState != nil {
+		l = m.BaseFeeControllerState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FeeHistory) > 0 {
+		for _, e := range m.FeeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -259,6 +351,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockGasWindow = append(m.BlockGasWindow, BlockGasWanted{})
+			if err := m.BlockGasWindow[len(m.BlockGasWindow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeControllerState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseFeeControllerState == nil {
+				m.BaseFeeControllerState = &BaseFeeControllerState{}
+			}
+			if err := m.BaseFeeControllerState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeHistory = append(m.FeeHistory, BlockFeeHistory{})
+			if err := m.FeeHistory[len(m.FeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
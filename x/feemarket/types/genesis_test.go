@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -28,11 +29,68 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
+			},
+			true,
+		},
+		{
+			"valid genesis with base fee algorithm state",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BlockGas:       uint64(1),
+				BlockGasWindow: []BlockGasWanted{{Height: 1, GasWanted: 100}, {Height: 2, GasWanted: 200}},
+				BaseFeeControllerState: &BaseFeeControllerState{
+					SmoothedUtilization: math.LegacyNewDecWithPrec(5, 1),
+					Integral:            math.LegacyZeroDec(),
+					PreviousError:       math.LegacyZeroDec(),
+				},
+				FeeHistory: []BlockFeeHistory{{Height: 1, BaseFee: math.NewInt(1000)}},
 			},
 			true,
 		},
+		{
+			"invalid block gas window height",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BlockGasWindow: []BlockGasWanted{{Height: 0, GasWanted: 100}},
+			},
+			false,
+		},
+		{
+			"duplicated block gas window height",
+			&GenesisState{
+				Params:         DefaultParams(),
+				BlockGasWindow: []BlockGasWanted{{Height: 1, GasWanted: 100}, {Height: 1, GasWanted: 200}},
+			},
+			false,
+		},
+		{
+			"nil base fee controller state field",
+			&GenesisState{
+				Params: DefaultParams(),
+				BaseFeeControllerState: &BaseFeeControllerState{
+					SmoothedUtilization: math.LegacyNewDecWithPrec(5, 1),
+				},
+			},
+			false,
+		},
+		{
+			"negative fee history base fee",
+			&GenesisState{
+				Params:     DefaultParams(),
+				FeeHistory: []BlockFeeHistory{{Height: 1, BaseFee: math.NewInt(-1)}},
+			},
+			false,
+		},
+		{
+			"duplicated fee history height",
+			&GenesisState{
+				Params:     DefaultParams(),
+				FeeHistory: []BlockFeeHistory{{Height: 1, BaseFee: math.NewInt(1)}, {Height: 1, BaseFee: math.NewInt(2)}},
+			},
+			false,
+		},
 		{
 			"valid New genesis",
 			NewGenesisState(
@@ -22,6 +22,9 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockGasWindow
+	prefixBaseFeeControllerState
+	prefixBlockFeeHistory
 )
 
 const (
@@ -30,7 +33,10 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted         = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWindow         = []byte{prefixBlockGasWindow}
+	KeyPrefixBaseFeeControllerState = []byte{prefixBaseFeeControllerState}
+	KeyPrefixBlockFeeHistory        = []byte{prefixBlockFeeHistory}
 )
 
 // Transient Store key prefixes
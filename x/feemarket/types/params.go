@@ -4,6 +4,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -19,6 +20,39 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeAlgorithm is EIP-1559
+	DefaultBaseFeeAlgorithm = BASE_FEE_ALGORITHM_EIP1559
+	// DefaultAIMDWindow is 10 blocks
+	DefaultAIMDWindow = uint32(10)
+	// DefaultAIMDAdditiveIncrease is 1/8 of the initial base fee
+	DefaultAIMDAdditiveIncrease = math.NewIntFromUint64(params.InitialBaseFee / params.BaseFeeChangeDenominator)
+	// DefaultAIMDMultiplicativeDecrease is 0.875 or 87.5%
+	DefaultAIMDMultiplicativeDecrease = math.LegacyNewDecWithPrec(875, 3)
+	// DefaultUtilizationSmoothing is 0.2 or 20%
+	DefaultUtilizationSmoothing = math.LegacyNewDecWithPrec(2, 1)
+	// DefaultProportionalGain is 0.125
+	DefaultProportionalGain = math.LegacyNewDecWithPrec(125, 3)
+	// DefaultIntegralGain is 0.0125
+	DefaultIntegralGain = math.LegacyNewDecWithPrec(125, 4)
+	// DefaultDerivativeGain is 0 (i.e. PI controller)
+	DefaultDerivativeGain = math.LegacyZeroDec()
+	// DefaultFeeHistorySize is 100 blocks
+	DefaultFeeHistorySize = uint32(100)
+	// DefaultBaseFeeSplit keeps the whole base fee of Ethereum transactions in
+	// the fee collector, so it is distributed to the stakers
+	DefaultBaseFeeSplit = NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	// DefaultPriorityFeeSplit keeps the whole priority fee of Ethereum
+	// transactions in the fee collector, so it is distributed to the stakers
+	DefaultPriorityFeeSplit = NewFeeSplit(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+	// DefaultCosmosFeeSplit burns the whole fee of Cosmos transactions
+	DefaultCosmosFeeSplit = NewFeeSplit(math.LegacyOneDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+)
+
+const (
+	// MaxAIMDWindow is the maximum number of blocks of the AIMD window
+	MaxAIMDWindow = 1000
+	// MaxFeeHistorySize is the maximum number of blocks kept in the fee history
+	MaxFeeHistorySize = 10000
 )
 
 // Parameter keys
@@ -62,26 +96,50 @@ func NewParams(
 	minGasPriceMultiplier math.LegacyDec,
 ) Params {
 	return Params{
-		NoBaseFee:                noBaseFee,
-		BaseFeeChangeDenominator: baseFeeChangeDenom,
-		ElasticityMultiplier:     elasticityMultiplier,
-		BaseFee:                  math.NewIntFromUint64(baseFee),
-		EnableHeight:             enableHeight,
-		MinGasPrice:              minGasPrice,
-		MinGasMultiplier:         minGasPriceMultiplier,
+		NoBaseFee:                  noBaseFee,
+		BaseFeeChangeDenominator:   baseFeeChangeDenom,
+		ElasticityMultiplier:       elasticityMultiplier,
+		BaseFee:                    math.NewIntFromUint64(baseFee),
+		EnableHeight:               enableHeight,
+		MinGasPrice:                minGasPrice,
+		MinGasMultiplier:           minGasPriceMultiplier,
+		BaseFeeAlgorithm:           DefaultBaseFeeAlgorithm,
+		AimdWindow:                 DefaultAIMDWindow,
+		AimdAdditiveIncrease:       DefaultAIMDAdditiveIncrease,
+		AimdMultiplicativeDecrease: DefaultAIMDMultiplicativeDecrease,
+		UtilizationSmoothing:       DefaultUtilizationSmoothing,
+		ProportionalGain:           DefaultProportionalGain,
+		IntegralGain:               DefaultIntegralGain,
+		DerivativeGain:             DefaultDerivativeGain,
+		FeeHistorySize:             DefaultFeeHistorySize,
+		BaseFeeSplit:               DefaultBaseFeeSplit,
+		PriorityFeeSplit:           DefaultPriorityFeeSplit,
+		CosmosFeeSplit:             DefaultCosmosFeeSplit,
 	}
 }
 
 // DefaultParams returns default evm parameters
 func DefaultParams() Params {
 	return Params{
-		NoBaseFee:                DefaultNoBaseFee,
-		BaseFeeChangeDenominator: params.BaseFeeChangeDenominator,
-		ElasticityMultiplier:     params.ElasticityMultiplier,
-		BaseFee:                  math.NewIntFromUint64(params.InitialBaseFee),
-		EnableHeight:             DefaultEnableHeight,
-		MinGasPrice:              DefaultMinGasPrice,
-		MinGasMultiplier:         DefaultMinGasMultiplier,
+		NoBaseFee:                  DefaultNoBaseFee,
+		BaseFeeChangeDenominator:   params.BaseFeeChangeDenominator,
+		ElasticityMultiplier:       params.ElasticityMultiplier,
+		BaseFee:                    math.NewIntFromUint64(params.InitialBaseFee),
+		EnableHeight:               DefaultEnableHeight,
+		MinGasPrice:                DefaultMinGasPrice,
+		MinGasMultiplier:           DefaultMinGasMultiplier,
+		BaseFeeAlgorithm:           DefaultBaseFeeAlgorithm,
+		AimdWindow:                 DefaultAIMDWindow,
+		AimdAdditiveIncrease:       DefaultAIMDAdditiveIncrease,
+		AimdMultiplicativeDecrease: DefaultAIMDMultiplicativeDecrease,
+		UtilizationSmoothing:       DefaultUtilizationSmoothing,
+		ProportionalGain:           DefaultProportionalGain,
+		IntegralGain:               DefaultIntegralGain,
+		DerivativeGain:             DefaultDerivativeGain,
+		FeeHistorySize:             DefaultFeeHistorySize,
+		BaseFeeSplit:               DefaultBaseFeeSplit,
+		PriorityFeeSplit:           DefaultPriorityFeeSplit,
+		CosmosFeeSplit:             DefaultCosmosFeeSplit,
 	}
 }
 
@@ -103,7 +161,184 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if p.FeeHistorySize > MaxFeeHistorySize {
+		return fmt.Errorf("fee history size cannot be greater than %d: %d", MaxFeeHistorySize, p.FeeHistorySize)
+	}
+
+	if err := p.BaseFeeSplit.Validate(); err != nil {
+		return fmt.Errorf("invalid base fee split: %w", err)
+	}
+
+	if err := p.PriorityFeeSplit.Validate(); err != nil {
+		return fmt.Errorf("invalid priority fee split: %w", err)
+	}
+
+	if err := p.CosmosFeeSplit.Validate(); err != nil {
+		return fmt.Errorf("invalid Cosmos fee split: %w", err)
+	}
+
+	if err := validateMessageFeeOverrides(p.MessageFeeOverrides); err != nil {
+		return err
+	}
+
+	return p.validateBaseFeeAlgorithm()
+}
+
+// validateBaseFeeAlgorithm checks that the parameters used by the selected
+// base fee algorithm are set. The parameters of the other algorithms can be
+// left empty.
+func (p Params) validateBaseFeeAlgorithm() error {
+	if !p.AimdAdditiveIncrease.IsNil() && p.AimdAdditiveIncrease.IsNegative() {
+		return fmt.Errorf("AIMD additive increase cannot be negative: %s", p.AimdAdditiveIncrease)
+	}
+
+	if !p.AimdMultiplicativeDecrease.IsNil() {
+		if err := validateFraction(p.AimdMultiplicativeDecrease); err != nil {
+			return fmt.Errorf("invalid AIMD multiplicative decrease: %w", err)
+		}
+	}
+
+	if !p.UtilizationSmoothing.IsNil() {
+		if err := validateFraction(p.UtilizationSmoothing); err != nil {
+			return fmt.Errorf("invalid utilization smoothing: %w", err)
+		}
+	}
+
+	for _, gain := range []math.LegacyDec{p.ProportionalGain, p.IntegralGain, p.DerivativeGain} {
+		if !gain.IsNil() && gain.IsNegative() {
+			return fmt.Errorf("controller gain cannot be negative: %s", gain)
+		}
+	}
+
+	switch p.BaseFeeAlgorithm {
+	case BASE_FEE_ALGORITHM_EIP1559:
+		return nil
+	case BASE_FEE_ALGORITHM_AIMD:
+		if p.ElasticityMultiplier == 0 {
+			return fmt.Errorf("elasticity multiplier cannot be 0")
+		}
+		if p.AimdWindow == 0 || p.AimdWindow > MaxAIMDWindow {
+			return fmt.Errorf("AIMD window must be between 1 and %d: %d", MaxAIMDWindow, p.AimdWindow)
+		}
+		if p.AimdAdditiveIncrease.IsNil() || p.AimdMultiplicativeDecrease.IsNil() {
+			return fmt.Errorf("AIMD additive increase and multiplicative decrease must be set")
+		}
+		if !p.AimdMultiplicativeDecrease.IsPositive() {
+			return fmt.Errorf("AIMD multiplicative decrease must be positive: %s", p.AimdMultiplicativeDecrease)
+		}
+		return nil
+	case BASE_FEE_ALGORITHM_TARGET_UTILIZATION:
+		if p.ElasticityMultiplier == 0 {
+			return fmt.Errorf("elasticity multiplier cannot be 0")
+		}
+		if p.UtilizationSmoothing.IsNil() || !p.UtilizationSmoothing.IsPositive() {
+			return fmt.Errorf("utilization smoothing must be positive")
+		}
+		if p.ProportionalGain.IsNil() || p.IntegralGain.IsNil() || p.DerivativeGain.IsNil() {
+			return fmt.Errorf("controller gains must be set")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid base fee algorithm: %s", p.BaseFeeAlgorithm)
+	}
+}
+
+// NewFeeSplit creates a new FeeSplit instance
+func NewFeeSplit(burn, proposer, communityPool math.LegacyDec) FeeSplit {
+	return FeeSplit{
+		Burn:          burn,
+		Proposer:      proposer,
+		CommunityPool: communityPool,
+	}
+}
+
+// Validate checks that the fractions of the fee split are set, between 0 and 1
+// and that their sum does not exceed 1.
+func (fs FeeSplit) Validate() error {
+	total := math.LegacyZeroDec()
+	for _, fraction := range []math.LegacyDec{fs.Burn, fs.Proposer, fs.CommunityPool} {
+		if fraction.IsNil() {
+			return fmt.Errorf("fee split fractions must be set")
+		}
+		if err := validateFraction(fraction); err != nil {
+			return err
+		}
+		total = total.Add(fraction)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("sum of the fee split fractions cannot be greater than 1: %s", total)
+	}
+	return nil
+}
+
+// MessageFeeRequirements returns the minimum gas price, the gas multiplier and
+// the fixed fee of a transaction containing the given message types. The
+// highest minimum gas price and gas multiplier are used, and the fixed fees of
+// all the messages are added up.
+func (p Params) MessageFeeRequirements(msgTypeURLs []string) (minGasPrice, gasMultiplier math.LegacyDec, fixedFee math.Int) {
+	minGasPrice = p.MinGasPrice
+	gasMultiplier = math.LegacyOneDec()
+	fixedFee = math.ZeroInt()
+
+	for _, msgTypeURL := range msgTypeURLs {
+		for _, override := range p.MessageFeeOverrides {
+			if override.MsgTypeUrl != msgTypeURL {
+				continue
+			}
+
+			minGasPrice = math.LegacyMaxDec(minGasPrice, override.MinGasPrice)
+			gasMultiplier = math.LegacyMaxDec(gasMultiplier, override.GasMultiplier)
+			fixedFee = fixedFee.Add(override.FixedFee)
+		}
+	}
+
+	return minGasPrice, gasMultiplier, fixedFee
+}
+
+// validateMessageFeeOverrides checks that the message fee overrides are set
+// for distinct message types and that their values are valid.
+func validateMessageFeeOverrides(overrides []MessageFeeOverride) error {
+	seen := make(map[string]bool, len(overrides))
+	for _, override := range overrides {
+		if !strings.HasPrefix(override.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid message type URL: %q", override.MsgTypeUrl)
+		}
+		if seen[override.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message fee override: %s", override.MsgTypeUrl)
+		}
+		seen[override.MsgTypeUrl] = true
+
+		if err := validateMinGasPrice(override.MinGasPrice); err != nil {
+			return fmt.Errorf("invalid min gas price of %s: %w", override.MsgTypeUrl, err)
+		}
+		if override.FixedFee.IsNil() || override.FixedFee.IsNegative() {
+			return fmt.Errorf("fixed fee of %s cannot be nil or negative", override.MsgTypeUrl)
+		}
+		if override.GasMultiplier.IsNil() {
+			return fmt.Errorf("gas multiplier of %s cannot be nil", override.MsgTypeUrl)
+		}
+		if !override.GasMultiplier.IsZero() && override.GasMultiplier.LT(math.LegacyOneDec()) {
+			return fmt.Errorf("gas multiplier of %s must be zero or at least 1: %s", override.MsgTypeUrl, override.GasMultiplier)
+		}
+	}
+	return nil
+}
+
+// validateFraction checks that the value is between 0 and 1
+func validateFraction(v math.LegacyDec) error {
+	if v.IsNegative() {
+		return fmt.Errorf("value cannot be negative: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("value cannot be greater than 1: %s", v)
+	}
+	return nil
 }
 
 func validateBool(i interface{}) error {
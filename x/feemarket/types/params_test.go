@@ -57,6 +57,229 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdkmath.LegacyNewDecWithPrec(20, 4), sdkmath.LegacyNewDec(2)),
 			true,
 		},
+		{
+			"valid: fee history disabled",
+			func() Params {
+				p := DefaultParams()
+				p.FeeHistorySize = 0
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: fee history size above maximum",
+			func() Params {
+				p := DefaultParams()
+				p.FeeHistorySize = MaxFeeHistorySize + 1
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: fee split between burning, proposer and community pool",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeSplit = NewFeeSplit(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(3, 1), sdkmath.LegacyNewDecWithPrec(2, 1))
+				p.PriorityFeeSplit = NewFeeSplit(sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyZeroDec())
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: fee split fractions sum bigger than 1",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeSplit = NewFeeSplit(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(1, 1))
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: negative fee split fraction",
+			func() Params {
+				p := DefaultParams()
+				p.PriorityFeeSplit = NewFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyNewDec(-1), sdkmath.LegacyZeroDec())
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: Cosmos fee split fractions sum greater than one",
+			func() Params {
+				p := DefaultParams()
+				p.CosmosFeeSplit = NewFeeSplit(sdkmath.LegacyOneDec(), sdkmath.LegacyNewDecWithPrec(1, 1), sdkmath.LegacyZeroDec())
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: fee split fraction not set",
+			func() Params {
+				p := DefaultParams()
+				p.PriorityFeeSplit = FeeSplit{}
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: AIMD algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: AIMD window is 0",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+				p.AimdWindow = 0
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD window above maximum",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+				p.AimdWindow = MaxAIMDWindow + 1
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD multiplicative decrease bigger than 1",
+			func() Params {
+				p := DefaultParams()
+				p.AimdMultiplicativeDecrease = sdkmath.LegacyNewDec(2)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD multiplicative decrease is 0",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_AIMD
+				p.AimdMultiplicativeDecrease = sdkmath.LegacyZeroDec()
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: AIMD additive increase is negative",
+			func() Params {
+				p := DefaultParams()
+				p.AimdAdditiveIncrease = sdkmath.NewInt(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: target utilization algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_TARGET_UTILIZATION
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: target utilization without smoothing",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_TARGET_UTILIZATION
+				p.UtilizationSmoothing = sdkmath.LegacyZeroDec()
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: target utilization with negative gain",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_TARGET_UTILIZATION
+				p.IntegralGain = sdkmath.LegacyNewDec(-1)
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: target utilization with unset gains",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BASE_FEE_ALGORITHM_TARGET_UTILIZATION
+				p.ProportionalGain = sdkmath.LegacyDec{}
+				return p
+			}(),
+			true,
+		},
+		{
+			"valid: message fee override",
+			func() Params {
+				p := DefaultParams()
+				p.MessageFeeOverrides = []MessageFeeOverride{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: sdkmath.LegacyNewDec(10), FixedFee: sdkmath.NewInt(1000), GasMultiplier: sdkmath.LegacyNewDec(2)},
+				}
+				return p
+			}(),
+			false,
+		},
+		{
+			"invalid: message fee override with invalid type URL",
+			func() Params {
+				p := DefaultParams()
+				p.MessageFeeOverrides = []MessageFeeOverride{
+					{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", MinGasPrice: sdkmath.LegacyZeroDec(), FixedFee: sdkmath.ZeroInt(), GasMultiplier: sdkmath.LegacyZeroDec()},
+				}
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: duplicate message fee override",
+			func() Params {
+				p := DefaultParams()
+				override := MessageFeeOverride{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: sdkmath.LegacyZeroDec(), FixedFee: sdkmath.ZeroInt(), GasMultiplier: sdkmath.LegacyZeroDec()}
+				p.MessageFeeOverrides = []MessageFeeOverride{override, override}
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: message fee override with negative fixed fee",
+			func() Params {
+				p := DefaultParams()
+				p.MessageFeeOverrides = []MessageFeeOverride{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: sdkmath.LegacyZeroDec(), FixedFee: sdkmath.NewInt(-1), GasMultiplier: sdkmath.LegacyZeroDec()},
+				}
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: message fee override with gas multiplier smaller than 1",
+			func() Params {
+				p := DefaultParams()
+				p.MessageFeeOverrides = []MessageFeeOverride{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: sdkmath.LegacyZeroDec(), FixedFee: sdkmath.ZeroInt(), GasMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1)},
+				}
+				return p
+			}(),
+			true,
+		},
+		{
+			"invalid: unknown base fee algorithm",
+			func() Params {
+				p := DefaultParams()
+				p.BaseFeeAlgorithm = BaseFeeAlgorithm(3)
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -70,6 +293,40 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestMessageFeeRequirements() {
+	params := DefaultParams()
+	params.MinGasPrice = sdkmath.LegacyNewDec(10)
+	params.MessageFeeOverrides = []MessageFeeOverride{
+		{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", MinGasPrice: sdkmath.LegacyNewDec(20), FixedFee: sdkmath.NewInt(1000), GasMultiplier: sdkmath.LegacyZeroDec()},
+		{MsgTypeUrl: "/cosmos.gov.v1.MsgSubmitProposal", MinGasPrice: sdkmath.LegacyNewDec(5), FixedFee: sdkmath.ZeroInt(), GasMultiplier: sdkmath.LegacyNewDec(3)},
+	}
+
+	testCases := []struct {
+		name             string
+		msgTypeURLs      []string
+		expMinGasPrice   sdkmath.LegacyDec
+		expGasMultiplier sdkmath.LegacyDec
+		expFixedFee      sdkmath.Int
+	}{
+		{"no messages", nil, sdkmath.LegacyNewDec(10), sdkmath.LegacyOneDec(), sdkmath.ZeroInt()},
+		{"no override", []string{"/cosmos.staking.v1beta1.MsgDelegate"}, sdkmath.LegacyNewDec(10), sdkmath.LegacyOneDec(), sdkmath.ZeroInt()},
+		{"higher min gas price", []string{"/cosmos.bank.v1beta1.MsgSend"}, sdkmath.LegacyNewDec(20), sdkmath.LegacyOneDec(), sdkmath.NewInt(1000)},
+		{"lower min gas price", []string{"/cosmos.gov.v1.MsgSubmitProposal"}, sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(3), sdkmath.ZeroInt()},
+		{
+			"multiple messages",
+			[]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1.MsgSubmitProposal", "/cosmos.bank.v1beta1.MsgSend"},
+			sdkmath.LegacyNewDec(20), sdkmath.LegacyNewDec(3), sdkmath.NewInt(2000),
+		},
+	}
+
+	for _, tc := range testCases {
+		minGasPrice, gasMultiplier, fixedFee := params.MessageFeeRequirements(tc.msgTypeURLs)
+		suite.Require().Equal(tc.expMinGasPrice, minGasPrice, tc.name)
+		suite.Require().Equal(tc.expGasMultiplier, gasMultiplier, tc.name)
+		suite.Require().Equal(tc.expFixedFee, fixedFee, tc.name)
+	}
+}
+
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(2))
 	suite.Require().NoError(validateBool(true))
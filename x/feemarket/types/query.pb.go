@@ -275,6 +275,109 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBlockFeeHistoryRequest defines the request type for querying the fee
+// history over a range of block heights.
+type QueryBlockFeeHistoryRequest struct {
+	// start_height is the first block height of the range
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height of the range, inclusive
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryBlockFeeHistoryRequest) Reset()         { *m = QueryBlockFeeHistoryRequest{} }
+func (m *QueryBlockFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBlockFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBlockFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBlockFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBlockFeeHistoryRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryBlockFeeHistoryRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryBlockFeeHistoryResponse returns the fee history of the blocks in the
+// requested range that are kept in the store.
+type QueryBlockFeeHistoryResponse struct {
+	// history is the fee history ordered by ascending block height
+	History []BlockFeeHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryBlockFeeHistoryResponse) Reset()         { *m = QueryBlockFeeHistoryResponse{} }
+func (m *QueryBlockFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBlockFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBlockFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBlockFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBlockFeeHistoryResponse) GetHistory() []BlockFeeHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -282,6 +385,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBlockFeeHistoryRequest)(nil), "ethermint.feemarket.v1.QueryBlockFeeHistoryRequest")
+	proto.RegisterType((*QueryBlockFeeHistoryResponse)(nil), "ethermint.feemarket.v1.QueryBlockFeeHistoryResponse")
 }
 
 func init() {
@@ -289,36 +394,43 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x4c, 0xdb, 0xf1, 0xa2, 0x63, 0x52, 0x74, 0x89, 0x1b, 0x59, 0x54, 0xb4, 0xd6,
-	0x19, 0x1b, 0xfd, 0x03, 0xe6, 0x50, 0x11, 0x3c, 0x68, 0x4e, 0xe2, 0xa5, 0x4c, 0xe2, 0xeb, 0x66,
-	0x49, 0x67, 0xdf, 0x76, 0x67, 0x12, 0xec, 0x55, 0xf0, 0xe2, 0x41, 0x04, 0xff, 0x84, 0x17, 0xc1,
-	0x9f, 0xd1, 0x63, 0xc1, 0x8b, 0x78, 0x28, 0x92, 0x08, 0xfe, 0x0d, 0xc9, 0xcc, 0x6c, 0x74, 0x35,
-	0xd1, 0x5c, 0x86, 0xc7, 0xdb, 0xef, 0x7d, 0xdf, 0xf7, 0xbe, 0xc7, 0xd2, 0x08, 0xcc, 0x00, 0x72,
-	0x95, 0xa4, 0x46, 0xec, 0x03, 0x28, 0x99, 0x0f, 0xc1, 0x88, 0xf1, 0x8e, 0x38, 0x1c, 0x41, 0x7e,
-	0xc4, 0xb3, 0x1c, 0x0d, 0xb2, 0xcd, 0x39, 0x86, 0xcf, 0x31, 0x7c, 0xbc, 0x13, 0x5c, 0x90, 0x2a,
-	0x49, 0x51, 0xd8, 0xd7, 0x41, 0x83, 0x1b, 0x4b, 0xe8, 0x7e, 0xcd, 0x39, 0x5c, 0x3d, 0xc6, 0x18,
-	0x6d, 0x29, 0x66, 0x95, 0xef, 0x36, 0x63, 0xc4, 0xf8, 0x00, 0x84, 0xcc, 0x12, 0x21, 0xd3, 0x14,
-	0x8d, 0x34, 0x09, 0xa6, 0xda, 0x7d, 0x8d, 0xea, 0x94, 0x3d, 0x9d, 0xb9, 0x7a, 0x22, 0x73, 0xa9,
-	0x74, 0x17, 0x0e, 0x47, 0xa0, 0x4d, 0xf4, 0x8c, 0x5e, 0x2c, 0x75, 0x75, 0x86, 0xa9, 0x06, 0xf6,
-	0x80, 0xd6, 0x32, 0xdb, 0xb9, 0x44, 0xae, 0x92, 0x9b, 0xe7, 0xda, 0x21, 0x5f, 0xbc, 0x04, 0x77,
-	0x73, 0x9d, 0x8d, 0xe3, 0xd3, 0x56, 0xe5, 0xc3, 0x8f, 0x4f, 0x5b, 0xa4, 0xeb, 0x07, 0xa3, 0x86,
-	0x67, 0xee, 0x48, 0x0d, 0xbb, 0x00, 0x85, 0xe0, 0x63, 0x5a, 0x2f, 0xb7, 0xbd, 0xe2, 0x7d, 0xba,
-	0xde, 0x93, 0x1a, 0xf6, 0xf6, 0x01, 0xac, 0xe6, 0x46, 0xe7, 0xf2, 0xd7, 0xd3, 0x56, 0xa3, 0x8f,
-	0x5a, 0xa1, 0xd6, 0x2f, 0x86, 0x3c, 0x41, 0xa1, 0xa4, 0x19, 0xf0, 0x47, 0xa9, 0xe9, 0xae, 0xf5,
-	0xdc, 0x74, 0xb4, 0x59, 0xb0, 0x1d, 0x60, 0x7f, 0xf8, 0x50, 0xce, 0xd7, 0xba, 0x45, 0x1b, 0x7f,
-	0xf4, 0xbd, 0xcc, 0x79, 0x5a, 0x8d, 0xa5, 0xdb, 0xaa, 0xda, 0x9d, 0x95, 0xed, 0x8f, 0x55, 0x7a,
-	0xd6, 0x62, 0xd9, 0x6b, 0x42, 0x6b, 0x6e, 0x1f, 0xb6, 0xb5, 0x6c, 0xdf, 0xbf, 0x23, 0x0c, 0x6e,
-	0xaf, 0x84, 0x75, 0xfa, 0x51, 0xf4, 0xea, 0xf3, 0xf7, 0xf7, 0x67, 0x9a, 0x2c, 0x10, 0x30, 0x56,
-	0xa8, 0xcb, 0x67, 0x76, 0xc9, 0xb1, 0x37, 0x84, 0xae, 0xf9, 0x78, 0xd8, 0xbf, 0xc9, 0xcb, 0xd9,
-	0x06, 0xdb, 0xab, 0x81, 0xbd, 0x95, 0x6b, 0xd6, 0x4a, 0xc8, 0x9a, 0x8b, 0xac, 0x14, 0xb7, 0x60,
-	0x6f, 0x09, 0x5d, 0x2f, 0x52, 0x64, 0xff, 0x11, 0x28, 0x1f, 0x21, 0xb8, 0xb3, 0x22, 0xda, 0xfb,
-	0xb9, 0x6e, 0xfd, 0xb4, 0xd8, 0x95, 0x85, 0x7e, 0x66, 0xe8, 0xbd, 0x58, 0xea, 0xce, 0xee, 0xf1,
-	0x24, 0x24, 0x27, 0x93, 0x90, 0x7c, 0x9b, 0x84, 0xe4, 0xdd, 0x34, 0xac, 0x9c, 0x4c, 0xc3, 0xca,
-	0x97, 0x69, 0x58, 0x79, 0xbe, 0x1d, 0x27, 0x66, 0x30, 0xea, 0xf1, 0x3e, 0x2a, 0x4f, 0xe1, 0xde,
-	0x71, 0xfb, 0xae, 0x78, 0xf9, 0x1b, 0x9d, 0x39, 0xca, 0x40, 0xf7, 0x6a, 0xf6, 0xb7, 0xb8, 0xf7,
-	0x33, 0x00, 0x00, 0xff, 0xff, 0x90, 0x0a, 0xbc, 0xd6, 0xc3, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0x12, 0x41,
+	0x18, 0x65, 0x5a, 0x2d, 0x65, 0x30, 0x51, 0x47, 0x68, 0xea, 0x4a, 0x97, 0xba, 0xb1, 0xb6, 0xd6,
+	0x76, 0xd7, 0xd2, 0xfe, 0x01, 0x39, 0x60, 0x4d, 0x7a, 0x50, 0x4e, 0xc6, 0x0b, 0x19, 0xe0, 0x63,
+	0x77, 0x03, 0xbb, 0x43, 0x77, 0x06, 0x22, 0x57, 0x13, 0x2f, 0x1e, 0x8c, 0x89, 0x7f, 0xc2, 0xc4,
+	0x8b, 0x3f, 0xa3, 0xc7, 0x26, 0x5e, 0x8c, 0x26, 0x8d, 0x01, 0x13, 0xff, 0x86, 0x61, 0x66, 0x96,
+	0x16, 0x0a, 0x16, 0x2f, 0x9b, 0xc9, 0x9b, 0xf7, 0xbd, 0xf7, 0x66, 0xe6, 0x65, 0xb1, 0x05, 0xc2,
+	0x83, 0x28, 0xf0, 0x43, 0xe1, 0x34, 0x00, 0x02, 0x1a, 0x35, 0x41, 0x38, 0xdd, 0x3d, 0xe7, 0xb8,
+	0x03, 0x51, 0xcf, 0x6e, 0x47, 0x4c, 0x30, 0xb2, 0x32, 0xe2, 0xd8, 0x23, 0x8e, 0xdd, 0xdd, 0x33,
+	0x6e, 0xd3, 0xc0, 0x0f, 0x99, 0x23, 0xbf, 0x8a, 0x6a, 0x3c, 0x9c, 0x21, 0x77, 0x3e, 0xa7, 0x78,
+	0x19, 0x97, 0xb9, 0x4c, 0x2e, 0x9d, 0xe1, 0x4a, 0xa3, 0x39, 0x97, 0x31, 0xb7, 0x05, 0x0e, 0x6d,
+	0xfb, 0x0e, 0x0d, 0x43, 0x26, 0xa8, 0xf0, 0x59, 0xc8, 0xd5, 0xae, 0x95, 0xc1, 0xe4, 0xe5, 0x30,
+	0xd5, 0x0b, 0x1a, 0xd1, 0x80, 0x97, 0xe1, 0xb8, 0x03, 0x5c, 0x58, 0xaf, 0xf0, 0x9d, 0x31, 0x94,
+	0xb7, 0x59, 0xc8, 0x81, 0x3c, 0xc5, 0x4b, 0x6d, 0x89, 0xac, 0xa2, 0x75, 0xb4, 0x95, 0x2e, 0x98,
+	0xf6, 0xf4, 0x43, 0xd8, 0x6a, 0xae, 0x98, 0x3a, 0x39, 0xcb, 0x27, 0x3e, 0xff, 0xf9, 0xba, 0x8d,
+	0xca, 0x7a, 0xd0, 0xca, 0x6a, 0xe5, 0x22, 0xe5, 0x50, 0x02, 0x88, 0x0d, 0x8f, 0x70, 0x66, 0x1c,
+	0xd6, 0x8e, 0x07, 0x78, 0xb9, 0x4a, 0x39, 0x54, 0x1a, 0x00, 0xd2, 0x33, 0x55, 0xbc, 0xfb, 0xe3,
+	0x2c, 0x9f, 0xad, 0x31, 0x1e, 0x30, 0xce, 0xeb, 0x4d, 0xdb, 0x67, 0x4e, 0x40, 0x85, 0x67, 0x3f,
+	0x0f, 0x45, 0x39, 0x59, 0x55, 0xd3, 0xd6, 0x4a, 0xac, 0xd6, 0x62, 0xb5, 0xe6, 0x33, 0x3a, 0x3a,
+	0xd6, 0x23, 0x9c, 0x9d, 0xc0, 0xb5, 0xcd, 0x2d, 0xbc, 0xe8, 0x52, 0x75, 0xaa, 0xc5, 0xf2, 0x70,
+	0x69, 0x55, 0xf0, 0xbd, 0x73, 0x6a, 0x09, 0xe0, 0xd0, 0xe7, 0x82, 0x45, 0x3d, 0xad, 0x44, 0xee,
+	0xe3, 0x1b, 0x5c, 0xd0, 0x48, 0x54, 0x3c, 0xf0, 0x5d, 0x4f, 0xe8, 0xc9, 0xb4, 0xc4, 0x0e, 0x25,
+	0x44, 0xd6, 0x30, 0x86, 0xb0, 0x1e, 0x13, 0x16, 0x24, 0x21, 0x05, 0x61, 0x5d, 0x6d, 0x5b, 0x2d,
+	0x9c, 0x9b, 0x6e, 0xa0, 0x23, 0x1d, 0xe1, 0xa4, 0xa7, 0xa0, 0x55, 0xb4, 0xbe, 0xb8, 0x95, 0x2e,
+	0x6c, 0xce, 0xba, 0xec, 0x09, 0x85, 0x8b, 0xb7, 0x1e, 0x4b, 0x14, 0x7e, 0x5e, 0xc3, 0xd7, 0xa5,
+	0x1d, 0x79, 0x87, 0xf0, 0x92, 0x7a, 0x1e, 0xb2, 0x3d, 0x4b, 0xf1, 0x72, 0x23, 0x8c, 0xc7, 0x73,
+	0x71, 0x55, 0x76, 0xcb, 0x7a, 0xfb, 0xed, 0xf7, 0xa7, 0x85, 0x1c, 0x31, 0x1c, 0xe8, 0x06, 0x8c,
+	0x8f, 0xb7, 0x56, 0x15, 0x81, 0xbc, 0x47, 0x38, 0xa9, 0x5f, 0x9b, 0xfc, 0x5b, 0x7c, 0xbc, 0x2a,
+	0xc6, 0xce, 0x7c, 0x64, 0x1d, 0xe5, 0x81, 0x8c, 0x62, 0x92, 0xdc, 0xb4, 0x28, 0x71, 0xb5, 0xc8,
+	0x07, 0x84, 0x97, 0xe3, 0x52, 0x90, 0x2b, 0x0c, 0xc6, 0x3b, 0x65, 0xec, 0xce, 0xc9, 0xd6, 0x79,
+	0x36, 0x64, 0x9e, 0x3c, 0x59, 0x9b, 0x9a, 0x67, 0xc8, 0xae, 0xb8, 0x94, 0x93, 0x2f, 0x08, 0xdf,
+	0x9c, 0x78, 0x57, 0xb2, 0x7f, 0xb5, 0xd3, 0xa5, 0xa2, 0x1a, 0x07, 0xff, 0x37, 0xa4, 0x53, 0xee,
+	0xca, 0x94, 0x9b, 0x64, 0x63, 0x76, 0xca, 0x06, 0x40, 0x45, 0xb7, 0xab, 0x58, 0x3a, 0xe9, 0x9b,
+	0xe8, 0xb4, 0x6f, 0xa2, 0x5f, 0x7d, 0x13, 0x7d, 0x1c, 0x98, 0x89, 0xd3, 0x81, 0x99, 0xf8, 0x3e,
+	0x30, 0x13, 0xaf, 0x77, 0x5c, 0x5f, 0x78, 0x9d, 0xaa, 0x5d, 0x63, 0x81, 0x96, 0x52, 0xdf, 0x6e,
+	0xe1, 0x89, 0xf3, 0xe6, 0x82, 0xac, 0xe8, 0xb5, 0x81, 0x57, 0x97, 0xe4, 0x3f, 0x69, 0xff, 0xef,
+	0x00, 0x56, 0x3e, 0xd3, 0x14, 0x40, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +451,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BlockFeeHistory queries the base fee and block gas kept in the fee history
+	// over a range of block heights
+	BlockFeeHistory(ctx context.Context, in *QueryBlockFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBlockFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +491,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BlockFeeHistory(ctx context.Context, in *QueryBlockFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBlockFeeHistoryResponse, error) {
+	out := new(QueryBlockFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BlockFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +508,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BlockFeeHistory queries the base fee and block gas kept in the fee history
+	// over a range of block heights
+	BlockFeeHistory(context.Context, *QueryBlockFeeHistoryRequest) (*QueryBlockFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +526,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BlockFeeHistory(ctx context.Context, req *QueryBlockFeeHistoryRequest) (*QueryBlockFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockFeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +588,25 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BlockFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockFeeHistory(ctx, req.(*QueryBlockFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +623,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BlockFeeHistory",
+			Handler:    _Query_BlockFeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -644,6 +797,76 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlockFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -718,6 +941,36 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlockFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryBlockFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1112,6 +1365,178 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlockFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, BlockFeeHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
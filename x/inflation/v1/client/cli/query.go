@@ -6,11 +6,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
 
@@ -30,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		GetSkippedEpochs(),
 		GetCirculatingSupply(),
 		GetInflationRate(),
+		GetInflationProjection(),
 		GetParams(),
 	)
 
@@ -184,6 +188,81 @@ func GetInflationRate() *cobra.Command {
 	return cmd
 }
 
+// Flags for the inflation projection query
+const (
+	FlagBondedRatio = "bonded-ratio"
+	FlagParamsFile  = "params-file"
+)
+
+// GetInflationProjection implements a command to return the projected mint
+// provisions and supply of the next periods.
+func GetInflationProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection PERIODS",
+		Short: "Query the projected mint provisions and supply of the next periods",
+		Long: `Query the projected mint provisions, total supply and circulating supply of the next periods, starting with the current one.
+The current bonded ratio and params are used unless a bonded ratio or a JSON file with hypothetical params is given.`,
+		Example: fmt.Sprintf(
+			"%s query %s projection 10 --bonded-ratio 0.6 --params-file params.json",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			periods, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number of periods: %w", err)
+			}
+
+			bondedRatio, err := cmd.Flags().GetString(FlagBondedRatio)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInflationProjectionRequest{
+				Periods:     periods,
+				BondedRatio: bondedRatio,
+			}
+
+			paramsFile, err := cmd.Flags().GetString(FlagParamsFile)
+			if err != nil {
+				return err
+			}
+			if paramsFile != "" {
+				bz, err := os.ReadFile(paramsFile)
+				if err != nil {
+					return err
+				}
+
+				var params types.Params
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+					return fmt.Errorf("failed to parse params file: %w", err)
+				}
+				req.Params = &params
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InflationProjection(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagBondedRatio, "", "Bonded ratio assumed for the projection (defaults to the current one)")
+	cmd.Flags().String(FlagParamsFile, "", "JSON file with hypothetical inflation params (defaults to the current ones)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetParams implements a command to return the current inflation
 // parameters.
 func GetParams() *cobra.Command {
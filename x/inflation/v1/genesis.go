@@ -25,6 +25,10 @@ func InitGenesis(
 
 	// Set genesis state
 	params := data.Params
+	if err := k.ValidateRecipients(params.InflationDistribution); err != nil {
+		panic(errorsmod.Wrapf(err, "invalid inflation distribution"))
+	}
+
 	err := k.SetParams(ctx, params)
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "error setting params"))
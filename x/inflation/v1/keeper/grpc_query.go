@@ -6,8 +6,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -73,6 +76,55 @@ func (k Keeper) CirculatingSupply(
 	return &types.QueryCirculatingSupplyResponse{CirculatingSupply: coin}, nil
 }
 
+// InflationProjection returns the projected mint provisions and supply of the
+// next periods with the current or the requested params.
+func (k Keeper) InflationProjection(
+	c context.Context,
+	req *types.QueryInflationProjectionRequest,
+) (*types.QueryInflationProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Periods == 0 || req.Periods > MaxProjectionPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "periods must be between 1 and %d", MaxProjectionPeriods)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params := k.GetParams(ctx)
+	if req.Params != nil {
+		if err := req.Params.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid params: %s", err)
+		}
+		params = *req.Params
+	}
+
+	var (
+		bondedRatio math.LegacyDec
+		err         error
+	)
+	if req.BondedRatio == "" {
+		bondedRatio, err = k.BondedRatio(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		bondedRatio, err = math.LegacyNewDecFromStr(req.BondedRatio)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bonded ratio: %s", err)
+		}
+		if bondedRatio.IsNegative() || bondedRatio.GT(math.LegacyOneDec()) {
+			return nil, status.Errorf(codes.InvalidArgument, "bonded ratio must be between 0 and 1: %s", bondedRatio)
+		}
+	}
+
+	return &types.QueryInflationProjectionResponse{
+		Projections: k.ProjectInflation(ctx, params, req.Periods, bondedRatio),
+		BondedRatio: bondedRatio,
+	}, nil
+}
+
 // Params returns params of the mint module.
 func (k Keeper) Params(
 	c context.Context,
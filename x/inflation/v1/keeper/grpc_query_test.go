@@ -91,6 +91,7 @@ func TestEpochMintProvision(t *testing.T) {
 					uint64(0),
 					365,
 					bondedRatio,
+					math.LegacyNewDecFromInt(nw.App.BankKeeper.GetSupply(ctx, params.MintDenom).Amount),
 				)
 				expEpochMintProvision := defaultEpochMintProvision.Quo(math.LegacyNewDec(types.ReductionFactor))
 				req = &types.QueryEpochMintProvisionRequest{}
@@ -187,7 +188,7 @@ func TestQueryCirculatingSupply(t *testing.T) {
 
 	keyring := testkeyring.New(int(nAccs))
 
-	// Foundation wallets are not considered in the circulating supply.
+	// Foundation wallets are part of the circulating supply.
 	foundationAcc := []sdk.AccAddress{
 		utils.EthHexToCosmosAddr(types.FoundationWallets[0]),
 		utils.EthHexToCosmosAddr(types.FoundationWallets[1]),
@@ -206,15 +207,14 @@ func TestQueryCirculatingSupply(t *testing.T) {
 	err := nw.App.InflationKeeper.MintCoins(ctx, mintCoin)
 	require.NoError(t, err)
 
-	// Expected circulating supply is composed only of the minted tokens plus
-	// pre-funded accounts balances except foundation wallets.
-	// Foundation wallets are removed in the computation, that's why we multiply
-	// by 4 (minted coins) + number of EOA and we don't add the number of
+	// Expected circulating supply is composed of the minted tokens plus the
+	// pre-funded accounts balances, including the foundation wallets. That's
+	// why we multiply by 4 (minted coins) + number of EOA + number of
 	// foundation accounts.
 	//
 	// NOTE: wallets associated with nAccs have part of the balance delegated
 	// but it is all considered in one place for simplicity.
-	expCirculatingSupply := sdk.NewDecCoin(mintDenom, prefundedAccBalance.MulRaw(4+nAccs))
+	expCirculatingSupply := sdk.NewDecCoin(mintDenom, prefundedAccBalance.MulRaw(4+nAccs+int64(len(foundationAcc))))
 
 	// The total bonded tokens for the 4 accounts initialized on the setup (3
 	// validators, 1 EOA).
@@ -280,3 +280,125 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expParams, res.Params)
 }
+
+func TestInflationProjection(t *testing.T) {
+	var (
+		ctx sdk.Context
+		nw  *network.UnitTestNetwork
+		req *types.QueryInflationProjectionRequest
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		postCheck func(res *types.QueryInflationProjectionResponse)
+	}{
+		{
+			"fail - zero periods",
+			func() {
+				req = &types.QueryInflationProjectionRequest{}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - too many periods",
+			func() {
+				req = &types.QueryInflationProjectionRequest{Periods: 101}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - invalid bonded ratio",
+			func() {
+				req = &types.QueryInflationProjectionRequest{Periods: 1, BondedRatio: "1.5"}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - invalid params",
+			func() {
+				params := types.DefaultParams()
+				params.InflationCurve = types.INFLATION_CURVE_SUPPLY_CAPPED
+				req = &types.QueryInflationProjectionRequest{Periods: 1, Params: &params}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - current params",
+			func() {
+				nw.App.InflationKeeper.SetPeriod(ctx, 2)
+				req = &types.QueryInflationProjectionRequest{Periods: 3, BondedRatio: "0.5"}
+			},
+			true,
+			func(res *types.QueryInflationProjectionResponse) {
+				require.Equal(t, math.LegacyNewDecWithPrec(5, 1), res.BondedRatio)
+				require.Len(t, res.Projections, 3)
+
+				params := nw.App.InflationKeeper.GetParams(ctx)
+				supply := nw.App.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+				expProvision := types.CalculateEpochMintProvision(
+					params,
+					2,
+					nw.App.InflationKeeper.GetEpochsPerPeriod(ctx),
+					res.BondedRatio,
+					math.LegacyNewDecFromInt(supply),
+				).TruncateDec()
+
+				prevSupply := math.LegacyNewDecFromInt(supply)
+				for i, projection := range res.Projections {
+					require.Equal(t, uint64(2+i), projection.Period)
+					require.True(t, projection.PeriodMintProvision.Amount.IsPositive())
+					require.Equal(t, prevSupply.Add(projection.PeriodMintProvision.Amount), projection.TotalSupply.Amount)
+					prevSupply = projection.TotalSupply.Amount
+				}
+				require.Equal(t, expProvision, res.Projections[0].EpochMintProvision.Amount)
+				// the exponential curve decays on each period
+				require.True(t, res.Projections[1].EpochMintProvision.Amount.LT(res.Projections[0].EpochMintProvision.Amount))
+			},
+		},
+		{
+			"pass - hypothetical params",
+			func() {
+				params := types.DefaultParams()
+				params.InflationCurve = types.INFLATION_CURVE_FIXED_RATE
+				params.FixedRate = math.LegacyZeroDec()
+				req = &types.QueryInflationProjectionRequest{Periods: 2, Params: &params}
+			},
+			true,
+			func(res *types.QueryInflationProjectionResponse) {
+				supply := nw.App.BankKeeper.GetSupply(ctx, types.DefaultInflationDenom).Amount
+				circulatingSupply := nw.App.InflationKeeper.GetCirculatingSupply(ctx, types.DefaultInflationDenom)
+
+				require.Len(t, res.Projections, 2)
+				for _, projection := range res.Projections {
+					require.True(t, projection.PeriodMintProvision.Amount.IsZero())
+					require.Equal(t, math.LegacyNewDecFromInt(supply), projection.TotalSupply.Amount)
+					require.Equal(t, circulatingSupply, projection.CirculatingSupply.Amount)
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			// reset
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			qc := nw.GetInflationClient()
+
+			tc.malleate()
+
+			res, err := qc.InflationProjection(ctx, req)
+			if tc.expPass {
+				require.NoError(t, err)
+				tc.postCheck(res)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-metrics"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
@@ -18,7 +19,12 @@ import (
 func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
 }
 
-// AfterEpochEnd mints and allocates coins at the end of each epoch end
+// AfterEpochEnd mints and allocates coins at the end of each epoch end.
+//
+// The inflation hooks are not wrapped with the epochs identifier hooks, as they
+// handle both the day epoch, which counts the skipped epochs, and the mint
+// epoch, whose identifier is stored in the module and can differ from the day
+// epoch. The epoch identifier is therefore filtered here.
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	params := k.GetParams(ctx)
 	skippedEpochs := k.GetSkippedEpochs(ctx)
@@ -55,11 +61,14 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		panic(err)
 	}
 
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
 	epochMintProvision := types.CalculateEpochMintProvision(
 		params,
 		period,
 		epochsPerPeriod,
 		bondedRatio,
+		math.LegacyNewDecFromInt(totalSupply),
 	)
 
 	if !epochMintProvision.IsPositive() {
@@ -75,10 +84,22 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		Amount: epochMintProvision.TruncateInt(),
 	}
 
-	staking, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin, params)
+	// Minting and allocating the inflation must not halt the chain, e.g. if a
+	// recipient cannot receive funds. The epoch is skipped in that case.
+	cacheCtx, writeCache := ctx.CacheContext()
+	allocations, err := k.MintAndAllocateInflation(cacheCtx, mintedCoin, params)
 	if err != nil {
-		panic(err)
+		skippedEpochs++
+		k.SetSkippedEpochs(ctx, skippedEpochs)
+		k.Logger(ctx).Error(
+			"SKIPPING INFLATION: failed to mint and allocate inflation",
+			"epoch-number", epochNumber,
+			"skipped-epochs", skippedEpochs,
+			"error", err.Error(),
+		)
+		return
 	}
+	writeCache()
 
 	// If period is passed, update the period. A period is
 	// passed if the current epoch number surpasses the epochsPerPeriod for the
@@ -97,9 +118,6 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	}
 
 	defer func() {
-		stakingAmt := staking.AmountOfNoDenomValidation(mintedCoin.Denom)
-		cpAmt := communityPool.AmountOfNoDenomValidation(mintedCoin.Denom)
-
 		if mintedCoin.Amount.IsInt64() && mintedCoin.Amount.IsPositive() {
 			telemetry.IncrCounterWithLabels(
 				[]string{types.ModuleName, "allocate", "total"},
@@ -107,19 +125,17 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
 			)
 		}
-		if stakingAmt.IsInt64() && stakingAmt.IsPositive() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "staking", "total"},
-				float32(stakingAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if cpAmt.IsInt64() && cpAmt.IsPositive() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "community_pool", "total"},
-				float32(cpAmt.Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
+		for i, allocation := range allocations {
+			if allocation.Amount.IsInt64() && allocation.Amount.IsPositive() {
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, "allocate", "recipient", "total"},
+					float32(allocation.Amount.Int64()),
+					[]metrics.Label{
+						telemetry.NewLabel("denom", mintedCoin.Denom),
+						telemetry.NewLabel("recipient", params.InflationDistribution.Recipients[i].Recipient),
+					},
+				)
+			}
 		}
 	}()
 
@@ -140,7 +156,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks           = Hooks{}
+	_ epochstypes.EpochIdentifierHooks = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -155,3 +174,10 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// EpochIdentifiers returns the identifiers of the epochs the inflation
+// depends on: the epoch that mints the inflation and the day epoch that counts
+// the skipped epochs
+func (h Hooks) EpochIdentifiers(ctx sdk.Context) []string {
+	return []string{h.k.GetEpochIdentifier(ctx), epochstypes.DayEpochID}
+}
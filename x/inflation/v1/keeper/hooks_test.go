@@ -8,6 +8,8 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
@@ -70,6 +72,36 @@ func TestEpochIdentifierAfterEpochEnd(t *testing.T) {
 	}
 }
 
+func TestAfterEpochEndAllocationFailure(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	// the recipients are not validated when setting the params directly, so
+	// the allocation to the blocked address fails
+	params := nw.App.InflationKeeper.GetParams(ctx)
+	params.EnableInflation = true
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:  math.LegacyZeroDec(),
+		CommunityPool:   math.LegacyZeroDec(),
+		UsageIncentives: math.LegacyZeroDec(),
+		Recipients: []types.InflationRecipient{
+			types.NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(5, 1)),
+			types.NewInflationRecipient(nw.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String(), math.LegacyNewDecWithPrec(5, 1)),
+		},
+	}
+	require.NoError(t, nw.App.InflationKeeper.SetParams(ctx, params))
+
+	prevSupply := nw.App.BankKeeper.GetSupply(ctx, denomMint)
+	skippedEpochs := nw.App.InflationKeeper.GetSkippedEpochs(ctx)
+
+	// the epoch is skipped without halting the chain
+	require.NotPanics(t, func() {
+		nw.App.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	})
+	require.Equal(t, prevSupply, nw.App.BankKeeper.GetSupply(ctx, denomMint))
+	require.Equal(t, skippedEpochs+1, nw.App.InflationKeeper.GetSkippedEpochs(ctx))
+}
+
 func TestPeriodChangesSkippedEpochsAfterEpochEnd(t *testing.T) {
 	nw := network.NewUnitTestNetwork()
 	ctx := nw.GetContext()
@@ -229,11 +261,13 @@ func TestPeriodChangesSkippedEpochsAfterEpochEnd(t *testing.T) {
 
 			if tc.periodChanges {
 				newProvision := nw.App.InflationKeeper.GetEpochMintProvision(ctx)
+				params := nw.App.InflationKeeper.GetParams(ctx)
 				expectedProvision := types.CalculateEpochMintProvision(
-					nw.App.InflationKeeper.GetParams(ctx),
+					params,
 					period,
 					currentEpochPerPeriod,
 					bondedRatio,
+					math.LegacyNewDecFromInt(nw.App.BankKeeper.GetSupply(ctx, params.MintDenom).Amount),
 				).Quo(math.LegacyNewDec(types.ReductionFactor))
 				require.Equal(t, expectedProvision, newProvision)
 				// mint provisions will change
@@ -4,35 +4,55 @@
 package keeper
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	utils "github.com/evmos/evmos/v20/utils"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
 )
 
-// MintAndAllocateInflation performs inflation minting and allocation
+// MintAndAllocateInflation performs inflation minting and allocation. It
+// returns the coins allocated to each of the distribution recipients.
 func (k Keeper) MintAndAllocateInflation(
 	ctx sdk.Context,
 	coin sdk.Coin,
 	params types.Params,
 ) (
-	staking, communityPool sdk.Coins,
+	allocations []sdk.Coin,
 	err error,
 ) {
 	// skip as no coins need to be minted
 	if coin.Amount.IsNil() || !coin.Amount.IsPositive() {
-		return nil, nil, nil
+		return nil, nil
+	}
+
+	allocations = k.GetAllocations(ctx, coin, params.InflationDistribution)
+
+	// The share of the burn recipients is not minted
+	minted := coin
+	for i, recipient := range params.InflationDistribution.Recipients {
+		if recipient.IsBurn() {
+			minted = minted.Sub(allocations[i])
+		}
 	}
 
 	// Mint coins for distribution
-	if err := k.MintCoins(ctx, coin); err != nil {
-		return nil, nil, err
+	if minted.IsPositive() {
+		if err := k.MintCoins(ctx, minted); err != nil {
+			return nil, err
+		}
 	}
 
-	// Allocate minted coins according to allocation proportions (staking, usage
-	// incentives, community pool)
-	return k.AllocateExponentialInflation(ctx, coin, params)
+	// Allocate minted coins according to the distribution recipients
+	if err := k.AllocateInflation(ctx, allocations, params); err != nil {
+		return nil, err
+	}
+
+	return allocations, nil
 }
 
 // MintCoins implements an alias call to the underlying supply keeper's
@@ -42,48 +62,100 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 }
 
-// AllocateExponentialInflation allocates coins from the inflation to external
-// modules according to allocation proportions:
-//   - staking rewards -> sdk `auth` module fee collector
-//   - usage incentives -> `x/incentives` module
-//   - community pool -> `sdk `distr` module community pool
-func (k Keeper) AllocateExponentialInflation(
+// GetAllocations splits the minted coin between the recipients of the
+// distribution according to their weights. The last recipient receives the
+// remainder of the truncated shares.
+func (k Keeper) GetAllocations(
 	ctx sdk.Context,
 	mintedCoin sdk.Coin,
-	params types.Params,
-) (
-	staking, communityPool sdk.Coins,
-	err error,
-) {
-	distribution := params.InflationDistribution
+	distribution types.InflationDistribution,
+) []sdk.Coin {
+	allocations := make([]sdk.Coin, len(distribution.Recipients))
+	remaining := mintedCoin
 
-	// Allocate staking rewards into fee collector account
-	staking = sdk.Coins{k.GetProportions(ctx, mintedCoin, distribution.StakingRewards)}
+	for i, recipient := range distribution.Recipients {
+		if i == len(distribution.Recipients)-1 {
+			allocations[i] = remaining
+			break
+		}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		k.feeCollectorName,
-		staking,
-	); err != nil {
-		return nil, nil, err
+		allocations[i] = k.GetProportions(ctx, mintedCoin, recipient.Weight)
+		remaining = remaining.Sub(allocations[i])
 	}
 
-	// Allocate community pool amount (remaining module balance) to community
-	// pool address
+	return allocations
+}
+
+// AllocateInflation allocates coins from the inflation to the recipients of
+// the distribution:
+//   - fee collector -> staking rewards
+//   - distribution module -> community pool
+//   - other module accounts and addresses -> sent to the recipient
+//   - burn -> nothing, as the coins were not minted
+func (k Keeper) AllocateInflation(
+	ctx sdk.Context,
+	allocations []sdk.Coin,
+	params types.Params,
+) error {
 	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	communityPool = k.bankKeeper.GetAllBalances(ctx, moduleAddr)
 
-	err = k.distrKeeper.FundCommunityPool(
-		ctx,
-		communityPool,
-		moduleAddr,
-	)
-	if err != nil {
-		return nil, nil, err
+	for i, recipient := range params.InflationDistribution.Recipients {
+		allocation := allocations[i]
+		if !allocation.IsPositive() {
+			continue
+		}
+
+		coins := sdk.Coins{allocation}
+
+		var err error
+		addr, isAddress := recipient.GetAddress()
+		switch {
+		case recipient.IsBurn():
+		case isAddress:
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+		case recipient.Recipient == distrtypes.ModuleName:
+			err = k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr)
+		default:
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Recipient, coins)
+		}
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to allocate inflation to %s", recipient.Recipient)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAllocateInflation,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			),
+		)
 	}
 
-	return staking, communityPool, nil
+	return nil
+}
+
+// ValidateRecipients checks that the recipients of the distribution that are
+// not addresses are existing module accounts, and that the address recipients
+// are allowed to receive funds.
+func (k Keeper) ValidateRecipients(distribution types.InflationDistribution) error {
+	for _, recipient := range distribution.Recipients {
+		if recipient.IsBurn() {
+			continue
+		}
+
+		if addr, isAddress := recipient.GetAddress(); isAddress {
+			if k.bankKeeper.BlockedAddr(addr) {
+				return fmt.Errorf("inflation recipient %s is not allowed to receive funds", recipient.Recipient)
+			}
+			continue
+		}
+
+		if k.accountKeeper.GetModuleAddress(recipient.Recipient) == nil {
+			return fmt.Errorf("inflation recipient %s is not a module account", recipient.Recipient)
+		}
+	}
+
+	return nil
 }
 
 // GetProportions calculates the proportion of coins that is to be
@@ -146,20 +218,13 @@ func (k Keeper) computeTeamAllocation(ctx sdk.Context, mintDenom string) math.Le
 }
 
 // GetCirculatingSupply returns the bank supply of the mintDenom excluding the
-// team allocation in the first year
+// tokens that are not spendable in clawback vesting accounts, as of the last
+// day epoch
 func (k Keeper) GetCirculatingSupply(ctx sdk.Context, mintDenom string) math.LegacyDec {
 	circulatingSupply := math.LegacyNewDecFromInt(k.bankKeeper.GetSupply(ctx, mintDenom).Amount)
 
-	// Consider team allocation only on mainnet chain id. Team funds are held in
-	// the foundation wallets. When the foundation distribute tokens to the
-	// team, they will not be in these wallets anymore and will be account for
-	// the in the circulating supply.
-	if utils.IsMainnet(ctx.ChainID()) {
-		foundationAllocation := k.computeTeamAllocation(ctx, mintDenom)
-		circulatingSupply = circulatingSupply.Sub(foundationAllocation)
-	}
-
-	return circulatingSupply
+	nonSpendable := k.vestingKeeper.GetNonSpendableSupply(ctx).AmountOf(mintDenom)
+	return circulatingSupply.Sub(math.LegacyNewDecFromInt(nonSpendable))
 }
 
 // GetInflationRate returns the inflation rate for the current period.
@@ -193,10 +258,13 @@ func (k Keeper) GetEpochMintProvision(ctx sdk.Context) math.LegacyDec {
 	if err != nil {
 		return math.LegacyZeroDec()
 	}
+	params := k.GetParams(ctx)
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
 	return types.CalculateEpochMintProvision(
-		k.GetParams(ctx),
+		params,
 		k.GetPeriod(ctx),
 		k.GetEpochsPerPeriod(ctx),
 		bondedRadio,
+		math.LegacyNewDecFromInt(totalSupply),
 	).Quo(math.LegacyNewDec(types.ReductionFactor))
 }
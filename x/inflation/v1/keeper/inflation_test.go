@@ -8,8 +8,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
@@ -59,7 +61,7 @@ func TestMintAndAllocateInflation(t *testing.T) {
 
 			tc.malleate()
 
-			_, _, err := nw.App.InflationKeeper.MintAndAllocateInflation(ctx, tc.mintCoin, types.DefaultParams())
+			_, err := nw.App.InflationKeeper.MintAndAllocateInflation(ctx, tc.mintCoin, types.DefaultParams())
 			require.NoError(t, err, tc.name)
 
 			// Get balances
@@ -92,6 +94,62 @@ func TestMintAndAllocateInflation(t *testing.T) {
 	}
 }
 
+func TestMintAndAllocateInflationRecipients(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	recipientAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	mintCoin := sdk.NewCoin(denomMint, math.NewInt(1_000_000))
+
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:  math.LegacyZeroDec(),
+		CommunityPool:   math.LegacyZeroDec(),
+		UsageIncentives: math.LegacyZeroDec(),
+		Recipients: []types.InflationRecipient{
+			types.NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(5, 1)),
+			types.NewInflationRecipient(recipientAddr.String(), math.LegacyNewDecWithPrec(3, 1)),
+			types.NewInflationRecipient(types.BurnRecipient, math.LegacyNewDecWithPrec(2, 1)),
+		},
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, nw.App.InflationKeeper.ValidateRecipients(params.InflationDistribution))
+
+	feeCollector := nw.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	prevFeeCollectorBalance := nw.App.BankKeeper.GetBalance(ctx, feeCollector, denomMint)
+	prevSupply := nw.App.BankKeeper.GetSupply(ctx, denomMint)
+
+	allocations, err := nw.App.InflationKeeper.MintAndAllocateInflation(ctx, mintCoin, params)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Coin{
+		sdk.NewCoin(denomMint, math.NewInt(500_000)),
+		sdk.NewCoin(denomMint, math.NewInt(300_000)),
+		sdk.NewCoin(denomMint, math.NewInt(200_000)),
+	}, allocations)
+
+	feeCollectorBalance := nw.App.BankKeeper.GetBalance(ctx, feeCollector, denomMint)
+	require.Equal(t, math.NewInt(500_000), feeCollectorBalance.Amount.Sub(prevFeeCollectorBalance.Amount))
+
+	recipientBalance := nw.App.BankKeeper.GetBalance(ctx, recipientAddr, denomMint)
+	require.Equal(t, math.NewInt(300_000), recipientBalance.Amount)
+
+	// the share of the burn recipient is not minted
+	supply := nw.App.BankKeeper.GetSupply(ctx, denomMint)
+	require.Equal(t, math.NewInt(800_000), supply.Amount.Sub(prevSupply.Amount))
+
+	moduleBalance := nw.App.BankKeeper.GetBalance(ctx, nw.App.AccountKeeper.GetModuleAddress(types.ModuleName), denomMint)
+	require.True(t, moduleBalance.IsZero())
+
+	// unknown module accounts cannot receive inflation
+	params.InflationDistribution.Recipients[0].Recipient = "unknown"
+	require.Error(t, nw.App.InflationKeeper.ValidateRecipients(params.InflationDistribution))
+
+	// blocked addresses cannot receive inflation
+	params.InflationDistribution.Recipients[0].Recipient = authtypes.FeeCollectorName
+	params.InflationDistribution.Recipients[1].Recipient = nw.App.AccountKeeper.GetModuleAddress(govtypes.ModuleName).String()
+	require.ErrorContains(t, nw.App.InflationKeeper.ValidateRecipients(params.InflationDistribution), "not allowed to receive funds")
+}
+
 func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	var (
 		ctx sdk.Context
@@ -143,14 +201,13 @@ func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 	for _, isTestnet := range []bool{false, true} {
 		for _, tc := range testCases {
 			t.Run(fmt.Sprintf("Case %s, mainnet = %t", tc.name, !isTestnet), func(t *testing.T) {
-				// This variable consider all non bonded tokens during genesis but the team
-				// allocation.
-				accsFreeAmount := network.PrefundedAccountInitialBalance.MulRaw(nAccs).Sub(accsBondAmount)
+				// This variable consider all non bonded tokens during genesis,
+				// including the team allocation.
+				accsFreeAmount := network.PrefundedAccountInitialBalance.MulRaw(nAccs).Sub(accsBondAmount).Add(teamAllocation)
 
 				chainID := utils.MainnetChainID + "-1"
 				if isTestnet {
 					chainID = utils.TestnetChainID + "-1"
-					accsFreeAmount = accsFreeAmount.Add(teamAllocation)
 				}
 				// reset
 				keyring := testkeyring.New(int(nAccs))
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	integrationutils "github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
 	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 	"github.com/evmos/evmos/v20/x/inflation/v1/types"
@@ -125,7 +126,7 @@ var _ = Describe("Inflation", Ordered, func() {
 					Expect(err).To(BeNil(), "failed to get epoch mint provision")
 					paramsRes, err := s.handler.GetInflationParams()
 					Expect(err).To(BeNil(), "failed to get inflation params")
-					distribution := paramsRes.Params.InflationDistribution.CommunityPool
+					distribution := paramsRes.Params.InflationDistribution.WeightOf(distrtypes.ModuleName)
 					expected := provisionRes.EpochMintProvision.Amount.Mul(distribution)
 
 					allocatedAmt := balanceCommunityPoolAmt.Sub(prevCommPoolBalanceAmt)
@@ -142,9 +143,13 @@ var _ = Describe("Inflation", Ordered, func() {
 				params := res.Params
 				params.EnableInflation = true
 				params.InflationDistribution = types.InflationDistribution{
-					StakingRewards:  math.LegacyNewDecWithPrec(333333333, 9),
-					CommunityPool:   math.LegacyNewDecWithPrec(666666667, 9),
+					StakingRewards:  math.LegacyZeroDec(), // Deprecated
+					CommunityPool:   math.LegacyZeroDec(), // Deprecated
 					UsageIncentives: math.LegacyZeroDec(), // Deprecated
+					Recipients: []types.InflationRecipient{
+						types.NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(333333333, 9)),
+						types.NewInflationRecipient(distrtypes.ModuleName, math.LegacyNewDecWithPrec(666666667, 9)),
+					},
 				}
 				err = integrationutils.UpdateInflationParams(
 					integrationutils.UpdateParamsInput{
@@ -217,7 +222,7 @@ var _ = Describe("Inflation", Ordered, func() {
 					Expect(err).To(BeNil(), "failed to get epoch mint provision")
 					paramsRes, err := s.handler.GetInflationParams()
 					Expect(err).To(BeNil(), "failed to get inflation params")
-					distribution := paramsRes.Params.InflationDistribution.CommunityPool
+					distribution := paramsRes.Params.InflationDistribution.WeightOf(distrtypes.ModuleName)
 					expected := provisionRes.EpochMintProvision.Amount.Mul(distribution)
 
 					allocatedAmt := balanceCommunityPoolAmt.Sub(prevCommPoolBalanceAmt)
@@ -302,7 +307,7 @@ var _ = Describe("Inflation", Ordered, func() {
 					Expect(err).To(BeNil(), "failed to get epoch mint provision")
 					paramsRes, err := s.handler.GetInflationParams()
 					Expect(err).To(BeNil(), "failed to get inflation params")
-					distribution := paramsRes.Params.InflationDistribution.CommunityPool
+					distribution := paramsRes.Params.InflationDistribution.WeightOf(distrtypes.ModuleName)
 					expected := provisionRes.EpochMintProvision.Amount.Mul(distribution)
 
 					allocatedAmt := balanceCommunityPoolAmt.Sub(prevCommPoolBalanceAmt)
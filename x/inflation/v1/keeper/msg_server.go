@@ -20,6 +20,10 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
+	if err := k.ValidateRecipients(req.Params.InflationDistribution); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid inflation distribution")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, errorsmod.Wrapf(err, "error setting params")
@@ -0,0 +1,81 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+)
+
+// MaxProjectionPeriods is the maximum number of periods that can be projected
+// in a single query.
+const MaxProjectionPeriods = 100
+
+// ProjectInflation projects the mint provisions and supply of the given number
+// of periods, starting with the current one, with the given params and an
+// assumed constant bonded ratio. Each epoch mints the provision calculated
+// with the total supply at that epoch, as the epoch hooks do, excluding the
+// share of the burn recipients. The tokens held by vesting accounts are
+// assumed not to change.
+func (k Keeper) ProjectInflation(
+	ctx sdk.Context,
+	params types.Params,
+	periods uint64,
+	bondedRatio math.LegacyDec,
+) []types.PeriodProjection {
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	period := k.GetPeriod(ctx)
+
+	totalSupply := math.LegacyNewDecFromInt(k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	circulatingSupply := k.GetCirculatingSupply(ctx, params.MintDenom)
+
+	projections := make([]types.PeriodProjection, 0, periods)
+	for i := uint64(0); i < periods; i++ {
+		epochMintProvision := math.LegacyZeroDec()
+		periodMintProvision := math.LegacyZeroDec()
+
+		for epoch := int64(0); params.EnableInflation && epoch < epochsPerPeriod; epoch++ {
+			provision := types.CalculateEpochMintProvision(
+				params,
+				period+i,
+				epochsPerPeriod,
+				bondedRatio,
+				totalSupply,
+			)
+			if !provision.IsPositive() {
+				break
+			}
+
+			// the hooks only mint whole tokens of the base denomination and
+			// do not mint the share of the burn recipients
+			mintedCoin := sdk.NewCoin(params.MintDenom, provision.TruncateInt())
+			allocations := k.GetAllocations(ctx, mintedCoin, params.InflationDistribution)
+			for j, recipient := range params.InflationDistribution.Recipients {
+				if recipient.IsBurn() {
+					mintedCoin = mintedCoin.Sub(allocations[j])
+				}
+			}
+			minted := math.LegacyNewDecFromInt(mintedCoin.Amount)
+			if epoch == 0 {
+				epochMintProvision = minted
+			}
+
+			periodMintProvision = periodMintProvision.Add(minted)
+			totalSupply = totalSupply.Add(minted)
+		}
+
+		circulatingSupply = circulatingSupply.Add(periodMintProvision)
+
+		projections = append(projections, types.PeriodProjection{
+			Period:              period + i,
+			EpochMintProvision:  sdk.NewDecCoinFromDec(params.MintDenom, epochMintProvision),
+			PeriodMintProvision: sdk.NewDecCoinFromDec(params.MintDenom, periodMintProvision),
+			TotalSupply:         sdk.NewDecCoinFromDec(params.MintDenom, totalSupply),
+			CirculatingSupply:   sdk.NewDecCoinFromDec(params.MintDenom, circulatingSupply),
+		})
+	}
+
+	return projections
+}
@@ -0,0 +1,37 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+)
+
+// MigrateStore migrates the x/inflation module state from the consensus version 3 to
+// version 4. Specifically, it sets the exponential curve and the default values of the
+// inflation curve params, so the existing monetary policy is kept.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+
+	bz := store.Get(types.ParamsKey)
+	if len(bz) != 0 {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.InflationCurve = types.DefaultInflationCurve
+	params.FixedRate = types.DefaultFixedRate
+	params.TailRate = types.DefaultTailRate
+	params.MaxSupply = types.DefaultMaxSupply
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
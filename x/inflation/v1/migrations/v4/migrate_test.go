@@ -0,0 +1,40 @@
+package v4_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/evmos/evmos/v20/encoding"
+	v4 "github.com/evmos/evmos/v20/x/inflation/v1/migrations/v4"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the inflation curves were added
+	params := types.Params{
+		MintDenom:              types.DefaultInflationDenom,
+		ExponentialCalculation: types.DefaultExponentialCalculation,
+		InflationDistribution:  types.DefaultInflationDistribution,
+		EnableInflation:        true,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v4.MigrateStore(store, cdc))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, types.INFLATION_CURVE_EXPONENTIAL, migrated.InflationCurve)
+	require.Equal(t, params.ExponentialCalculation, migrated.ExponentialCalculation)
+	require.Equal(t, params.InflationDistribution, migrated.InflationDistribution)
+}
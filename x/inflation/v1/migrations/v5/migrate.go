@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v5
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+)
+
+// MigrateStore migrates the x/inflation module state from the consensus version 4 to
+// version 5. Specifically, it replaces the deprecated staking rewards and community pool
+// distributions by the equivalent inflation recipients.
+func MigrateStore(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+
+	bz := store.Get(types.ParamsKey)
+	if len(bz) != 0 {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	distribution := params.InflationDistribution
+
+	//nolint:staticcheck
+	legacy := []types.InflationRecipient{
+		types.NewInflationRecipient(authtypes.FeeCollectorName, distribution.StakingRewards),
+		types.NewInflationRecipient(distrtypes.ModuleName, distribution.CommunityPool),
+	}
+
+	for _, recipient := range legacy {
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			continue
+		}
+		distribution.Recipients = append(distribution.Recipients, recipient)
+	}
+
+	distribution.StakingRewards = math.LegacyZeroDec()  //nolint:staticcheck
+	distribution.CommunityPool = math.LegacyZeroDec()   //nolint:staticcheck
+	distribution.UsageIncentives = math.LegacyZeroDec() //nolint:staticcheck
+	params.InflationDistribution = distribution
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,44 @@
+package v5_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/evmos/evmos/v20/encoding"
+	v5 "github.com/evmos/evmos/v20/x/inflation/v1/migrations/v5"
+	"github.com/evmos/evmos/v20/x/inflation/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig()
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the inflation recipients were added
+	params := types.DefaultParams()
+	params.InflationDistribution = types.InflationDistribution{
+		StakingRewards:  math.LegacyNewDecWithPrec(533333334, 9),
+		CommunityPool:   math.LegacyNewDecWithPrec(466666666, 9),
+		UsageIncentives: math.LegacyZeroDec(),
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v5.MigrateStore(store, cdc))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	require.NoError(t, migrated.Validate())
+	require.Equal(t, []types.InflationRecipient{
+		types.NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(533333334, 9)),
+		types.NewInflationRecipient(distrtypes.ModuleName, math.LegacyNewDecWithPrec(466666666, 9)),
+	}, migrated.InflationDistribution.Recipients)
+}
@@ -27,7 +27,7 @@ import (
 )
 
 // consensusVersion defines the current x/inflation module consensus version.
-const consensusVersion = 3
+const consensusVersion = 5
 
 // type check to ensure the interface is properly implemented
 var (
@@ -141,6 +141,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	// Migrate to version 4 of store
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
+
+	// Migrate to version 5 of store
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the inflation module. It returns
@@ -0,0 +1,63 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnRecipient is the inflation recipient whose share of the epoch mint
+// provision is not minted.
+const BurnRecipient = "burn"
+
+// NewInflationRecipient creates a new InflationRecipient instance
+func NewInflationRecipient(recipient string, weight math.LegacyDec) InflationRecipient {
+	return InflationRecipient{
+		Recipient: recipient,
+		Weight:    weight,
+	}
+}
+
+// Validate performs a stateless validation of the inflation recipient
+func (r InflationRecipient) Validate() error {
+	if strings.TrimSpace(r.Recipient) == "" {
+		return fmt.Errorf("inflation recipient cannot be blank")
+	}
+
+	if r.Weight.IsNil() || !r.Weight.IsPositive() {
+		return fmt.Errorf("weight of inflation recipient %s must be positive", r.Recipient)
+	}
+
+	return nil
+}
+
+// IsBurn returns true if the share of the recipient is burned.
+func (r InflationRecipient) IsBurn() bool {
+	return r.Recipient == BurnRecipient
+}
+
+// GetAddress returns the account address of the recipient, or false if the
+// recipient is the name of a module account or the burn recipient.
+func (r InflationRecipient) GetAddress() (sdk.AccAddress, bool) {
+	addr, err := sdk.AccAddressFromBech32(r.Recipient)
+	if err != nil {
+		return nil, false
+	}
+	return addr, true
+}
+
+// WeightOf returns the weight of the given recipient in the distribution, or
+// zero if it is not a recipient.
+func (d InflationDistribution) WeightOf(recipient string) math.LegacyDec {
+	for _, r := range d.Recipients {
+		if r.Recipient == recipient {
+			return r.Weight
+		}
+	}
+	return math.LegacyZeroDec()
+}
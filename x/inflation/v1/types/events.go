@@ -5,8 +5,10 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint              = ModuleName
+	EventTypeAllocateInflation = "allocate_inflation"
 
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyRecipient       = "recipient"
 )
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -116,6 +118,17 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// inflation_curve selects the curve used to calculate the mint provision
+	InflationCurve InflationCurve `protobuf:"varint,5,opt,name=inflation_curve,json=inflationCurve,proto3,enum=evmos.inflation.v1.InflationCurve" json:"inflation_curve,omitempty"`
+	// fixed_rate is the share of the total supply minted on each period by the
+	// fixed rate curve
+	FixedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fixed_rate,json=fixedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fixed_rate"`
+	// tail_rate is the minimum share of the total supply minted on each period
+	// by the tail emission curve
+	TailRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=tail_rate,json=tailRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tail_rate"`
+	// max_supply is the total supply at which the supply capped curve stops
+	// minting
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,6 +192,13 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetInflationCurve() InflationCurve {
+	if m != nil {
+		return m.InflationCurve
+	}
+	return INFLATION_CURVE_EXPONENTIAL
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.inflation.v1.Params")
@@ -187,34 +207,43 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/genesis.proto", fileDescriptor_1cb8eee530db1235) }
 
 var fileDescriptor_1cb8eee530db1235 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x8d, 0x9b, 0x12, 0x11, 0x17, 0x28, 0xb5, 0x20, 0x44, 0x91, 0x58, 0x56, 0x91, 0x90, 0xd2,
-	0x1c, 0xd6, 0x34, 0x9c, 0xb9, 0x94, 0x56, 0x28, 0xb7, 0x68, 0xb9, 0x71, 0xb1, 0x9c, 0xec, 0x34,
-	0xb5, 0x9a, 0xb5, 0xad, 0xb5, 0x13, 0x95, 0xbf, 0xe0, 0x33, 0xb8, 0xc1, 0x67, 0xf4, 0xd8, 0x23,
-	0x27, 0x84, 0x92, 0x03, 0xbf, 0x81, 0x32, 0x5e, 0x36, 0x41, 0xec, 0xc5, 0xb2, 0xdf, 0xbc, 0x79,
-	0x6f, 0x66, 0x3c, 0x34, 0x86, 0x55, 0x6e, 0x1c, 0x57, 0xfa, 0x6a, 0x21, 0xbd, 0x32, 0x9a, 0xaf,
-	0xce, 0xf8, 0x1c, 0x34, 0x38, 0xe5, 0x12, 0x5b, 0x18, 0x6f, 0x18, 0x43, 0x46, 0x52, 0x31, 0x92,
-	0xd5, 0x59, 0xef, 0x44, 0xe6, 0x4a, 0x1b, 0x8e, 0x67, 0xa0, 0xf5, 0x9e, 0xcd, 0xcd, 0xdc, 0xe0,
-	0x95, 0x6f, 0x6f, 0x25, 0xda, 0xaf, 0x91, 0xdf, 0x29, 0x21, 0xa7, 0xbf, 0x21, 0xf4, 0xd1, 0x87,
-	0x60, 0xf9, 0xd1, 0x4b, 0x0f, 0xec, 0x1d, 0x6d, 0x59, 0x59, 0xc8, 0xdc, 0x75, 0x49, 0x4c, 0x06,
-	0x47, 0xa3, 0x5e, 0xf2, 0x7f, 0x09, 0xc9, 0x04, 0x19, 0xe7, 0xed, 0xbb, 0x9f, 0xaf, 0x1a, 0x5f,
-	0x7f, 0x7f, 0x1f, 0x92, 0xb4, 0x4c, 0x62, 0x1d, 0xda, 0xb2, 0x50, 0x28, 0x93, 0x75, 0x0f, 0x62,
-	0x32, 0x38, 0x4c, 0xcb, 0x17, 0x3b, 0xa5, 0x4f, 0xc1, 0x9a, 0xd9, 0xb5, 0x50, 0x19, 0x68, 0xaf,
-	0xae, 0x14, 0x14, 0xdd, 0x66, 0x4c, 0x06, 0xed, 0xf4, 0x18, 0xf1, 0x71, 0x05, 0xb3, 0x21, 0x3d,
-	0x41, 0xc8, 0x09, 0x0b, 0x85, 0x28, 0xd5, 0x0e, 0x63, 0x32, 0x68, 0x96, 0x5c, 0x37, 0x81, 0x62,
-	0x12, 0x64, 0x5f, 0xd3, 0x27, 0xee, 0x46, 0x59, 0x0b, 0x99, 0x08, 0xa1, 0xee, 0x03, 0xb4, 0x7d,
-	0x5c, 0xa2, 0x97, 0x08, 0xf6, 0xbf, 0x1d, 0xd0, 0x56, 0xa8, 0x99, 0xbd, 0xa4, 0x34, 0x57, 0xda,
-	0x8b, 0x0c, 0xb4, 0xc9, 0xb1, 0xc7, 0x76, 0xda, 0xde, 0x22, 0x17, 0x5b, 0x80, 0x69, 0xfa, 0x02,
-	0x6e, 0xad, 0xd1, 0xdb, 0x6a, 0xe4, 0x42, 0xcc, 0xe4, 0x62, 0xb6, 0x0c, 0x7d, 0x63, 0x43, 0x47,
-	0xa3, 0x61, 0xdd, 0x3c, 0x2e, 0x77, 0x29, 0xef, 0x77, 0x19, 0xfb, 0xf3, 0xe9, 0x40, 0x2d, 0x85,
-	0xdd, 0xd0, 0x4e, 0xa5, 0x24, 0x32, 0xe5, 0x7c, 0xa1, 0xa6, 0x4b, 0xb4, 0x6b, 0xa2, 0xdd, 0x69,
-	0x9d, 0xdd, 0xf8, 0xef, 0xe3, 0x62, 0x2f, 0x61, 0xdf, 0xed, 0xb9, 0xaa, 0x63, 0xe0, 0x27, 0x68,
-	0x39, 0x5d, 0x80, 0xa8, 0xe2, 0x38, 0xd8, 0x87, 0xe9, 0x71, 0xc0, 0x2b, 0xe1, 0xf3, 0xf1, 0xdd,
-	0x3a, 0x22, 0xf7, 0xeb, 0x88, 0xfc, 0x5a, 0x47, 0xe4, 0xcb, 0x26, 0x6a, 0xdc, 0x6f, 0xa2, 0xc6,
-	0x8f, 0x4d, 0xd4, 0xf8, 0xc4, 0xe7, 0xca, 0x5f, 0x2f, 0xa7, 0xc9, 0xcc, 0xe4, 0x3c, 0x2c, 0x58,
-	0x38, 0x57, 0xa3, 0x37, 0xfc, 0xf6, 0xdf, 0x65, 0xf3, 0x9f, 0x2d, 0xb8, 0x69, 0x0b, 0x37, 0xed,
-	0xed, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x47, 0x3c, 0x80, 0xee, 0x02, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0xa6, 0x82, 0x48, 0x67, 0x15, 0xdc, 0xc6, 0xc5, 0x8a, 0xb1, 0x4b, 0x48, 0x4c, 0x58, 0x12,
+	0xdb, 0x5d, 0x3c, 0x7b, 0x61, 0x21, 0x1b, 0xa2, 0x89, 0xa4, 0x7b, 0xf3, 0x32, 0x19, 0xda, 0x01,
+	0x26, 0xb4, 0x33, 0x93, 0xce, 0x40, 0xe0, 0x5f, 0xf8, 0x33, 0x3c, 0x7a, 0xf0, 0x47, 0xec, 0x71,
+	0xe3, 0xc9, 0x98, 0xb8, 0x31, 0x70, 0xf0, 0x6f, 0x98, 0xce, 0x54, 0x60, 0xb3, 0xc4, 0x64, 0x2f,
+	0x93, 0xbe, 0xef, 0x7d, 0xef, 0x7b, 0xdf, 0x7b, 0x9d, 0x01, 0x75, 0x3c, 0x8f, 0x99, 0xf0, 0x08,
+	0x1d, 0x45, 0x48, 0x12, 0x46, 0xbd, 0xf9, 0x99, 0x37, 0xc6, 0x14, 0x0b, 0x22, 0x5c, 0x9e, 0x30,
+	0xc9, 0x2c, 0x4b, 0x31, 0xdc, 0x0d, 0xc3, 0x9d, 0x9f, 0xd5, 0x0e, 0x51, 0x4c, 0x28, 0xf3, 0xd4,
+	0xa9, 0x69, 0xb5, 0x17, 0x01, 0x13, 0x31, 0x13, 0x50, 0x45, 0x9e, 0x0e, 0xb2, 0xd4, 0xb3, 0x31,
+	0x1b, 0x33, 0x8d, 0xa7, 0x5f, 0x19, 0xda, 0xd8, 0xd3, 0x79, 0xdb, 0x44, 0x71, 0x1a, 0x6b, 0x03,
+	0x3c, 0xbe, 0xd0, 0x6e, 0x2e, 0x25, 0x92, 0xd8, 0x7a, 0x07, 0x8a, 0x1c, 0x25, 0x28, 0x16, 0xb6,
+	0x51, 0x37, 0x9a, 0x07, 0xed, 0x9a, 0x7b, 0xd7, 0x9d, 0x3b, 0x50, 0x8c, 0x8e, 0x79, 0x75, 0x73,
+	0x9c, 0xfb, 0xf2, 0xe7, 0x6b, 0xcb, 0xf0, 0xb3, 0x22, 0xab, 0x0a, 0x8a, 0x1c, 0x27, 0x84, 0x85,
+	0xf6, 0x83, 0xba, 0xd1, 0x2c, 0xf8, 0x59, 0x64, 0x9d, 0x80, 0xa7, 0x98, 0xb3, 0x60, 0x02, 0x49,
+	0x88, 0xa9, 0x24, 0x23, 0x82, 0x13, 0x3b, 0x5f, 0x37, 0x9a, 0xa6, 0x5f, 0x51, 0x78, 0x7f, 0x03,
+	0x5b, 0x2d, 0x70, 0xa8, 0x20, 0x01, 0x39, 0x4e, 0x60, 0xa6, 0x56, 0xa8, 0x1b, 0xcd, 0x7c, 0xc6,
+	0x15, 0x03, 0x9c, 0x0c, 0xb4, 0xec, 0x6b, 0x50, 0x16, 0x53, 0xc2, 0x39, 0x0e, 0xa1, 0x4e, 0xd9,
+	0x0f, 0x55, 0xdb, 0x27, 0x19, 0xda, 0x53, 0x60, 0xe3, 0x57, 0x01, 0x14, 0xb5, 0x67, 0xeb, 0x15,
+	0x00, 0x31, 0xa1, 0x12, 0x86, 0x98, 0xb2, 0x58, 0xcd, 0x68, 0xfa, 0x66, 0x8a, 0x74, 0x53, 0xc0,
+	0xa2, 0xe0, 0x39, 0x5e, 0x70, 0x46, 0x53, 0x37, 0x28, 0x82, 0x01, 0x8a, 0x82, 0x99, 0x9e, 0x5b,
+	0x0d, 0x74, 0xd0, 0x6e, 0xed, 0xdb, 0x47, 0x6f, 0x5b, 0x72, 0xbe, 0xad, 0xd8, 0xdd, 0x4f, 0x15,
+	0xef, 0xa5, 0x58, 0x53, 0x50, 0xdd, 0x28, 0xc1, 0x90, 0x08, 0x99, 0x90, 0xe1, 0x4c, 0xb5, 0xcb,
+	0xab, 0x76, 0x27, 0xfb, 0xda, 0xf5, 0xff, 0x05, 0xdd, 0x9d, 0x82, 0xdd, 0x6e, 0x47, 0x64, 0x1f,
+	0x43, 0xfd, 0x04, 0x8a, 0x86, 0x11, 0x86, 0x9b, 0xbc, 0x5a, 0x6c, 0xc9, 0xaf, 0x68, 0x7c, 0x23,
+	0x6c, 0xbd, 0x07, 0x95, 0xad, 0xaf, 0x60, 0x96, 0xcc, 0xb1, 0xda, 0x6c, 0xb9, 0xdd, 0xf8, 0xaf,
+	0xa1, 0xf3, 0x94, 0xe9, 0x97, 0xc9, 0xad, 0xd8, 0xba, 0x00, 0x60, 0x44, 0x16, 0x38, 0x84, 0x09,
+	0x92, 0xd8, 0x2e, 0xa6, 0x3b, 0xef, 0x34, 0x53, 0xb7, 0x3f, 0x6f, 0x8e, 0x5f, 0xea, 0x8b, 0x2c,
+	0xc2, 0xa9, 0x4b, 0x98, 0x17, 0x23, 0x39, 0x71, 0x3f, 0xe0, 0x31, 0x0a, 0x96, 0x5d, 0x1c, 0xe8,
+	0x61, 0x4c, 0x55, 0xeb, 0xa7, 0x97, 0xb3, 0x07, 0x4c, 0x89, 0x48, 0xa4, 0x75, 0x1e, 0xdd, 0x53,
+	0xa7, 0x94, 0x96, 0x2a, 0x99, 0x8f, 0x00, 0xc4, 0x68, 0x01, 0xc5, 0x8c, 0xf3, 0x68, 0x69, 0x97,
+	0x94, 0xce, 0x69, 0xa6, 0x73, 0x74, 0x57, 0xa7, 0x4f, 0xe5, 0xf7, 0x6f, 0x6f, 0x80, 0x4e, 0xa4,
+	0x51, 0xe6, 0x2b, 0x46, 0x8b, 0x4b, 0x25, 0xd1, 0xe9, 0x5f, 0xad, 0x1c, 0xe3, 0x7a, 0xe5, 0x18,
+	0xbf, 0x57, 0x8e, 0xf1, 0x79, 0xed, 0xe4, 0xae, 0xd7, 0x4e, 0xee, 0xc7, 0xda, 0xc9, 0x7d, 0xf2,
+	0xc6, 0x44, 0x4e, 0x66, 0x43, 0x37, 0x60, 0xb1, 0xa7, 0x9f, 0xa3, 0x3e, 0xe7, 0xed, 0x53, 0x6f,
+	0x71, 0xfb, 0x69, 0xca, 0x25, 0xc7, 0x62, 0x58, 0x54, 0xef, 0xf2, 0xed, 0xdf, 0x01, 0x00, 0x87,
+	0x94, 0xcc, 0xab, 0x37, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -292,6 +321,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TailRate.Size()
+		i -= size
+		if _, err := m.TailRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FixedRate.Size()
+		i -= size
+		if _, err := m.FixedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.InflationCurve != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InflationCurve))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -384,6 +448,15 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.InflationCurve != 0 {
+		n += 1 + sovGenesis(uint64(m.InflationCurve))
+	}
+	l = m.FixedRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TailRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -712,6 +785,127 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationCurve", wireType)
+			}
+			m.InflationCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationCurve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TailRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
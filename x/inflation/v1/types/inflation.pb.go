@@ -25,23 +25,63 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationCurve enumerates the curves used to calculate the mint provision
+// of each period.
+type InflationCurve int32
+
+const (
+	// INFLATION_CURVE_EXPONENTIAL decays the provision exponentially following
+	// the exponential calculation.
+	INFLATION_CURVE_EXPONENTIAL InflationCurve = 0
+	// INFLATION_CURVE_FIXED_RATE mints a fixed share of the total supply on each
+	// period.
+	INFLATION_CURVE_FIXED_RATE InflationCurve = 1
+	// INFLATION_CURVE_TAIL_EMISSION decays the provision exponentially but never
+	// mints less than the tail rate of the total supply on each period.
+	INFLATION_CURVE_TAIL_EMISSION InflationCurve = 2
+	// INFLATION_CURVE_SUPPLY_CAPPED decays the provision exponentially and stops
+	// minting once the total supply reaches the max supply.
+	INFLATION_CURVE_SUPPLY_CAPPED InflationCurve = 3
+)
+
+var InflationCurve_name = map[int32]string{
+	0: "INFLATION_CURVE_EXPONENTIAL",
+	1: "INFLATION_CURVE_FIXED_RATE",
+	2: "INFLATION_CURVE_TAIL_EMISSION",
+	3: "INFLATION_CURVE_SUPPLY_CAPPED",
+}
+
+var InflationCurve_value = map[string]int32{
+	"INFLATION_CURVE_EXPONENTIAL":   0,
+	"INFLATION_CURVE_FIXED_RATE":    1,
+	"INFLATION_CURVE_TAIL_EMISSION": 2,
+	"INFLATION_CURVE_SUPPLY_CAPPED": 3,
+}
+
+func (x InflationCurve) String() string {
+	return proto.EnumName(InflationCurve_name, int32(x))
+}
+
+func (InflationCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
-// excludes the team vesting distribution, as this is minted once at genesis.
-// The initial InflationDistribution can be calculated from the Evmos Token
-// Model like this:
-// mintDistribution1 = distribution1 / (1 - teamVestingDistribution)
-// 0.5333333         = 40%           / (1 - 25%)
+// allocated through minting on each epoch. It excludes the team vesting
+// distribution, as this is minted once at genesis.
 type InflationDistribution struct {
-	// staking_rewards defines the proportion of the minted minted_denom that is
-	// to be allocated as staking rewards
-	StakingRewards cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards"`
+	// Deprecated: staking_rewards defines the proportion of the minted minted_denom that is
+	// to be allocated as staking rewards. Use a recipients entry for the fee collector instead.
+	StakingRewards cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_rewards,json=stakingRewards,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_rewards"` // Deprecated: Do not use.
 	// Deprecated: usage_incentives defines the proportion of the minted minted_denom that is
 	// to be allocated to the incentives module address
 	UsageIncentives cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=usage_incentives,json=usageIncentives,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"usage_incentives"` // Deprecated: Do not use.
-	// community_pool defines the proportion of the minted minted_denom that is to
-	// be allocated to the community pool
-	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// Deprecated: community_pool defines the proportion of the minted minted_denom that is to
+	// be allocated to the community pool. Use a recipients entry for the distribution module instead.
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"` // Deprecated: Do not use.
+	// recipients of the minted tokens and their weights. The weights must add up
+	// to 1.
+	Recipients []InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
@@ -77,6 +117,66 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+func (m *InflationDistribution) GetRecipients() []InflationRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// InflationRecipient defines a recipient of a share of the tokens minted on
+// each epoch.
+type InflationRecipient struct {
+	// recipient is the name of a module account, a bech32 account address or
+	// "burn". The share of the fee collector is distributed as staking rewards,
+	// the share of the distribution module funds the community pool and the share
+	// of "burn" is not minted.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// weight defines the proportion of the minted tokens allocated to the
+	// recipient
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 // ExponentialCalculation holds factors to calculate exponential inflation on
 // each period. Calculation reference:
 // periodProvision = exponentialDecay       *  bondingIncentive
@@ -99,7 +199,7 @@ func (m *ExponentialCalculation) Reset()         { *m = ExponentialCalculation{}
 func (m *ExponentialCalculation) String() string { return proto.CompactTextString(m) }
 func (*ExponentialCalculation) ProtoMessage()    {}
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+	return fileDescriptor_d064cb35c3ff7df8, []int{2}
 }
 func (m *ExponentialCalculation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,7 +229,9 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("evmos.inflation.v1.InflationCurve", InflationCurve_name, InflationCurve_value)
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
+	proto.RegisterType((*InflationRecipient)(nil), "evmos.inflation.v1.InflationRecipient")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
 }
 
@@ -138,32 +240,43 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xc7, 0xe3, 0xf2, 0x21, 0x61, 0x60, 0x17, 0x22, 0x40, 0xd1, 0x22, 0x65, 0x51, 0x4f, 0xab,
-	0x3d, 0xc4, 0x14, 0x24, 0x1e, 0xa0, 0x94, 0x43, 0x05, 0x12, 0x50, 0x01, 0x07, 0x2e, 0xd1, 0xc4,
-	0x35, 0xa9, 0xd5, 0xd8, 0x13, 0xd9, 0x4e, 0x48, 0xdf, 0x02, 0xf1, 0x14, 0x1c, 0x79, 0x8c, 0x1e,
-	0x7b, 0x44, 0x1c, 0x2a, 0xd4, 0x1e, 0x78, 0x0d, 0x94, 0x0f, 0x5a, 0x71, 0x23, 0x97, 0xd1, 0x64,
-	0x34, 0xbf, 0x7f, 0x3c, 0x7f, 0x8f, 0xe9, 0x50, 0x94, 0x0a, 0x2d, 0x93, 0xfa, 0x53, 0x06, 0x4e,
-	0xa2, 0x66, 0xe5, 0xe8, 0xf8, 0x11, 0xe5, 0x06, 0x1d, 0xfa, 0x7e, 0xd3, 0x13, 0x1d, 0xcb, 0xe5,
-	0xe8, 0xec, 0x2e, 0x28, 0xa9, 0x91, 0x35, 0xb1, 0x6d, 0x3b, 0xbb, 0x97, 0x62, 0x8a, 0x4d, 0xca,
-	0xea, 0xac, 0xad, 0x0e, 0xbf, 0x0e, 0xe8, 0xfd, 0xe9, 0x5f, 0x72, 0x22, 0xad, 0x33, 0x32, 0x29,
-	0xea, 0xdc, 0x7f, 0x4b, 0x4f, 0xad, 0x83, 0xa5, 0xd4, 0x69, 0x6c, 0xc4, 0x67, 0x30, 0x73, 0x1b,
-	0x90, 0x47, 0xe4, 0xe2, 0xc6, 0xf8, 0x62, 0xbd, 0x3d, 0xf7, 0x7e, 0x6e, 0xcf, 0x1f, 0x72, 0xb4,
-	0x0a, 0xad, 0x9d, 0x2f, 0x23, 0x89, 0x4c, 0x81, 0x5b, 0x44, 0xaf, 0x44, 0x0a, 0x7c, 0x35, 0x11,
-	0xfc, 0xdb, 0xef, 0xef, 0x97, 0x64, 0x76, 0xd2, 0x09, 0xcc, 0x5a, 0xde, 0x7f, 0x4f, 0xef, 0x14,
-	0x16, 0x52, 0x11, 0x4b, 0xcd, 0x85, 0x76, 0xb2, 0x14, 0x36, 0x18, 0x34, 0x9a, 0x97, 0xff, 0xab,
-	0x19, 0x90, 0xd9, 0x69, 0xa3, 0x31, 0x3d, 0x48, 0xf8, 0xaf, 0xe9, 0x09, 0x47, 0xa5, 0x0a, 0x2d,
-	0xdd, 0x2a, 0xce, 0x11, 0xb3, 0xe0, 0x4a, 0xcf, 0x83, 0xde, 0x3e, 0xf0, 0x6f, 0x10, 0xb3, 0xe1,
-	0x76, 0x40, 0x1f, 0xbc, 0xa8, 0x72, 0xd4, 0xf5, 0x1f, 0x20, 0x7b, 0x0e, 0x19, 0x2f, 0x5a, 0x87,
-	0xfc, 0x67, 0x94, 0x40, 0x6f, 0x1f, 0x08, 0xd4, 0x9c, 0xe9, 0x66, 0xed, 0xc1, 0x99, 0x9a, 0xe3,
-	0xbd, 0xc7, 0x21, 0xbc, 0xf6, 0x24, 0x41, 0x3d, 0xaf, 0x6f, 0xcf, 0x81, 0x49, 0x85, 0x0b, 0xae,
-	0xf6, 0xf5, 0xa4, 0xe3, 0xdf, 0x35, 0xb8, 0xff, 0x92, 0xde, 0x52, 0x50, 0xc5, 0x25, 0x18, 0x09,
-	0x9a, 0x8b, 0xe0, 0x5a, 0x4f, 0xb9, 0x9b, 0x0a, 0xaa, 0x0f, 0x1d, 0x3c, 0x9e, 0xae, 0x77, 0x21,
-	0xd9, 0xec, 0x42, 0xf2, 0x6b, 0x17, 0x92, 0x2f, 0xfb, 0xd0, 0xdb, 0xec, 0x43, 0xef, 0xc7, 0x3e,
-	0xf4, 0x3e, 0xb2, 0x54, 0xba, 0x45, 0x91, 0x44, 0x1c, 0x15, 0x6b, 0x77, 0xbf, 0x8d, 0xe5, 0x93,
-	0xc7, 0xac, 0xfa, 0xf7, 0x1d, 0xb8, 0x55, 0x2e, 0x6c, 0x72, 0xbd, 0xd9, 0xe3, 0xa7, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xec, 0x57, 0xae, 0x03, 0x2a, 0x03, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x3d, 0x49, 0xbe, 0x4a, 0x99, 0x7e, 0x4d, 0xc3, 0x08, 0x90, 0x95, 0x82, 0x53, 0xb2,
+	0x40, 0x51, 0x16, 0x36, 0x2d, 0x12, 0x6b, 0xf2, 0xc7, 0x95, 0x2c, 0x42, 0xe2, 0x3a, 0x49, 0x55,
+	0xd8, 0x58, 0x13, 0x67, 0x70, 0x46, 0x8d, 0x3d, 0x91, 0x3d, 0x76, 0x93, 0x37, 0x60, 0xc9, 0x0b,
+	0xb0, 0x62, 0xc3, 0x92, 0xc7, 0xe8, 0xb2, 0x4b, 0xc4, 0x22, 0x42, 0xc9, 0x02, 0xf1, 0x16, 0xc8,
+	0x76, 0x9a, 0x40, 0xcb, 0x02, 0x6f, 0x46, 0xd7, 0xd7, 0xe7, 0xfc, 0x7c, 0x7d, 0x6c, 0x5d, 0x58,
+	0x21, 0xa1, 0xc3, 0x7c, 0x85, 0xba, 0xef, 0x26, 0x98, 0x53, 0xe6, 0x2a, 0xe1, 0xd1, 0xf6, 0x42,
+	0x9e, 0x7a, 0x8c, 0x33, 0x84, 0x62, 0x8d, 0xbc, 0x6d, 0x87, 0x47, 0xa5, 0x7b, 0xd8, 0xa1, 0x2e,
+	0x53, 0xe2, 0x33, 0x91, 0x95, 0xee, 0xdb, 0xcc, 0x66, 0x71, 0xa9, 0x44, 0x55, 0xd2, 0xad, 0xfc,
+	0xcc, 0xc0, 0x07, 0xda, 0x8d, 0xb3, 0x45, 0x7d, 0xee, 0xd1, 0x61, 0x10, 0xd5, 0xa8, 0x07, 0xf7,
+	0x7d, 0x8e, 0x2f, 0xa8, 0x6b, 0x9b, 0x1e, 0xb9, 0xc4, 0xde, 0xc8, 0x17, 0xc1, 0x21, 0xa8, 0xe6,
+	0x1b, 0xb5, 0xab, 0x45, 0x59, 0xf8, 0xb6, 0x28, 0x1f, 0x58, 0xcc, 0x77, 0x98, 0xef, 0x8f, 0x2e,
+	0x64, 0xca, 0x14, 0x07, 0xf3, 0xb1, 0xdc, 0x26, 0x36, 0xb6, 0xe6, 0x2d, 0x62, 0x7d, 0xfe, 0xf1,
+	0xa5, 0x06, 0x44, 0x60, 0x14, 0xd6, 0x08, 0x23, 0x21, 0xa0, 0x01, 0x2c, 0x06, 0x3e, 0xb6, 0x89,
+	0x49, 0x5d, 0x8b, 0xb8, 0x9c, 0x86, 0xc4, 0x17, 0x33, 0xa9, 0xa9, 0xfb, 0x31, 0x43, 0xdb, 0x20,
+	0xd0, 0x29, 0x2c, 0x58, 0xcc, 0x71, 0x02, 0x97, 0xf2, 0xb9, 0x39, 0x65, 0x6c, 0x22, 0x66, 0x53,
+	0x43, 0xf7, 0x36, 0x04, 0x9d, 0xb1, 0x09, 0x3a, 0x85, 0xd0, 0x23, 0x16, 0x9d, 0x52, 0xe2, 0x72,
+	0x5f, 0xcc, 0x1d, 0x66, 0xab, 0xbb, 0xc7, 0x4f, 0xe5, 0xbb, 0x51, 0xcb, 0x9b, 0xf4, 0x8c, 0x1b,
+	0x79, 0x23, 0x1f, 0x3d, 0x36, 0xe6, 0x1a, 0xbf, 0x41, 0x2a, 0x1c, 0xa2, 0xbb, 0x62, 0xf4, 0x08,
+	0xe6, 0x37, 0x9a, 0x24, 0x61, 0x63, 0xdb, 0x40, 0x2f, 0xe1, 0xce, 0x25, 0xa1, 0xf6, 0x98, 0xaf,
+	0x63, 0xaa, 0xfe, 0xeb, 0x1b, 0x19, 0x6b, 0x5f, 0x65, 0x91, 0x81, 0x0f, 0xd5, 0xd9, 0x94, 0xb9,
+	0x51, 0x58, 0x78, 0xd2, 0xc4, 0x13, 0x2b, 0x48, 0x66, 0x40, 0x2f, 0x20, 0xc0, 0x22, 0x48, 0xc9,
+	0x05, 0x38, 0xf2, 0x79, 0xa9, 0xe7, 0x01, 0x5e, 0xe4, 0xb3, 0xc4, 0x6c, 0x5a, 0x9f, 0x85, 0xba,
+	0xb0, 0x30, 0x64, 0xee, 0x28, 0xfa, 0x15, 0x39, 0xf6, 0x6c, 0xc2, 0xc5, 0x5c, 0x4a, 0xc8, 0xde,
+	0xda, 0xdf, 0x8f, 0xed, 0xe8, 0x15, 0xfc, 0xdf, 0xc1, 0x33, 0x33, 0xc4, 0x1e, 0xc5, 0xae, 0x45,
+	0xc4, 0xff, 0x52, 0xe2, 0x76, 0x1d, 0x3c, 0x3b, 0x5b, 0x9b, 0x6b, 0x1f, 0x01, 0x2c, 0x6c, 0xbe,
+	0x6b, 0x33, 0xf0, 0x42, 0x82, 0xca, 0xf0, 0x40, 0xeb, 0x9c, 0xb4, 0xeb, 0x7d, 0xad, 0xdb, 0x31,
+	0x9b, 0x03, 0xe3, 0x4c, 0x35, 0xd5, 0x73, 0xbd, 0xdb, 0x51, 0x3b, 0x7d, 0xad, 0xde, 0x2e, 0x0a,
+	0x48, 0x82, 0xa5, 0xdb, 0x82, 0x13, 0xed, 0x5c, 0x6d, 0x99, 0x46, 0xbd, 0xaf, 0x16, 0x01, 0x7a,
+	0x02, 0x1f, 0xdf, 0xbe, 0xdf, 0xaf, 0x6b, 0x6d, 0x53, 0x7d, 0xad, 0xf5, 0x7a, 0x5a, 0xb7, 0x53,
+	0xcc, 0xfc, 0x4d, 0xd2, 0x1b, 0xe8, 0x7a, 0xfb, 0x8d, 0xd9, 0xac, 0xeb, 0xba, 0xda, 0x2a, 0x66,
+	0x4b, 0xb9, 0xf7, 0x9f, 0x24, 0xa1, 0xa1, 0x5d, 0x2d, 0x25, 0x70, 0xbd, 0x94, 0xc0, 0xf7, 0xa5,
+	0x04, 0x3e, 0xac, 0x24, 0xe1, 0x7a, 0x25, 0x09, 0x5f, 0x57, 0x92, 0xf0, 0x56, 0xb1, 0x29, 0x1f,
+	0x07, 0x43, 0xd9, 0x62, 0x8e, 0x92, 0x2c, 0x9a, 0xe4, 0x0c, 0x8f, 0x9f, 0x29, 0xb3, 0x3f, 0x97,
+	0x0e, 0x9f, 0x4f, 0x89, 0x3f, 0xdc, 0x89, 0x97, 0xc6, 0xf3, 0x5f, 0x03, 0x00, 0xe7, 0x0f, 0x5a,
+	0x02, 0x97, 0x04, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -186,6 +299,20 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.CommunityPool.Size()
 		i -= size
@@ -219,6 +346,46 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -315,6 +482,27 @@ func (m *InflationDistribution) Size() (n int) {
 	n += 1 + l + sovInflation(uint64(l))
 	l = m.CommunityPool.Size()
 	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
 	return n
 }
 
@@ -474,6 +662,156 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, InflationRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInflation(dAtA[iNdEx:])
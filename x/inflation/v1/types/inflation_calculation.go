@@ -15,8 +15,42 @@ const (
 	ReductionFactor = 3
 )
 
-// CalculateEpochProvisions returns mint provision per epoch. The function used to compute the
-// emission is the half life times a reduction factor:
+// CalculateEpochMintProvision returns the mint provision per epoch of the
+// inflation curve selected in the params. The total supply is given in the
+// base denomination and is only used by the curves that depend on it.
+func CalculateEpochMintProvision(
+	params Params,
+	period uint64,
+	epochsPerPeriod int64,
+	bondedRatio math.LegacyDec,
+	totalSupply math.LegacyDec,
+) math.LegacyDec {
+	if epochsPerPeriod == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	switch params.InflationCurve {
+	case INFLATION_CURVE_FIXED_RATE:
+		return totalSupply.Mul(params.FixedRate).QuoInt64(epochsPerPeriod)
+	case INFLATION_CURVE_TAIL_EMISSION:
+		epochProvision := calculateExponentialEpochMintProvision(params, period, epochsPerPeriod, bondedRatio)
+		tailProvision := totalSupply.Mul(params.TailRate).QuoInt64(epochsPerPeriod)
+		return math.LegacyMaxDec(epochProvision, tailProvision)
+	case INFLATION_CURVE_SUPPLY_CAPPED:
+		epochProvision := calculateExponentialEpochMintProvision(params, period, epochsPerPeriod, bondedRatio)
+		remaining := math.LegacyNewDecFromInt(params.MaxSupply).Sub(totalSupply)
+		if !remaining.IsPositive() {
+			return math.LegacyZeroDec()
+		}
+		return math.LegacyMinDec(epochProvision, remaining)
+	default:
+		return calculateExponentialEpochMintProvision(params, period, epochsPerPeriod, bondedRatio)
+	}
+}
+
+// calculateExponentialEpochMintProvision returns mint provision per epoch. The
+// function used to compute the emission is the half life times a reduction
+// factor:
 //
 // f(x) = { a * (1 -r ) ^ x * [1 + maxVariance * (1 - bondedRatio / bTarget)] + c} / reductionFactor
 //
@@ -24,7 +58,7 @@ const (
 // current year with respect to the starting year. Then, f(x) is computed and from this, the epoch
 // emission. For example, having x=0, the tokens minted for a specific epochs are proportional to
 // f(0) / numberOfEpochs.
-func CalculateEpochMintProvision(
+func calculateExponentialEpochMintProvision(
 	params Params,
 	period uint64,
 	epochsPerPeriod int64,
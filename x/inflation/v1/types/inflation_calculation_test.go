@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -145,6 +146,7 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 				tc.period,
 				epochsPerPeriod,
 				tc.bondedRatio,
+				math.LegacyZeroDec(),
 			)
 
 			// Here we use a relative error because the expected values are computed with another
@@ -157,3 +159,104 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 		})
 	}
 }
+
+func (suite *InflationTestSuite) TestCalculateEpochMintProvisionCurves() {
+	epochsPerPeriod := int64(365)
+	totalSupply := math.LegacyNewDec(1_000_000_000).Mul(math.LegacyNewDecFromInt(evmostypes.PowerReduction))
+	// (300_000_000 * (1 - 0.5) ** 0 + 9_375_000) / 3 / 365 * 10 ** 18
+	exponentialProvision := math.LegacyMustNewDecFromStr("282534246575342465753425.000000000000000000")
+
+	testCases := []struct {
+		name              string
+		malleate          func(params *Params)
+		period            uint64
+		totalSupply       math.LegacyDec
+		expEpochProvision math.LegacyDec
+	}{
+		{
+			"exponential",
+			func(_ *Params) {},
+			0,
+			totalSupply,
+			exponentialProvision,
+		},
+		{
+			"fixed rate",
+			func(params *Params) {
+				params.InflationCurve = INFLATION_CURVE_FIXED_RATE
+				params.FixedRate = math.LegacyNewDecWithPrec(2, 2)
+			},
+			0,
+			totalSupply,
+			// 1_000_000_000 * 0.02 / 365 * 10 ** 18
+			totalSupply.Mul(math.LegacyNewDecWithPrec(2, 2)).QuoInt64(epochsPerPeriod),
+		},
+		{
+			"tail emission - exponential above the tail",
+			func(params *Params) {
+				params.InflationCurve = INFLATION_CURVE_TAIL_EMISSION
+				params.TailRate = math.LegacyNewDecWithPrec(1, 2)
+			},
+			0,
+			totalSupply,
+			exponentialProvision,
+		},
+		{
+			"tail emission - exponential below the tail",
+			func(params *Params) {
+				params.InflationCurve = INFLATION_CURVE_TAIL_EMISSION
+				params.TailRate = math.LegacyNewDecWithPrec(1, 2)
+			},
+			20,
+			totalSupply,
+			// 1_000_000_000 * 0.01 / 365 * 10 ** 18
+			totalSupply.Mul(math.LegacyNewDecWithPrec(1, 2)).QuoInt64(epochsPerPeriod),
+		},
+		{
+			"supply capped - below the cap",
+			func(params *Params) {
+				params.InflationCurve = INFLATION_CURVE_SUPPLY_CAPPED
+				params.MaxSupply = totalSupply.MulInt64(2).TruncateInt()
+			},
+			0,
+			totalSupply,
+			exponentialProvision,
+		},
+		{
+			"supply capped - mints up to the cap",
+			func(params *Params) {
+				params.InflationCurve = INFLATION_CURVE_SUPPLY_CAPPED
+				params.MaxSupply = totalSupply.Add(math.LegacyNewDec(100)).TruncateInt()
+			},
+			0,
+			totalSupply,
+			math.LegacyNewDec(100),
+		},
+		{
+			"supply capped - cap reached",
+			func(params *Params) {
+				params.InflationCurve = INFLATION_CURVE_SUPPLY_CAPPED
+				params.MaxSupply = totalSupply.TruncateInt()
+			},
+			0,
+			totalSupply.MulInt64(2),
+			math.LegacyZeroDec(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := DefaultParams()
+			tc.malleate(&params)
+			suite.Require().NoError(params.Validate())
+
+			epochMintProvision := CalculateEpochMintProvision(
+				params,
+				tc.period,
+				epochsPerPeriod,
+				math.LegacyOneDec(),
+				tc.totalSupply,
+			)
+			suite.Require().Equal(tc.expEpochProvision, epochMintProvision)
+		})
+	}
+}
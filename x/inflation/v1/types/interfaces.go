@@ -30,6 +30,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	HasSupply(ctx context.Context, denom string) bool
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
@@ -45,6 +46,12 @@ type StakingKeeper interface {
 	TotalBondedTokens(ctx context.Context) (math.Int, error)
 }
 
+// VestingKeeper defines the expected vesting keeper used to exclude the tokens
+// held by vesting accounts from the circulating supply
+type VestingKeeper interface {
+	GetNonSpendableSupply(ctx sdk.Context) sdk.Coins
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
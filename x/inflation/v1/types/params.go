@@ -10,6 +10,8 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evmostypes "github.com/evmos/evmos/v20/types"
 )
 
@@ -36,10 +38,18 @@ var (
 		MaxVariance:   math.LegacyZeroDec(),             // 0%
 	}
 	DefaultInflationDistribution = InflationDistribution{
-		StakingRewards:  math.LegacyNewDecWithPrec(533333334, 9), // 0.53
-		CommunityPool:   math.LegacyNewDecWithPrec(466666666, 9), // 0.47
-		UsageIncentives: math.LegacyZeroDec(),                    // Deprecated
+		StakingRewards:  math.LegacyZeroDec(), // Deprecated
+		CommunityPool:   math.LegacyZeroDec(), // Deprecated
+		UsageIncentives: math.LegacyZeroDec(), // Deprecated
+		Recipients: []InflationRecipient{
+			{Recipient: authtypes.FeeCollectorName, Weight: math.LegacyNewDecWithPrec(533333334, 9)}, // 0.53
+			{Recipient: distrtypes.ModuleName, Weight: math.LegacyNewDecWithPrec(466666666, 9)},      // 0.47
+		},
 	}
+	DefaultInflationCurve = INFLATION_CURVE_EXPONENTIAL
+	DefaultFixedRate      = math.LegacyZeroDec()
+	DefaultTailRate       = math.LegacyZeroDec()
+	DefaultMaxSupply      = math.ZeroInt()
 )
 
 func NewParams(
@@ -53,6 +63,10 @@ func NewParams(
 		ExponentialCalculation: exponentialCalculation,
 		InflationDistribution:  inflationDistribution,
 		EnableInflation:        enableInflation,
+		InflationCurve:         DefaultInflationCurve,
+		FixedRate:              DefaultFixedRate,
+		TailRate:               DefaultTailRate,
+		MaxSupply:              DefaultMaxSupply,
 	}
 }
 
@@ -63,6 +77,10 @@ func DefaultParams() Params {
 		ExponentialCalculation: DefaultExponentialCalculation,
 		InflationDistribution:  DefaultInflationDistribution,
 		EnableInflation:        DefaultInflation,
+		InflationCurve:         DefaultInflationCurve,
+		FixedRate:              DefaultFixedRate,
+		TailRate:               DefaultTailRate,
+		MaxSupply:              DefaultMaxSupply,
 	}
 }
 
@@ -127,26 +145,120 @@ func validateInflationDistribution(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if v.StakingRewards.IsNegative() {
-		return errors.New("staking distribution ratio must not be negative")
+	if !isZeroOrNil(v.StakingRewards) || !isZeroOrNil(v.CommunityPool) { //nolint:staticcheck
+		return errors.New("staking rewards and community pool distributions are deprecated. Use the recipients param instead")
 	}
 
-	if !v.UsageIncentives.IsZero() {
+	if !isZeroOrNil(v.UsageIncentives) { //nolint:staticcheck
 		return errors.New("incentives pool distribution is deprecated. UsageIncentives param should be zero")
 	}
 
-	if v.CommunityPool.IsNegative() {
-		return errors.New("community pool distribution ratio must not be negative")
+	if len(v.Recipients) == 0 {
+		return errors.New("inflation distribution recipients cannot be empty")
 	}
 
-	totalProportions := v.StakingRewards.Add(v.UsageIncentives).Add(v.CommunityPool)
-	if !totalProportions.Equal(math.LegacyNewDec(1)) {
+	seenRecipients := make(map[string]bool)
+	totalWeight := math.LegacyZeroDec()
+	for _, recipient := range v.Recipients {
+		if err := recipient.Validate(); err != nil {
+			return err
+		}
+
+		if seenRecipients[recipient.Recipient] {
+			return fmt.Errorf("duplicate inflation recipient %s", recipient.Recipient)
+		}
+		seenRecipients[recipient.Recipient] = true
+
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(math.LegacyNewDec(1)) {
 		return errors.New("total distributions ratio should be 1")
 	}
 
 	return nil
 }
 
+// isZeroOrNil returns true if the given decimal is zero or not set.
+func isZeroOrNil(d math.LegacyDec) bool {
+	return d.IsNil() || d.IsZero()
+}
+
+func validateRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// unset rates are not used by the default curve
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("rate cannot be negative: %s", v)
+	}
+
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("rate cannot be greater than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+// validateInflationCurve checks that the curve is known and that the params
+// it depends on are set.
+func (p Params) validateInflationCurve() error {
+	if err := validateRate(p.FixedRate); err != nil {
+		return fmt.Errorf("invalid fixed rate: %w", err)
+	}
+	if err := validateRate(p.TailRate); err != nil {
+		return fmt.Errorf("invalid tail rate: %w", err)
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	switch p.InflationCurve {
+	case INFLATION_CURVE_EXPONENTIAL:
+		return nil
+	case INFLATION_CURVE_FIXED_RATE:
+		if p.FixedRate.IsNil() {
+			return errors.New("fixed rate must be set for the fixed rate curve")
+		}
+		return nil
+	case INFLATION_CURVE_TAIL_EMISSION:
+		if p.TailRate.IsNil() || !p.TailRate.IsPositive() {
+			return errors.New("tail rate must be positive for the tail emission curve")
+		}
+		return nil
+	case INFLATION_CURVE_SUPPLY_CAPPED:
+		if p.MaxSupply.IsNil() || !p.MaxSupply.IsPositive() {
+			return errors.New("max supply must be positive for the supply capped curve")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid inflation curve: %s", p.InflationCurve)
+	}
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -166,6 +278,9 @@ func (p Params) Validate() error {
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
+	if err := p.validateInflationCurve(); err != nil {
+		return err
+	}
 
 	return validateBool(p.EnableInflation)
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -25,9 +27,13 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 	}
 
 	validInflationDistribution := InflationDistribution{
-		StakingRewards:  math.LegacyNewDecWithPrec(533334, 6),
+		StakingRewards:  math.LegacyZeroDec(),
 		UsageIncentives: math.LegacyZeroDec(),
-		CommunityPool:   math.LegacyNewDecWithPrec(466666, 6),
+		CommunityPool:   math.LegacyZeroDec(),
+		Recipients: []InflationRecipient{
+			NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(533334, 6)),
+			NewInflationRecipient(distrtypes.ModuleName, math.LegacyNewDecWithPrec(466666, 6)),
+		},
 	}
 
 	testCases := []struct {
@@ -248,6 +254,211 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			},
 			true,
 		},
+		{
+			"invalid - inflation distribution - deprecated staking rewards and community pool",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  math.LegacyNewDecWithPrec(533334, 6),
+					UsageIncentives: math.LegacyZeroDec(),
+					CommunityPool:   math.LegacyNewDecWithPrec(466666, 6),
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"valid - inflation distribution - unset deprecated fields",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					Recipients: []InflationRecipient{
+						NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(5, 1)),
+						NewInflationRecipient(authtypes.NewModuleAddress("ecosystem").String(), math.LegacyNewDecWithPrec(3, 1)),
+						NewInflationRecipient(BurnRecipient, math.LegacyNewDecWithPrec(2, 1)),
+					},
+				},
+				EnableInflation: true,
+			},
+			false,
+		},
+		{
+			"invalid - inflation distribution - no recipients",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  InflationDistribution{},
+				EnableInflation:        true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - blank recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					Recipients: []InflationRecipient{
+						NewInflationRecipient(" ", math.LegacyOneDec()),
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - zero weight",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					Recipients: []InflationRecipient{
+						NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyOneDec()),
+						NewInflationRecipient(distrtypes.ModuleName, math.LegacyZeroDec()),
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate recipient",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					Recipients: []InflationRecipient{
+						NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(5, 1)),
+						NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(5, 1)),
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - total weight unequal 1",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					Recipients: []InflationRecipient{
+						NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyNewDecWithPrec(5, 1)),
+						NewInflationRecipient(distrtypes.ModuleName, math.LegacyNewDecWithPrec(4, 1)),
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"valid - fixed rate curve",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_FIXED_RATE,
+				FixedRate:              math.LegacyNewDecWithPrec(2, 2),
+			},
+			false,
+		},
+		{
+			"invalid - fixed rate curve - rate greater than 1",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_FIXED_RATE,
+				FixedRate:              math.LegacyNewDec(2),
+			},
+			true,
+		},
+		{
+			"invalid - fixed rate curve - unset rate",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_FIXED_RATE,
+			},
+			true,
+		},
+		{
+			"valid - tail emission curve",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_TAIL_EMISSION,
+				TailRate:               math.LegacyNewDecWithPrec(1, 2),
+			},
+			false,
+		},
+		{
+			"invalid - tail emission curve - zero tail rate",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_TAIL_EMISSION,
+				TailRate:               math.LegacyZeroDec(),
+			},
+			true,
+		},
+		{
+			"invalid - tail emission curve - negative tail rate",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_TAIL_EMISSION,
+				TailRate:               math.LegacyNewDecWithPrec(-1, 2),
+			},
+			true,
+		},
+		{
+			"valid - supply capped curve",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_SUPPLY_CAPPED,
+				MaxSupply:              math.NewInt(1_000_000_000),
+			},
+			false,
+		},
+		{
+			"invalid - supply capped curve - zero max supply",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         INFLATION_CURVE_SUPPLY_CAPPED,
+				MaxSupply:              math.ZeroInt(),
+			},
+			true,
+		},
+		{
+			"invalid - unknown inflation curve",
+			Params{
+				MintDenom:              DefaultInflationDenom,
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				InflationCurve:         InflationCurve(10),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -448,6 +448,206 @@ func (m *QueryInflationRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationRateResponse proto.InternalMessageInfo
 
+// QueryInflationProjectionRequest is the request type for the
+// Query/InflationProjection RPC method.
+type QueryInflationProjectionRequest struct {
+	// periods is the number of periods to project, starting with the current one
+	Periods uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+	// bonded_ratio is the bonded ratio assumed for the projection. The current
+	// bonded ratio is used if empty.
+	BondedRatio string `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3" json:"bonded_ratio,omitempty"`
+	// params are the hypothetical params used for the projection. The current
+	// params are used if not set.
+	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryInflationProjectionRequest) Reset()         { *m = QueryInflationProjectionRequest{} }
+func (m *QueryInflationProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionRequest) ProtoMessage()    {}
+func (*QueryInflationProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{10}
+}
+func (m *QueryInflationProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionRequest.Merge(m, src)
+}
+func (m *QueryInflationProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionRequest) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *QueryInflationProjectionRequest) GetBondedRatio() string {
+	if m != nil {
+		return m.BondedRatio
+	}
+	return ""
+}
+
+func (m *QueryInflationProjectionRequest) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// QueryInflationProjectionResponse is the response type for the
+// Query/InflationProjection RPC method.
+type QueryInflationProjectionResponse struct {
+	// projections of each period
+	Projections []PeriodProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+	// bonded_ratio is the bonded ratio assumed for the projection
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+}
+
+func (m *QueryInflationProjectionResponse) Reset()         { *m = QueryInflationProjectionResponse{} }
+func (m *QueryInflationProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionResponse) ProtoMessage()    {}
+func (*QueryInflationProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{11}
+}
+func (m *QueryInflationProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionResponse.Merge(m, src)
+}
+func (m *QueryInflationProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionResponse) GetProjections() []PeriodProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// PeriodProjection is the projected minting and supply of a period.
+type PeriodProjection struct {
+	// period is the number of the period
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// epoch_mint_provision is the provision minted on the first epoch of the
+	// period
+	EpochMintProvision types.DecCoin `protobuf:"bytes,2,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision"`
+	// period_mint_provision is the total provision minted during the period
+	PeriodMintProvision types.DecCoin `protobuf:"bytes,3,opt,name=period_mint_provision,json=periodMintProvision,proto3" json:"period_mint_provision"`
+	// total_supply is the total supply at the end of the period
+	TotalSupply types.DecCoin `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply"`
+	// circulating_supply is the circulating supply at the end of the period,
+	// assuming that the tokens held by vesting accounts do not change
+	CirculatingSupply types.DecCoin `protobuf:"bytes,5,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply"`
+}
+
+func (m *PeriodProjection) Reset()         { *m = PeriodProjection{} }
+func (m *PeriodProjection) String() string { return proto.CompactTextString(m) }
+func (*PeriodProjection) ProtoMessage()    {}
+func (*PeriodProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{12}
+}
+func (m *PeriodProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodProjection.Merge(m, src)
+}
+func (m *PeriodProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodProjection proto.InternalMessageInfo
+
+func (m *PeriodProjection) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodProjection) GetEpochMintProvision() types.DecCoin {
+	if m != nil {
+		return m.EpochMintProvision
+	}
+	return types.DecCoin{}
+}
+
+func (m *PeriodProjection) GetPeriodMintProvision() types.DecCoin {
+	if m != nil {
+		return m.PeriodMintProvision
+	}
+	return types.DecCoin{}
+}
+
+func (m *PeriodProjection) GetTotalSupply() types.DecCoin {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return types.DecCoin{}
+}
+
+func (m *PeriodProjection) GetCirculatingSupply() types.DecCoin {
+	if m != nil {
+		return m.CirculatingSupply
+	}
+	return types.DecCoin{}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -456,7 +656,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b9f1b5d47c7fd7, []int{10}
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{13}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +695,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b9f1b5d47c7fd7, []int{11}
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{14}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,6 +742,9 @@ func init() {
 	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "evmos.inflation.v1.QueryCirculatingSupplyResponse")
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "evmos.inflation.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "evmos.inflation.v1.QueryInflationRateResponse")
+	proto.RegisterType((*QueryInflationProjectionRequest)(nil), "evmos.inflation.v1.QueryInflationProjectionRequest")
+	proto.RegisterType((*QueryInflationProjectionResponse)(nil), "evmos.inflation.v1.QueryInflationProjectionResponse")
+	proto.RegisterType((*PeriodProjection)(nil), "evmos.inflation.v1.PeriodProjection")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.inflation.v1.QueryParamsResponse")
 }
@@ -549,52 +752,65 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/query.proto", fileDescriptor_91b9f1b5d47c7fd7) }
 
 var fileDescriptor_91b9f1b5d47c7fd7 = []byte{
-	// 715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x3d, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc6, 0x73, 0x08, 0x82, 0x7a, 0xa8, 0x95, 0x7a, 0x54, 0x08, 0xdc, 0xe2, 0x54, 0x16, 0x94,
-	0xa8, 0xa8, 0x77, 0x4d, 0xba, 0xb0, 0xb0, 0xb4, 0x65, 0xa8, 0x04, 0xa2, 0xa4, 0x4c, 0x2c, 0x91,
-	0xe3, 0x1c, 0xee, 0xa9, 0xf5, 0x9d, 0xeb, 0x73, 0x22, 0x32, 0xb0, 0x20, 0x21, 0x31, 0x22, 0xb1,
-	0x31, 0x30, 0x57, 0x15, 0x12, 0x7c, 0x8c, 0x8e, 0x95, 0x58, 0x10, 0x43, 0x41, 0x0d, 0x12, 0x5f,
-	0x03, 0xf9, 0xee, 0x9c, 0xc6, 0x8d, 0x9d, 0xd2, 0x81, 0xc5, 0x71, 0xee, 0xff, 0xf6, 0xdc, 0xe3,
-	0xfb, 0xd9, 0xd0, 0xa6, 0xdd, 0x40, 0x48, 0xc2, 0xf8, 0xcb, 0x5d, 0x37, 0x66, 0x82, 0x93, 0x6e,
-	0x8d, 0xec, 0x75, 0x68, 0xd4, 0xc3, 0x61, 0x24, 0x62, 0x81, 0x90, 0x8a, 0xe3, 0x41, 0x1c, 0x77,
-	0x6b, 0xd6, 0xb4, 0x1b, 0x30, 0x2e, 0x88, 0xba, 0xea, 0x34, 0xcb, 0xf6, 0x84, 0x4c, 0xfa, 0xb4,
-	0x5c, 0x49, 0x49, 0xb7, 0xd6, 0xa2, 0xb1, 0x5b, 0x23, 0x9e, 0x60, 0xdc, 0xc4, 0xe7, 0x73, 0xc6,
-	0xf8, 0x94, 0x53, 0xc9, 0xa4, 0xc9, 0x98, 0xf1, 0x85, 0x2f, 0xd4, 0x2d, 0x49, 0xee, 0xcc, 0xea,
-	0x9c, 0x2f, 0x84, 0xbf, 0x4b, 0x89, 0x1b, 0x32, 0xe2, 0x72, 0x2e, 0x62, 0x55, 0x6d, 0x6a, 0x9c,
-	0x19, 0x88, 0x9e, 0x25, 0x5a, 0x37, 0x69, 0xc4, 0x44, 0xbb, 0x41, 0xf7, 0x3a, 0x54, 0xc6, 0xce,
-	0x12, 0xbc, 0x9e, 0x59, 0x95, 0xa1, 0xe0, 0x92, 0xa2, 0x1b, 0xb0, 0x1c, 0xaa, 0x95, 0x9b, 0x60,
-	0x1e, 0x54, 0x2f, 0x37, 0xcc, 0x3f, 0x67, 0x1e, 0xda, 0x2a, 0xfd, 0x51, 0x28, 0xbc, 0xed, 0x27,
-	0x8c, 0xc7, 0x9b, 0x91, 0xe8, 0x32, 0xc9, 0x04, 0x4f, 0x1b, 0x7e, 0x06, 0xb0, 0x52, 0x98, 0x62,
-	0xba, 0xbf, 0x03, 0x70, 0x86, 0x26, 0xe1, 0x66, 0xc0, 0x78, 0xdc, 0x0c, 0xd3, 0x04, 0x35, 0xec,
-	0x5a, 0x7d, 0x0e, 0x6b, 0x83, 0x70, 0x62, 0x10, 0x36, 0x06, 0xe1, 0x75, 0xea, 0xad, 0x09, 0xc6,
-	0x57, 0x1f, 0x1c, 0x1e, 0x57, 0x4a, 0x07, 0x3f, 0x2b, 0xf7, 0x7d, 0x16, 0x6f, 0x77, 0x5a, 0xd8,
-	0x13, 0x01, 0x31, 0x86, 0xea, 0x9f, 0x25, 0xd9, 0xde, 0x21, 0x71, 0x2f, 0xa4, 0x32, 0xad, 0x91,
-	0xfb, 0x7f, 0xbe, 0x2e, 0x82, 0x06, 0xa2, 0x23, 0x92, 0x9c, 0x59, 0x78, 0x4b, 0xa9, 0xdd, 0xda,
-	0x61, 0x61, 0x48, 0xdb, 0x4a, 0xb4, 0x4c, 0xf7, 0xb2, 0x06, 0xad, 0xbc, 0xa0, 0xd9, 0xc5, 0x5d,
-	0x38, 0x25, 0x75, 0xa0, 0xa9, 0x1a, 0x4b, 0xe3, 0xd5, 0xa4, 0x1c, 0x4e, 0x77, 0x2a, 0xf0, 0xb6,
-	0x6a, 0xb2, 0xc6, 0x22, 0xaf, 0x93, 0x3c, 0x50, 0xee, 0x6f, 0x75, 0xc2, 0x70, 0xb7, 0x97, 0x4e,
-	0xd9, 0x07, 0xc6, 0xd4, 0x9c, 0x0c, 0x33, 0xea, 0x2d, 0x80, 0xc8, 0x3b, 0x8d, 0x36, 0xa5, 0x0a,
-	0xff, 0x67, 0xbb, 0xa6, 0xbd, 0xb3, 0x7a, 0x06, 0x6e, 0x6d, 0xa4, 0x47, 0xb3, 0xe1, 0xc6, 0x34,
-	0xdd, 0x47, 0x60, 0xdc, 0x3a, 0x13, 0x34, 0x5b, 0x78, 0x0a, 0xa7, 0x06, 0x07, 0xba, 0x19, 0xb9,
-	0x31, 0x55, 0xea, 0x27, 0x56, 0xab, 0x89, 0xbe, 0x1f, 0xc7, 0x95, 0x59, 0xad, 0x46, 0xb6, 0x77,
-	0x30, 0x13, 0x24, 0x70, 0xe3, 0x6d, 0xfc, 0x98, 0xfa, 0xae, 0xd7, 0x5b, 0xa7, 0x9e, 0xd6, 0x33,
-	0xc9, 0x86, 0x1b, 0x9f, 0x9e, 0x67, 0x37, 0x72, 0x83, 0xc1, 0x23, 0x7b, 0x9e, 0x9e, 0x67, 0xb3,
-	0x6a, 0xa6, 0x3f, 0x84, 0xe5, 0x50, 0xad, 0x18, 0xcf, 0x2c, 0x3c, 0x8a, 0x2a, 0xd6, 0x35, 0xab,
-	0x13, 0x89, 0x22, 0x3d, 0xd2, 0x14, 0xd5, 0x3f, 0x5d, 0x85, 0x57, 0x54, 0x5b, 0xf4, 0x1a, 0x96,
-	0x35, 0x2a, 0x68, 0x21, 0xaf, 0xc5, 0x28, 0x61, 0xd6, 0xbd, 0x73, 0xf3, 0xb4, 0x46, 0xc7, 0x79,
-	0xf3, 0xed, 0xf7, 0x87, 0x4b, 0x73, 0xc8, 0x22, 0x39, 0xfc, 0x6b, 0xfe, 0xd0, 0x17, 0x00, 0xd1,
-	0x28, 0x58, 0xa8, 0x5e, 0x38, 0xa3, 0x10, 0x54, 0x6b, 0xe5, 0x42, 0x35, 0x46, 0xe3, 0xb2, 0xd2,
-	0xb8, 0x88, 0xaa, 0x79, 0x1a, 0xf3, 0x90, 0x46, 0x1f, 0x01, 0x9c, 0xcc, 0xf0, 0x83, 0x96, 0x0a,
-	0x07, 0xe7, 0x41, 0x68, 0xe1, 0x7f, 0x4d, 0x37, 0x12, 0x17, 0x95, 0xc4, 0x3b, 0xc8, 0xc9, 0x93,
-	0x98, 0x05, 0x16, 0x1d, 0x00, 0x38, 0x3d, 0x42, 0x1d, 0xaa, 0x15, 0x4e, 0x2c, 0x62, 0xd8, 0xaa,
-	0x5f, 0xa4, 0xc4, 0x08, 0xc5, 0x4a, 0x68, 0x15, 0x2d, 0xe4, 0x09, 0x1d, 0xa5, 0x5d, 0x39, 0x99,
-	0x61, 0x6b, 0x8c, 0x93, 0x79, 0x80, 0x8e, 0x71, 0x32, 0x17, 0xd9, 0xf1, 0x4e, 0x66, 0x61, 0x56,
-	0x5c, 0x28, 0x56, 0xc6, 0x71, 0x31, 0x4c, 0xea, 0x38, 0x2e, 0x32, 0xec, 0x9e, 0xc3, 0x85, 0x66,
-	0x76, 0xe3, 0xf0, 0xc4, 0x06, 0x47, 0x27, 0x36, 0xf8, 0x75, 0x62, 0x83, 0xf7, 0x7d, 0xbb, 0x74,
-	0xd4, 0xb7, 0x4b, 0xdf, 0xfb, 0x76, 0xe9, 0x05, 0x19, 0x7a, 0xef, 0xe9, 0x7a, 0x7d, 0xed, 0xd6,
-	0x97, 0xc9, 0xab, 0x6c, 0x2f, 0xf5, 0x12, 0x6c, 0x95, 0xd5, 0xe7, 0x72, 0xe5, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x12, 0xe5, 0x58, 0x51, 0xed, 0x07, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xeb, 0x44,
+	0x10, 0xcf, 0x36, 0xef, 0x05, 0xde, 0xe6, 0xf5, 0x89, 0x6e, 0x0a, 0x0a, 0x6e, 0x71, 0x82, 0x55,
+	0xda, 0xa8, 0xa8, 0x76, 0x93, 0x72, 0xe0, 0xc2, 0xa5, 0x2d, 0x87, 0xf2, 0x47, 0xa4, 0x29, 0x42,
+	0x02, 0x0e, 0x91, 0xe3, 0x2c, 0xee, 0xd2, 0xc4, 0xeb, 0x7a, 0x9d, 0x88, 0x1c, 0xb8, 0x20, 0x81,
+	0x38, 0x22, 0xb8, 0xf1, 0x09, 0xaa, 0x0a, 0x09, 0x3e, 0x03, 0xa7, 0x72, 0xab, 0xc4, 0x05, 0x71,
+	0x28, 0xa8, 0x45, 0xe2, 0x6b, 0x20, 0xef, 0xae, 0x13, 0xbb, 0x59, 0x37, 0xc9, 0x81, 0x4b, 0xfe,
+	0xec, 0xcc, 0xfc, 0xe6, 0x37, 0x3f, 0xcf, 0xec, 0x18, 0xea, 0x78, 0xd8, 0xa7, 0xcc, 0x22, 0xde,
+	0x67, 0x3d, 0x3b, 0x24, 0xd4, 0xb3, 0x86, 0x75, 0xeb, 0x7c, 0x80, 0x83, 0x91, 0xe9, 0x07, 0x34,
+	0xa4, 0x08, 0x71, 0xbb, 0x39, 0xb6, 0x9b, 0xc3, 0xba, 0xb6, 0x62, 0xf7, 0x89, 0x47, 0x2d, 0xfe,
+	0x29, 0xdc, 0x34, 0xdd, 0xa1, 0x2c, 0xc2, 0xe9, 0xd8, 0x0c, 0x5b, 0xc3, 0x7a, 0x07, 0x87, 0x76,
+	0xdd, 0x72, 0x28, 0xf1, 0xa4, 0xbd, 0xaa, 0x48, 0xe3, 0x62, 0x0f, 0x33, 0xc2, 0xa4, 0xc7, 0xaa,
+	0x4b, 0x5d, 0xca, 0x7f, 0x5a, 0xd1, 0x2f, 0x79, 0xba, 0xee, 0x52, 0xea, 0xf6, 0xb0, 0x65, 0xfb,
+	0xc4, 0xb2, 0x3d, 0x8f, 0x86, 0x3c, 0x5a, 0xc6, 0x18, 0xab, 0x10, 0x1d, 0x47, 0x5c, 0x9b, 0x38,
+	0x20, 0xb4, 0xdb, 0xc2, 0xe7, 0x03, 0xcc, 0x42, 0x63, 0x07, 0x96, 0x52, 0xa7, 0xcc, 0xa7, 0x1e,
+	0xc3, 0xe8, 0x25, 0x58, 0xf0, 0xf9, 0x49, 0x19, 0x54, 0x41, 0xed, 0x51, 0x4b, 0xfe, 0x33, 0xaa,
+	0x50, 0xe7, 0xee, 0x6f, 0xfb, 0xd4, 0x39, 0x7d, 0x9f, 0x78, 0x61, 0x33, 0xa0, 0x43, 0xc2, 0x08,
+	0xf5, 0x62, 0xc0, 0x9f, 0x00, 0xac, 0x64, 0xba, 0x48, 0xf4, 0x6f, 0x01, 0x5c, 0xc5, 0x91, 0xb9,
+	0xdd, 0x27, 0x5e, 0xd8, 0xf6, 0x63, 0x07, 0x9e, 0xac, 0xd8, 0x58, 0x37, 0x85, 0x40, 0x66, 0x24,
+	0x90, 0x29, 0x05, 0x32, 0x0f, 0xb1, 0x73, 0x40, 0x89, 0xb7, 0xff, 0xe6, 0xd5, 0x4d, 0x25, 0x77,
+	0xf9, 0x57, 0xe5, 0x75, 0x97, 0x84, 0xa7, 0x83, 0x8e, 0xe9, 0xd0, 0xbe, 0x25, 0x05, 0x15, 0x5f,
+	0x3b, 0xac, 0x7b, 0x66, 0x85, 0x23, 0x1f, 0xb3, 0x38, 0x86, 0x5d, 0xfc, 0xfb, 0xcb, 0x36, 0x68,
+	0x21, 0x3c, 0x45, 0xc9, 0x58, 0x83, 0x2f, 0x73, 0xb6, 0x27, 0x67, 0xc4, 0xf7, 0x71, 0x97, 0x93,
+	0x66, 0x71, 0x2d, 0x07, 0x50, 0x53, 0x19, 0x65, 0x15, 0xaf, 0xc1, 0x67, 0x4c, 0x18, 0xda, 0x1c,
+	0x98, 0x49, 0xad, 0x96, 0x59, 0xd2, 0xdd, 0xa8, 0xc0, 0x57, 0x38, 0xc8, 0x01, 0x09, 0x9c, 0x41,
+	0xf4, 0x40, 0x3d, 0xf7, 0x64, 0xe0, 0xfb, 0xbd, 0x51, 0x9c, 0xe5, 0x02, 0x40, 0x3d, 0xcb, 0x43,
+	0xa6, 0xfa, 0x1a, 0x40, 0xe4, 0x4c, 0xac, 0x6d, 0xc6, 0xcd, 0xff, 0xb3, 0x5c, 0x2b, 0xce, 0x7d,
+	0x3e, 0x63, 0xb5, 0x8e, 0xe2, 0xd6, 0x6c, 0xd9, 0x21, 0x8e, 0xeb, 0xe8, 0x43, 0x4d, 0x65, 0x94,
+	0x25, 0x7c, 0x00, 0x9f, 0x8d, 0x1b, 0xba, 0x1d, 0xd8, 0x21, 0xe6, 0xec, 0x9f, 0xec, 0xd7, 0x22,
+	0x7e, 0x7f, 0xde, 0x54, 0xd6, 0x04, 0x1b, 0xd6, 0x3d, 0x33, 0x09, 0xb5, 0xfa, 0x76, 0x78, 0x6a,
+	0xbe, 0x87, 0x5d, 0xdb, 0x19, 0x1d, 0x62, 0x47, 0xf0, 0x59, 0x26, 0x49, 0x60, 0xe3, 0xfb, 0xb8,
+	0xd1, 0xc6, 0xf9, 0x9a, 0x01, 0xfd, 0x1c, 0x3b, 0xe1, 0xa4, 0x19, 0x51, 0x19, 0x3e, 0x27, 0x1a,
+	0x37, 0x7e, 0x36, 0xf1, 0x5f, 0xf4, 0x2a, 0x7c, 0xda, 0xa1, 0x5e, 0x17, 0x77, 0x23, 0x2e, 0x84,
+	0x96, 0x97, 0x22, 0x32, 0xad, 0xa2, 0x38, 0x6b, 0x45, 0x47, 0xa8, 0x01, 0x0b, 0xbe, 0x1d, 0xd8,
+	0x7d, 0x56, 0xce, 0x73, 0x9d, 0x35, 0x73, 0x7a, 0xbc, 0xcd, 0x26, 0xf7, 0x68, 0x49, 0x4f, 0xe3,
+	0x57, 0x00, 0xab, 0xd9, 0xa4, 0xa4, 0x14, 0xc7, 0xb0, 0xe8, 0x8f, 0x4f, 0x23, 0x66, 0xf9, 0x5a,
+	0xb1, 0xb1, 0xa1, 0x44, 0xe7, 0x6c, 0x27, 0x10, 0xfb, 0x4f, 0x22, 0xb5, 0x84, 0x1c, 0x49, 0x0c,
+	0xf4, 0xae, 0xaa, 0x9c, 0x05, 0xb4, 0x4d, 0x16, 0x6e, 0x7c, 0x93, 0x87, 0x2f, 0xdc, 0xcf, 0x9c,
+	0x75, 0x23, 0xa0, 0x8f, 0x33, 0x46, 0x79, 0x69, 0x8e, 0xde, 0x4c, 0x54, 0xa3, 0x98, 0x4d, 0xf4,
+	0x29, 0x7c, 0x51, 0x24, 0xb9, 0x8f, 0x9d, 0x5f, 0x0c, 0xbb, 0x24, 0x50, 0xd2, 0xe0, 0xef, 0xc0,
+	0xa7, 0x21, 0x0d, 0xed, 0x5e, 0x3c, 0x4b, 0x8f, 0x16, 0xc3, 0x2c, 0xf2, 0x60, 0x31, 0x16, 0xe8,
+	0x23, 0xe5, 0x74, 0x3e, 0x5e, 0x0c, 0x51, 0x31, 0x6e, 0xe3, 0x2b, 0x5b, 0x34, 0x99, 0x9c, 0xb3,
+	0x0f, 0x61, 0x29, 0x75, 0x2a, 0xbb, 0xea, 0xad, 0x71, 0xbb, 0x82, 0x59, 0xed, 0x9a, 0x4c, 0x2b,
+	0x83, 0x1a, 0xbf, 0x3d, 0x0f, 0x1f, 0x73, 0x58, 0xf4, 0x25, 0x2c, 0x88, 0xa7, 0x8f, 0x36, 0x55,
+	0x10, 0xd3, 0x4b, 0x44, 0xdb, 0x9a, 0xe9, 0x27, 0x38, 0x1a, 0xc6, 0x57, 0xbf, 0xff, 0xf3, 0xc3,
+	0xd2, 0x3a, 0xd2, 0x2c, 0xc5, 0x8a, 0x93, 0x0d, 0xf5, 0x33, 0x80, 0x68, 0x7a, 0x77, 0xa0, 0x46,
+	0x66, 0x8e, 0xcc, 0x5d, 0xa4, 0xed, 0x2d, 0x14, 0x23, 0x39, 0xee, 0x72, 0x8e, 0xdb, 0xa8, 0xa6,
+	0xe2, 0xa8, 0x6a, 0x75, 0xf4, 0x23, 0x80, 0xcb, 0xa9, 0x15, 0x81, 0x76, 0x32, 0x13, 0xab, 0xf6,
+	0x8c, 0x66, 0xce, 0xeb, 0x2e, 0x29, 0x6e, 0x73, 0x8a, 0x1b, 0xc8, 0x50, 0x51, 0x4c, 0xef, 0x24,
+	0x74, 0x09, 0xe0, 0xca, 0xd4, 0x62, 0x41, 0xf5, 0xcc, 0x8c, 0x59, 0x6b, 0x4a, 0x6b, 0x2c, 0x12,
+	0x22, 0x89, 0x9a, 0x9c, 0x68, 0x0d, 0x6d, 0xaa, 0x88, 0x4e, 0x8f, 0x0c, 0x57, 0x32, 0xb5, 0x3e,
+	0x1e, 0x50, 0x52, 0xb5, 0x83, 0x34, 0x73, 0x5e, 0xf7, 0x79, 0x94, 0x4c, 0xef, 0x2b, 0x74, 0x01,
+	0x60, 0x49, 0x71, 0xad, 0xa3, 0xbd, 0xd9, 0x39, 0xa7, 0x36, 0x93, 0xf6, 0xc6, 0x62, 0x41, 0x92,
+	0xee, 0x26, 0xa7, 0x5b, 0x45, 0xba, 0x72, 0x7e, 0x26, 0x94, 0xa2, 0x11, 0xe6, 0x63, 0xfd, 0xd0,
+	0x08, 0x27, 0x2f, 0x15, 0x6d, 0x6b, 0xa6, 0xdf, 0x5c, 0x23, 0x2c, 0xae, 0x97, 0xa3, 0xab, 0x5b,
+	0x1d, 0x5c, 0xdf, 0xea, 0xe0, 0xef, 0x5b, 0x1d, 0x7c, 0x77, 0xa7, 0xe7, 0xae, 0xef, 0xf4, 0xdc,
+	0x1f, 0x77, 0x7a, 0xee, 0x13, 0x2b, 0xf1, 0x16, 0x22, 0xe2, 0xc5, 0xe7, 0xb0, 0xb1, 0x6b, 0x7d,
+	0x91, 0xc6, 0xe2, 0xaf, 0x24, 0x9d, 0x02, 0x7f, 0x79, 0xdd, 0xfb, 0x6f, 0x00, 0xe0, 0x04, 0xde,
+	0x7d, 0x7b, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -620,6 +836,9 @@ type QueryClient interface {
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// InflationProjection projects the mint provisions and supply of the next
+	// periods with the current or the given hypothetical params.
+	InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -677,6 +896,15 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error) {
+	out := new(QueryInflationProjectionResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/InflationProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/Params", in, out, opts...)
@@ -699,6 +927,9 @@ type QueryServer interface {
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// InflationProjection projects the mint provisions and supply of the next
+	// periods with the current or the given hypothetical params.
+	InflationProjection(context.Context, *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -722,6 +953,9 @@ func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (*UnimplementedQueryServer) InflationProjection(ctx context.Context, req *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjection not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -820,6 +1054,24 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/InflationProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjection(ctx, req.(*QueryInflationProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -838,6 +1090,7 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -862,6 +1115,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "InflationProjection",
+			Handler:    _Query_InflationProjection_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1141,6 +1398,168 @@ func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInflationProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BondedRatio) > 0 {
+		i -= len(m.BondedRatio)
+		copy(dAtA[i:], m.BondedRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondedRatio)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CirculatingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PeriodMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.EpochMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1310,13 +1729,70 @@ func (m *QueryInflationRateResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryInflationProjectionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	l = len(m.BondedRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PeriodProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PeriodMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -1974,6 +2450,462 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInflationProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, PeriodProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
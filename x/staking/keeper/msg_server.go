@@ -61,7 +61,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 
 // validateDelegationAmountNotUnvested checks if the delegator is a clawback vesting account.
 // In such case, checks that the provided delegation amount is available according
-// to the current vesting schedule (unvested coins cannot be delegated).
+// to the current vesting schedule (unvested coins cannot be delegated), unless the
+// funder enabled unvested staking for the account.
 func (k msgServer) validateDelegationAmountNotUnvested(goCtx context.Context, delegatorAddress string, amount math.Int) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	addr, err := sdk.AccAddressFromBech32(delegatorAddress)
@@ -83,6 +84,12 @@ func (k msgServer) validateDelegationAmountNotUnvested(goCtx context.Context, de
 		return nil
 	}
 
+	// the funder can allow the account to delegate unvested coins, which are
+	// undelegated on clawback
+	if clawbackAccount.UnvestedStaking {
+		return nil
+	}
+
 	// vesting account can only delegate
 	// if enough free balance (coins not in vesting schedule)
 	// plus the vested coins (locked/unlocked)
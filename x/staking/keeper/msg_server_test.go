@@ -65,6 +65,20 @@ func TestMsgDelegate(t *testing.T) {
 			expErr: true,
 			errMsg: "cannot delegate unvested coins",
 		},
+		{
+			name: "can delegate unvested coins from a ClawbackVestingAccount with unvested staking",
+			setup: func() sdk.Coin {
+				err := setupClawbackVestingAccount(ctx, nw, delegatorAddr, funderAddr, testutil.TestVestingSchedule.TotalVestingCoins)
+				require.NoError(t, err)
+
+				msg := vestingtypes.NewMsgSetUnvestedStaking(funderAddr, delegatorAddr, true)
+				_, err = nw.App.VestingKeeper.SetUnvestedStaking(ctx, msg)
+				require.NoError(t, err)
+
+				return defaultDelCoin
+			},
+			expErr: false,
+		},
 		{
 			name: "can delegate locked vested coins from a ClawbackVestingAccount",
 			setup: func() sdk.Coin {
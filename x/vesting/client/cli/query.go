@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetClawbackVestingAccountsCmd(),
+		GetUnvestedSupplyCmd(),
 	)
 	return cmd
 }
@@ -62,3 +64,77 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetClawbackVestingAccountsCmd queries all clawback vesting accounts, optionally filtered by funder.
+func GetClawbackVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Gets all clawback vesting accounts and their locked, unvested and vested tokens",
+		Long:  "Gets all clawback vesting accounts and their locked, unvested and vested tokens. Use the --funder flag to only list the accounts funded by the given address.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			funder, err := cmd.Flags().GetString(FlagFunder)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClawbackVestingAccountsRequest{
+				FunderAddress: funder,
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.ClawbackVestingAccounts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFunder, "", "only list the vesting accounts funded by this address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+	return cmd
+}
+
+// GetUnvestedSupplyCmd queries the total locked and unvested tokens of all clawback vesting accounts.
+func GetUnvestedSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unvested-supply",
+		Short: "Gets the total locked and unvested tokens held by all clawback vesting accounts",
+		Long:  "Gets the total locked and unvested tokens held by all clawback vesting accounts as of the last day epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnvestedSupply(context.Background(), &types.QueryUnvestedSupplyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(
+				fmt.Sprintf("Locked: %s\nUnvested: %s\n", res.Locked, res.Unvested))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
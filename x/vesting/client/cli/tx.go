@@ -26,6 +26,10 @@ const (
 	FlagVesting  = "vesting"
 	FlagClawback = "clawback"
 	FlagFunder   = "funder"
+	FlagUnlock   = "unlock"
+	FlagPeriod   = "period"
+
+	FlagValidateOnly = "validate-only"
 )
 
 // NewTxCmd returns a root CLI command handler for vesting
@@ -45,6 +49,11 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgAccelerateVestingCmd(),
+		NewMsgAcceleratePeriodCmd(),
+		NewMsgExtendLockupCmd(),
+		NewMsgBatchFundVestingAccountsCmd(),
+		NewMsgSetUnvestedStakingCmd(),
 	)
 
 	return txCmd
@@ -267,3 +276,220 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgAccelerateVestingCmd returns a CLI command handler for vesting all the
+// unvested coins of a clawback vesting account.
+func NewMsgAccelerateVestingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accelerate-vesting VESTING_ACCOUNT_ADDRESS",
+		Short: "Vest all the unvested coins of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from).
+The unvested coins of VESTING_ACCOUNT_ADDRESS vest at the current block time.
+With --unlock, the locked coins are unlocked as well.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			unlock, _ := cmd.Flags().GetBool(FlagUnlock)
+
+			msg := types.NewMsgAccelerateVesting(clientCtx.GetFromAddress(), vestingAcc, unlock)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagUnlock, false, "Unlock the locked coins as well")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgAcceleratePeriodCmd returns a CLI command handler for vesting a single
+// future vesting period of a clawback vesting account.
+func NewMsgAcceleratePeriodCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accelerate-period VESTING_ACCOUNT_ADDRESS PERIOD_INDEX",
+		Short: "Vest a single future vesting period of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from).
+The vesting period at PERIOD_INDEX in the vesting schedule of VESTING_ACCOUNT_ADDRESS
+vests at the current block time.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			periodIndex, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceleratePeriod(clientCtx.GetFromAddress(), vestingAcc, uint32(periodIndex))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgExtendLockupCmd returns a CLI command handler for delaying the future
+// unlocking events of a clawback vesting account.
+func NewMsgExtendLockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-lockup VESTING_ACCOUNT_ADDRESS EXTENSION",
+		Short: "Delay the future unlocking events of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from) and signed by VESTING_ACCOUNT_ADDRESS as well.
+The future unlocking events are delayed by EXTENSION (e.g. 720h).
+Use --generate-only to create the transaction and collect the signature of the vesting account.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			extension, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendLockup(clientCtx.GetFromAddress(), vestingAcc, extension)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgBatchFundVestingAccountsCmd returns a CLI command handler for funding
+// multiple clawback vesting accounts from a CSV file.
+func NewMsgBatchFundVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-fund-vesting-accounts CSV_FILE",
+		Short: "Fund multiple vesting accounts with allocations of tokens from a CSV file.",
+		Long: `Each row of the CSV file describes a grant as address,amount,start,cliff,duration.
+The start time is given as a unix timestamp or in RFC3339 format, the cliff and duration
+as seconds or as a duration string (e.g. 8760h). The amount vests linearly over the duration,
+with a vesting event at the end of every period (--period). Nothing vests before the cliff.
+The coins are unlocked immediately. Grants with the same schedule share a template.
+The vesting accounts must already exist and be funded by the --from address.
+
+Use --validate-only to check every row and report their errors without creating a transaction.`,
+		Example: `Sample CSV file contents:
+address,amount,start,cliff,duration
+evmos1...,1000000aevmos,1672531200,8760h,35040h
+evmos1...,2000000aevmos,2023-01-01T00:00:00Z,31536000,126144000`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			period, err := cmd.Flags().GetDuration(FlagPeriod)
+			if err != nil {
+				return err
+			}
+			if period < time.Second {
+				return fmt.Errorf("period must be at least one second, got %s", period)
+			}
+
+			validateOnly, err := cmd.Flags().GetBool(FlagValidateOnly)
+			if err != nil {
+				return err
+			}
+
+			rows, rowErrors, err := ReadBatchGrantsFile(args[0], int64(period/time.Second))
+			if err != nil {
+				return err
+			}
+
+			for _, rowErr := range rowErrors {
+				cmd.PrintErrln(rowErr.Error())
+			}
+			if len(rowErrors) > 0 {
+				return fmt.Errorf("found %d invalid rows", len(rowErrors))
+			}
+
+			msg := NewBatchFundMsg(clientCtx.GetFromAddress(), rows)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if validateOnly {
+				total := sdk.NewCoins()
+				for _, row := range rows {
+					total = total.Add(row.VestingPeriods.TotalAmount()...)
+				}
+				return clientCtx.PrintString(
+					fmt.Sprintf("Grants: %d\nTemplates: %d\nTotal: %s\n", len(msg.Grants), len(msg.Templates), total))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagPeriod, 30*24*time.Hour, "length of the vesting periods")
+	cmd.Flags().Bool(FlagValidateOnly, false, "validate the CSV file without creating a transaction")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgSetUnvestedStakingCmd returns a CLI command handler for allowing or
+// disallowing a clawback vesting account to delegate its unvested coins.
+func NewMsgSetUnvestedStakingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-unvested-staking VESTING_ACCOUNT_ADDRESS ENABLED",
+		Short: "Allow or disallow an existing ClawbackVestingAccount to delegate its unvested coins.",
+		Long: `Must be requested by the funder address (--from).
+If enabled, the account can delegate its unvested coins. On clawback, the delegated unvested coins
+are undelegated and transferred to the destination once the unbonding completes.
+Disabling it does not undelegate the coins that are already delegated.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetUnvestedStaking(clientCtx.GetFromAddress(), vestingAcc, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
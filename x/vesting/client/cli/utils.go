@@ -4,13 +4,21 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/evmos/evmos/v20/x/vesting/types"
 )
 
 type VestingData struct {
@@ -56,3 +64,180 @@ func ReadScheduleFile(path string) (int64, sdkvesting.Periods, error) {
 
 	return startTime, periods, nil
 }
+
+// BatchGrantRow is a grant read from a row of a batch funding CSV file.
+type BatchGrantRow struct {
+	Line           int
+	VestingAddress sdk.AccAddress
+	StartTime      time.Time
+	VestingPeriods sdkvesting.Periods
+}
+
+// RowError is an error found in a row of a batch funding CSV file.
+type RowError struct {
+	Line int
+	Err  error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// ReadBatchGrantsFile reads a CSV file with address,amount,start,cliff,duration
+// rows and builds the vesting periods of every grant, with an event at the end
+// of every period of the given length. The start time is given as a unix
+// timestamp or in RFC3339 format, the cliff and duration as seconds or as a
+// duration string (e.g. 8760h). A header row is skipped.
+//
+// It returns the valid rows and an error for every invalid row.
+func ReadBatchGrantsFile(path string, periodLength int64) ([]BatchGrantRow, []RowError, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 5
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var (
+		rows      []BatchGrantRow
+		rowErrors []RowError
+		seen      = make(map[string]int)
+	)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			// a malformed row does not prevent reading the next ones
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				rowErrors = append(rowErrors, RowError{Line: parseErr.Line, Err: parseErr.Err})
+				continue
+			}
+			return nil, nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(rows) == 0 && len(rowErrors) == 0 && strings.EqualFold(record[0], "address") {
+			continue
+		}
+
+		row, err := parseBatchGrantRecord(record, periodLength)
+		if err != nil {
+			rowErrors = append(rowErrors, RowError{Line: line, Err: err})
+			continue
+		}
+
+		addr := row.VestingAddress.String()
+		if prev, ok := seen[addr]; ok {
+			rowErrors = append(rowErrors, RowError{Line: line, Err: fmt.Errorf("duplicate address %s, first seen on line %d", addr, prev)})
+			continue
+		}
+		seen[addr] = line
+
+		row.Line = line
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+// parseBatchGrantRecord parses an address,amount,start,cliff,duration record.
+func parseBatchGrantRecord(record []string, periodLength int64) (BatchGrantRow, error) {
+	addr, err := sdk.AccAddressFromBech32(record[0])
+	if err != nil {
+		return BatchGrantRow{}, fmt.Errorf("invalid address: %w", err)
+	}
+
+	amount, err := sdk.ParseCoinsNormalized(record[1])
+	if err != nil {
+		return BatchGrantRow{}, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	start, err := parseStartTime(record[2])
+	if err != nil {
+		return BatchGrantRow{}, fmt.Errorf("invalid start: %w", err)
+	}
+
+	cliff, err := parseSeconds(record[3])
+	if err != nil {
+		return BatchGrantRow{}, fmt.Errorf("invalid cliff: %w", err)
+	}
+
+	duration, err := parseSeconds(record[4])
+	if err != nil {
+		return BatchGrantRow{}, fmt.Errorf("invalid duration: %w", err)
+	}
+
+	periods, err := types.NewCliffVestingPeriods(amount, cliff, duration, periodLength)
+	if err != nil {
+		return BatchGrantRow{}, err
+	}
+
+	return BatchGrantRow{
+		VestingAddress: addr,
+		StartTime:      start,
+		VestingPeriods: periods,
+	}, nil
+}
+
+// parseStartTime parses a unix timestamp or an RFC3339 time.
+func parseStartTime(value string) (time.Time, error) {
+	if unix, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(unix, 0).UTC(), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// parseSeconds parses a number of seconds or a duration string.
+func parseSeconds(value string) (int64, error) {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return seconds, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d%time.Second != 0 {
+		return 0, fmt.Errorf("%s is not a whole number of seconds", value)
+	}
+	return int64(d / time.Second), nil
+}
+
+// NewBatchFundMsg builds a MsgBatchFundVestingAccounts for the given rows.
+// Rows with the same start time and vesting periods share a template.
+func NewBatchFundMsg(funder sdk.AccAddress, rows []BatchGrantRow) *types.MsgBatchFundVestingAccounts {
+	var (
+		templates []types.VestingTemplate
+		grants    = make([]types.BatchGrant, 0, len(rows))
+		indexes   = make(map[string]uint32)
+	)
+
+	for _, row := range rows {
+		key := fmt.Sprintf("%d/%s", row.StartTime.Unix(), row.VestingPeriods)
+		index, ok := indexes[key]
+		if !ok {
+			index = uint32(len(templates)) // #nosec G115 -- number of rows is bounded by the transaction size
+			indexes[key] = index
+			templates = append(templates, types.VestingTemplate{
+				StartTime:      row.StartTime,
+				VestingPeriods: row.VestingPeriods,
+			})
+		}
+
+		grants = append(grants, types.BatchGrant{
+			VestingAddress: row.VestingAddress.String(),
+			TemplateIndex:  index,
+		})
+	}
+
+	return types.NewMsgBatchFundVestingAccounts(funder, templates, grants)
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBatchGrantsFile(t *testing.T) {
+	addr1 := sdk.AccAddress(common.BytesToAddress([]byte{1}).Bytes()).String()
+	addr2 := sdk.AccAddress(common.BytesToAddress([]byte{2}).Bytes()).String()
+	addr3 := sdk.AccAddress(common.BytesToAddress([]byte{3}).Bytes()).String()
+
+	lines := []string{
+		"address,amount,start,cliff,duration",
+		fmt.Sprintf("%s,1000test,1000,200,400", addr1),
+		fmt.Sprintf("%s,1000test,1970-01-01T00:16:40Z,200s,400s", addr2),
+		"invalid,1000test,1000,200,400",
+		fmt.Sprintf("%s,1000test,1000,500,400", addr3),
+		fmt.Sprintf("%s,1000test,1000,200,400", addr1),
+		fmt.Sprintf("%s,1000test,1000", addr3),
+	}
+	path := filepath.Join(t.TempDir(), "grants.csv")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600))
+
+	rows, rowErrors, err := ReadBatchGrantsFile(path, 100)
+	require.NoError(t, err)
+
+	require.Len(t, rows, 2)
+	require.Equal(t, 2, rows[0].Line)
+	require.Equal(t, time.Unix(1000, 0).UTC(), rows[0].StartTime)
+	require.Equal(t, rows[0].StartTime, rows[1].StartTime)
+	require.Equal(t, rows[0].VestingPeriods, rows[1].VestingPeriods)
+	require.Equal(t, int64(400), rows[0].VestingPeriods.TotalLength())
+
+	require.Len(t, rowErrors, 4)
+	require.Equal(t, 4, rowErrors[0].Line)
+	require.ErrorContains(t, rowErrors[0], "invalid address")
+	require.Equal(t, 5, rowErrors[1].Line)
+	require.ErrorContains(t, rowErrors[1], "cliff must be between 0 and the duration")
+	require.Equal(t, 6, rowErrors[2].Line)
+	require.ErrorContains(t, rowErrors[2], "duplicate address")
+	require.Equal(t, 7, rowErrors[3].Line)
+	require.ErrorContains(t, rowErrors[3], "wrong number of fields")
+
+	// rows with the same schedule share a template
+	msg := NewBatchFundMsg(sdk.AccAddress(common.BytesToAddress([]byte{4}).Bytes()), rows)
+	require.NoError(t, msg.ValidateBasic())
+	require.Len(t, msg.Templates, 1)
+	require.Len(t, msg.Grants, 2)
+}
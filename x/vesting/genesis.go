@@ -0,0 +1,28 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/vesting/keeper"
+	"github.com/evmos/evmos/v20/x/vesting/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, pc := range data.PendingClawbacks {
+		k.SetPendingClawback(ctx, pc)
+	}
+
+	// the clawback vesting accounts are imported by the auth module
+	k.IndexClawbackVestingAccounts(ctx)
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		PendingClawbacks: k.GetPendingClawbacks(ctx),
+	}
+}
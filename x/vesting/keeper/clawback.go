@@ -0,0 +1,298 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/evmos/evmos/v20/x/vesting/types"
+)
+
+// GetPendingClawbacks returns all the clawed back coins that are still
+// unbonding.
+func (k Keeper) GetPendingClawbacks(ctx sdk.Context) []types.PendingClawback {
+	var pendingClawbacks []types.PendingClawback
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingClawback)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pc types.PendingClawback
+		k.cdc.MustUnmarshal(iterator.Value(), &pc)
+		pendingClawbacks = append(pendingClawbacks, pc)
+	}
+
+	return pendingClawbacks
+}
+
+// HasPendingClawback checks if there is a pending clawback for the given
+// unbonding entry of the vesting account.
+func (k Keeper) HasPendingClawback(ctx sdk.Context, unbondingID uint64, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(buildPendingClawbackKey(unbondingID, addr))
+}
+
+// SetPendingClawback stores the pending clawback of an unbonding entry.
+func (k Keeper) SetPendingClawback(ctx sdk.Context, pc types.PendingClawback) {
+	addr := sdk.MustAccAddressFromBech32(pc.VestingAddress)
+	bz := k.cdc.MustMarshal(&pc)
+	ctx.KVStore(k.storeKey).Set(buildPendingClawbackKey(pc.UnbondingId, addr), bz)
+}
+
+// DeletePendingClawback removes the pending clawback of an unbonding entry.
+func (k Keeper) DeletePendingClawback(ctx sdk.Context, pc types.PendingClawback) {
+	addr := sdk.MustAccAddressFromBech32(pc.VestingAddress)
+	ctx.KVStore(k.storeKey).Delete(buildPendingClawbackKey(pc.UnbondingId, addr))
+}
+
+// buildPendingClawbackKey builds the key for the pending clawback of the given
+// unbonding entry and vesting account
+func buildPendingClawbackKey(unbondingID uint64, addr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(types.KeyPrefixPendingClawback)+8+len(addr.Bytes()))
+	key = append(key, types.KeyPrefixPendingClawback...)
+	key = binary.BigEndian.AppendUint64(key, unbondingID)
+	key = append(key, addr.Bytes()...)
+
+	return key
+}
+
+// clawbackDelegations claws back the given amount of delegated unvested coins.
+// The coins are undelegated from the validators of the account and, if that
+// is not enough, taken from its existing unbonding delegations. The unbonding
+// coins are stored as pending clawbacks and transferred to the destination
+// once they are unbonded. It returns the amount of coins that are pending.
+func (k Keeper) clawbackDelegations(
+	ctx sdk.Context,
+	addr, dest sdk.AccAddress,
+	bondDenom string,
+	amount sdkmath.Int,
+) (sdkmath.Int, error) {
+	remaining := amount
+
+	delegations, err := k.stakingKeeper.GetDelegatorDelegations(ctx, addr, math.MaxUint16)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	for _, delegation := range delegations {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+
+		shares := delegation.Shares
+		if validator.TokensFromShares(shares).TruncateInt().GT(remaining) {
+			shares, err = validator.SharesFromTokens(remaining)
+			if err != nil {
+				return sdkmath.ZeroInt(), err
+			}
+		}
+
+		// a failed undelegation (e.g. when the maximum number of unbonding
+		// entries is reached) must not abort the clawback, so the delegation
+		// is skipped and the remaining amount is taken from the other
+		// validators or the existing unbonding delegations
+		cacheCtx, writeCache := ctx.CacheContext()
+		completionTime, returnAmount, err := k.stakingKeeper.Undelegate(cacheCtx, addr, valAddr, shares)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to undelegate unvested coins for clawback",
+				"account", addr.String(),
+				"validator", delegation.ValidatorAddress,
+				"error", err.Error(),
+			)
+			continue
+		}
+		writeCache()
+		if !returnAmount.IsPositive() {
+			continue
+		}
+
+		ubd, err := k.stakingKeeper.GetUnbondingDelegation(ctx, addr, valAddr)
+		if err != nil {
+			return sdkmath.ZeroInt(), err
+		}
+
+		// the new entry might have been merged with another undelegation of
+		// the same block
+		for _, entry := range ubd.Entries {
+			if entry.CreationHeight != ctx.BlockHeight() || !entry.CompletionTime.Equal(completionTime) {
+				continue
+			}
+
+			owed := sdkmath.MinInt(returnAmount, remaining)
+			k.addPendingClawback(ctx, addr, dest, entry, sdk.NewCoin(bondDenom, owed))
+			remaining = remaining.Sub(owed)
+			break
+		}
+	}
+
+	if !remaining.IsPositive() {
+		return amount, nil
+	}
+
+	// claim the unvested coins that were already being undelegated
+	ubds, err := k.stakingKeeper.GetUnbondingDelegations(ctx, addr, math.MaxUint16)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	for _, ubd := range ubds {
+		for _, entry := range ubd.Entries {
+			if !remaining.IsPositive() {
+				return amount, nil
+			}
+			if !entry.Balance.IsPositive() || k.HasPendingClawback(ctx, entry.UnbondingId, addr) {
+				continue
+			}
+
+			owed := sdkmath.MinInt(entry.Balance, remaining)
+			k.addPendingClawback(ctx, addr, dest, entry, sdk.NewCoin(bondDenom, owed))
+			remaining = remaining.Sub(owed)
+		}
+	}
+
+	// coins that were slashed while delegated cannot be clawed back
+	return amount.Sub(remaining), nil
+}
+
+// addPendingClawback stores a pending clawback for the given unbonding entry,
+// adding to the amount of an existing one.
+func (k Keeper) addPendingClawback(
+	ctx sdk.Context,
+	addr, dest sdk.AccAddress,
+	entry stakingtypes.UnbondingDelegationEntry,
+	amount sdk.Coin,
+) {
+	pc := types.PendingClawback{
+		VestingAddress:     addr.String(),
+		DestinationAddress: dest.String(),
+		UnbondingId:        entry.UnbondingId,
+		Amount:             amount,
+		EntryBalance:       entry.Balance,
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(buildPendingClawbackKey(entry.UnbondingId, addr))
+	if bz != nil {
+		var existing types.PendingClawback
+		k.cdc.MustUnmarshal(bz, &existing)
+		pc.Amount = pc.Amount.Add(existing.Amount)
+	}
+
+	k.SetPendingClawback(ctx, pc)
+}
+
+// CompletePendingClawbacks transfers the pending clawbacks whose unbonding
+// completed to their destination. The amounts of the clawbacks that are still
+// unbonding are reduced if their unbonding entry was slashed. It must run
+// after the staking module completed the matured unbondings.
+//
+// Each pending clawback is processed on a cached context. If it fails, the
+// error is logged and the pending clawback is kept in the store, so that it is
+// retried on the next block instead of halting the chain.
+func (k Keeper) CompletePendingClawbacks(ctx sdk.Context) {
+	for _, pc := range k.GetPendingClawbacks(ctx) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.processPendingClawback(cacheCtx, pc); err != nil {
+			k.Logger(ctx).Error(
+				"failed to process pending clawback",
+				"account", pc.VestingAddress,
+				"unbonding-id", pc.UnbondingId,
+				"error", err.Error(),
+			)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// processPendingClawback completes the pending clawback if its unbonding
+// entry matured, or applies the slashing of the entry to its amount.
+func (k Keeper) processPendingClawback(ctx sdk.Context, pc types.PendingClawback) error {
+	ubd, err := k.stakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, pc.UnbondingId)
+	if err != nil && !errors.Is(err, stakingtypes.ErrNoUnbondingDelegation) {
+		return err
+	}
+
+	if entry, found := findUnbondingEntry(ubd, pc.UnbondingId); err == nil && found {
+		if entry.Balance.Equal(pc.EntryBalance) {
+			return nil
+		}
+
+		// slashing reduces the entry proportionally
+		if entry.Balance.LT(pc.EntryBalance) {
+			pc.Amount.Amount = pc.Amount.Amount.Mul(entry.Balance).Quo(pc.EntryBalance)
+		}
+		pc.EntryBalance = entry.Balance
+		k.SetPendingClawback(ctx, pc)
+		return nil
+	}
+
+	return k.completePendingClawback(ctx, pc)
+}
+
+// completePendingClawback transfers the unbonded coins of a pending clawback
+// to its destination and removes it from the store.
+func (k Keeper) completePendingClawback(ctx sdk.Context, pc types.PendingClawback) error {
+	k.DeletePendingClawback(ctx, pc)
+
+	addr := sdk.MustAccAddressFromBech32(pc.VestingAddress)
+	dest := sdk.MustAccAddressFromBech32(pc.DestinationAddress)
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, pc.Amount.Denom)
+	coins := sdk.NewCoins(sdk.NewCoin(pc.Amount.Denom, sdkmath.MinInt(pc.Amount.Amount, balance.Amount)))
+	if coins.IsZero() {
+		return nil
+	}
+
+	var err error
+	if dest.Equals(k.accountKeeper.GetModuleAddress(distributiontypes.ModuleName)) {
+		err = k.distributionKeeper.FundCommunityPool(ctx, coins, addr)
+	} else {
+		err = k.bankKeeper.SendCoins(ctx, addr, dest, coins)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to complete clawback from %s", pc.VestingAddress)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteClawback,
+			sdk.NewAttribute(types.AttributeKeyAccount, pc.VestingAddress),
+			sdk.NewAttribute(types.AttributeKeyDestination, pc.DestinationAddress),
+			sdk.NewAttribute(types.AttributeKeyCoins, coins.String()),
+		),
+	)
+
+	return nil
+}
+
+// findUnbondingEntry returns the entry of the unbonding delegation with the
+// given unbonding ID.
+func findUnbondingEntry(ubd stakingtypes.UnbondingDelegation, id uint64) (stakingtypes.UnbondingDelegationEntry, bool) {
+	for _, entry := range ubd.Entries {
+		if entry.UnbondingId == id {
+			return entry, true
+		}
+	}
+	return stakingtypes.UnbondingDelegationEntry{}, false
+}
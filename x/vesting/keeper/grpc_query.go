@@ -7,6 +7,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -49,3 +50,109 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// ClawbackVestingAccounts returns the clawback vesting accounts together with
+// their locked, unvested and vested tokens. The results are ordered by address
+// and can optionally be filtered by funder.
+func (k Keeper) ClawbackVestingAccounts(
+	goCtx context.Context,
+	req *types.QueryClawbackVestingAccountsRequest,
+) (*types.QueryClawbackVestingAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FunderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(req.FunderAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime()
+
+	var (
+		accounts []types.VestingAccountBalances
+		nextKey  []byte
+		matched  uint64
+	)
+
+	// accounts are iterated in address order from the index, so the address
+	// of the first account that does not fit in the page is used as the next
+	// key
+	k.iterateClawbackVestingAccountsFrom(ctx, pageReq.Key, func(va *types.ClawbackVestingAccount) bool {
+		if req.FunderAddress != "" && va.FunderAddress != req.FunderAddress {
+			return false
+		}
+
+		addr := va.GetAddress()
+
+		matched++
+		if matched <= pageReq.Offset {
+			return false
+		}
+
+		if uint64(len(accounts)) == limit {
+			if nextKey == nil {
+				nextKey = addr
+			}
+			return !countTotal
+		}
+
+		accounts = append(accounts, types.VestingAccountBalances{
+			Address:       va.Address,
+			FunderAddress: va.FunderAddress,
+			Locked:        va.GetLockedUpCoins(blockTime),
+			Unvested:      va.GetVestingCoins(blockTime),
+			Vested:        va.GetVestedCoins(blockTime),
+		})
+		return false
+	})
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal && pageReq.Key == nil {
+		pageRes.Total = matched
+	}
+
+	return &types.QueryClawbackVestingAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// UnvestedSupply returns the total locked and unvested tokens held by all
+// clawback vesting accounts at the current block time
+func (k Keeper) UnvestedSupply(
+	goCtx context.Context,
+	req *types.QueryUnvestedSupplyRequest,
+) (*types.QueryUnvestedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	supply := k.computeUnvestedSupply(ctx)
+
+	return &types.QueryUnvestedSupplyResponse{
+		Locked:       supply.Locked,
+		Unvested:     supply.Unvested,
+		NonSpendable: supply.NonSpendable,
+	}, nil
+}
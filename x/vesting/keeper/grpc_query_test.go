@@ -3,13 +3,19 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/evmos/evmos/v20/testutil"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 	"github.com/evmos/evmos/v20/x/vesting/types"
 )
 
@@ -140,3 +146,158 @@ func TestBalances(t *testing.T) {
 		})
 	}
 }
+
+func TestClawbackVestingAccounts(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	qc := nw.GetVestingClient()
+
+	otherFunder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	funded := []sdk.AccAddress{
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+	}
+	for _, addr := range funded {
+		setupClawbackVestingAccount(t, ctx, nw, funder, addr, lockupPeriods)
+	}
+	otherAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	setupClawbackVestingAccount(t, ctx, nw, otherFunder, otherAddr, lockupPeriods)
+
+	_, err := qc.ClawbackVestingAccounts(ctx, &types.QueryClawbackVestingAccountsRequest{FunderAddress: "evmos1"})
+	require.ErrorContains(t, err, "decoding bech32 failed")
+
+	res, err := qc.ClawbackVestingAccounts(ctx, &types.QueryClawbackVestingAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 4)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+
+	res, err = qc.ClawbackVestingAccounts(ctx, &types.QueryClawbackVestingAccountsRequest{
+		FunderAddress: otherFunder.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.VestingAccountBalances{{
+		Address:       otherAddr.String(),
+		FunderAddress: otherFunder.String(),
+		Locked:        balances,
+		Unvested:      balances,
+		Vested:        nil,
+	}}, res.Accounts)
+
+	// page through the accounts of the funder one at a time
+	var (
+		seen    []string
+		nextKey []byte
+	)
+	for i := 0; i < len(funded); i++ {
+		res, err = qc.ClawbackVestingAccounts(ctx, &types.QueryClawbackVestingAccountsRequest{
+			FunderAddress: funder.String(),
+			Pagination:    &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Accounts, 1)
+		require.Equal(t, funder.String(), res.Accounts[0].FunderAddress)
+		seen = append(seen, res.Accounts[0].Address)
+		nextKey = res.Pagination.NextKey
+	}
+	require.Nil(t, nextKey, "expected no more pages")
+
+	expAddrs := make([]string, len(funded))
+	for i, addr := range funded {
+		expAddrs[i] = addr.String()
+	}
+	require.ElementsMatch(t, expAddrs, seen)
+
+	res, err = qc.ClawbackVestingAccounts(ctx, &types.QueryClawbackVestingAccountsRequest{
+		FunderAddress: funder.String(),
+		Pagination:    &query.PageRequest{Offset: 2, Limit: 5, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, seen[2], res.Accounts[0].Address)
+
+	// accounts converted by a clawback are removed from the index
+	_, err = nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(otherFunder, otherAddr, nil))
+	require.NoError(t, err)
+	res, err = qc.ClawbackVestingAccounts(ctx, &types.QueryClawbackVestingAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 3)
+}
+
+func TestUnvestedSupply(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	qc := nw.GetVestingClient()
+
+	res, err := qc.UnvestedSupply(ctx, &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Locked.IsZero())
+	require.True(t, res.Unvested.IsZero())
+	require.True(t, res.NonSpendable.IsZero())
+
+	// the second account is unlocked before its coins are vested
+	shortLockupPeriods := sdkvesting.Periods{{Length: 1000, Amount: balances}}
+	setupClawbackVestingAccount(t, ctx, nw, funder, vestingAddr, lockupPeriods)
+	setupClawbackVestingAccount(t, ctx, nw, funder, addr3, shortLockupPeriods)
+
+	res, err = qc.UnvestedSupply(ctx, &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balances.Add(balances...), res.Locked)
+	require.Equal(t, balances.Add(balances...), res.Unvested)
+	require.Equal(t, balances.Add(balances...), res.NonSpendable)
+
+	// after the first vesting period a quarter of each account is vested, the
+	// first account is still locked and the second one is unlocked
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2000 * time.Second))
+	res, err = nw.App.VestingKeeper.UnvestedSupply(ctx, &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balances, res.Locked)
+	require.Equal(t, balances.Sub(quarter...).Add(balances.Sub(quarter...)...), res.Unvested)
+	// the non spendable supply is the sum of the larger amount of each
+	// account, not the larger of the totals
+	expNonSpendable := balances.Add(balances.Sub(quarter...)...)
+	require.Equal(t, expNonSpendable, res.NonSpendable)
+
+	// the non spendable tokens are not part of the circulating supply
+	supply := nw.App.BankKeeper.GetSupply(ctx, baseDenom).Amount
+	circulating := nw.App.InflationKeeper.GetCirculatingSupply(ctx, baseDenom)
+	require.Equal(t, math.LegacyNewDecFromInt(supply.Sub(expNonSpendable.AmountOf(baseDenom))), circulating)
+
+	// the day epoch stores a snapshot, which is used by the circulating supply
+	// until the next one, while the query is computed at the current block time
+	nw.App.VestingKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2000 * time.Second))
+	expUnvested := balances.Sub(quarter...).Sub(quarter...).Add(balances.Sub(quarter...).Sub(quarter...)...)
+	res, err = nw.App.VestingKeeper.UnvestedSupply(ctx, &types.QueryUnvestedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balances, res.Locked)
+	require.Equal(t, expUnvested, res.Unvested)
+	require.Equal(t, balances.Add(balances.Sub(quarter...).Sub(quarter...)...), res.NonSpendable)
+	require.Equal(t, expNonSpendable, nw.App.VestingKeeper.GetNonSpendableSupply(ctx))
+
+	nw.App.VestingKeeper.Hooks().AfterEpochEnd(ctx, epochstypes.DayEpochID, 2)
+	require.Equal(t, res.NonSpendable, nw.App.VestingKeeper.GetNonSpendableSupply(ctx))
+}
+
+// setupClawbackVestingAccount converts the given address into a clawback
+// vesting account funded with the given lockup and the default vesting
+// schedules.
+func setupClawbackVestingAccount(t *testing.T, ctx sdk.Context, nw *network.UnitTestNetwork, funder, vestingAddr sdk.AccAddress, lockup sdkvesting.Periods) {
+	t.Helper()
+
+	// fund the vesting account with coins to initialize it and then send all
+	// balances to the funding account
+	err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+	require.NoError(t, err, "error while funding the target account")
+	err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+	require.NoError(t, err, "error while sending coins to the funder account")
+
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false))
+	require.NoError(t, err, "error while creating the vesting account")
+
+	_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, types.NewMsgFundVestingAccount(
+		funder, vestingAddr, ctx.BlockTime(), lockup, vestingPeriods,
+	))
+	require.NoError(t, err, "error while funding the vesting account")
+}
@@ -11,10 +11,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	epochstypes "github.com/evmos/evmos/v20/x/epochs/types"
 	vestingtypes "github.com/evmos/evmos/v20/x/vesting/types"
 )
 
-var _ govtypes.GovHooks = Hooks{}
+var (
+	_ govtypes.GovHooks      = Hooks{}
+	_ epochstypes.EpochHooks = Hooks{}
+)
 
 // Hooks wrapper struct for the vesting keeper
 type Hooks struct {
@@ -185,6 +189,23 @@ func (k Keeper) AfterProposalVotingPeriodEnded(c context.Context, proposalID uin
 	return nil
 }
 
+// BeforeEpochStart is a wrapper for calling the epochs BeforeEpochStart hook
+// on the module keeper
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd is a wrapper for calling the epochs AfterEpochEnd hook on the
+// module keeper
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd updates the snapshot of the unvested supply. The hooks are
+// registered for the day epoch only, so the clawback vesting accounts are
+// iterated once per day.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) {
+	k.UpdateUnvestedSupply(ctx)
+}
+
 // getClawbackProposals checks if the proposal with the given ID is a governance
 // clawback proposal.
 func getClawbackProposals(proposal govv1.Proposal) ([]vestingtypes.MsgClawback, error) {
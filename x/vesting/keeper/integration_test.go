@@ -1068,8 +1068,9 @@ var _ = Describe("Clawback Vesting Accounts - claw back tokens", func() {
 		customGen := network.CustomGenesisState{}
 		// inflation custom genesis
 		inflGen := infltypes.DefaultGenesisState()
-		inflGen.Params.InflationDistribution.CommunityPool = math.LegacyZeroDec()
-		inflGen.Params.InflationDistribution.StakingRewards = math.LegacyOneDec()
+		inflGen.Params.InflationDistribution.Recipients = []infltypes.InflationRecipient{
+			infltypes.NewInflationRecipient(authtypes.FeeCollectorName, math.LegacyOneDec()),
+		}
 		customGen[infltypes.ModuleName] = inflGen
 		// distribution custom genesis
 		distrGen := distrtypes.DefaultGenesisState()
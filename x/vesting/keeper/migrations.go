@@ -12,6 +12,7 @@ import (
 var (
 	_ module.MigrationHandler = Migrator{}.Migrate1to2
 	_ module.MigrationHandler = Migrator{}.Migrate2to3
+	_ module.MigrationHandler = Migrator{}.Migrate3to4
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -35,3 +36,10 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.accountKeeper)
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4. It indexes the
+// existing clawback vesting accounts.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.IndexClawbackVestingAccounts(ctx)
+	return nil
+}
@@ -139,3 +139,38 @@ func TestMigrate2to3(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrate3to4(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+
+	vestingAddr, _ := testutiltx.NewAccAddressAndKey()
+	funder, _ := testutiltx.NewAccAddressAndKey()
+
+	// accounts stored before the migration are not indexed
+	baseAccount := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	baseAccount.AccountNumber = nw.App.AccountKeeper.NextAccountNumber(ctx)
+	acc, err := sdkvesting.NewBaseVestingAccount(baseAccount, balances, 500000)
+	require.NoError(t, err)
+	nw.App.AccountKeeper.SetAccount(ctx, &vestingtypes.ClawbackVestingAccount{
+		BaseVestingAccount: acc,
+		FunderAddress:      funder.String(),
+		StartTime:          time.Now(),
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	})
+
+	countAccounts := func() int {
+		var count int
+		nw.App.VestingKeeper.IterateClawbackVestingAccounts(ctx, func(*vestingtypes.ClawbackVestingAccount) bool {
+			count++
+			return false
+		})
+		return count
+	}
+	require.Zero(t, countAccounts())
+
+	migrator := keeper.NewMigrator(nw.App.VestingKeeper)
+	require.NoError(t, migrator.Migrate3to4(ctx), "migration failed")
+	require.Equal(t, 1, countAccounts())
+}
@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -79,6 +80,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 		FunderAddress:      funderAddress.String(),
 	}
 	ak.SetAccount(ctx, vestingAcc)
+	k.setClawbackVestingAccountIndex(ctx, vestingAcc.GetAddress())
 
 	if !msg.EnableGovClawback {
 		k.SetGovClawbackDisabled(ctx, vestingAcc.GetAddress())
@@ -253,7 +255,7 @@ func (k Keeper) Clawback(
 	}
 
 	// Perform clawback transfer
-	clawedBack, err := k.transferClawback(ctx, *va, dest)
+	clawedBack, unbonding, err := k.transferClawback(ctx, *va, dest)
 	if err != nil {
 		return nil, err
 	}
@@ -270,12 +272,14 @@ func (k Keeper) Clawback(
 				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
 				sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
 				sdk.NewAttribute(types.AttributeKeyDestination, dest.String()),
+				sdk.NewAttribute(types.AttributeKeyUnbonding, unbonding.String()),
 			),
 		},
 	)
 
 	return &types.MsgClawbackResponse{
-		Coins: clawedBack,
+		Coins:     clawedBack,
+		Unbonding: unbonding,
 	}, nil
 }
 
@@ -377,18 +381,290 @@ func (k Keeper) ConvertVestingAccount(
 
 	baseAcc := vestingAcc.BaseAccount
 	k.accountKeeper.SetAccount(ctx, baseAcc)
+	k.deleteClawbackVestingAccountIndex(ctx, address)
 
 	return &types.MsgConvertVestingAccountResponse{}, nil
 }
 
+// AccelerateVesting vests all the unvested coins of a ClawbackVestingAccount
+// at the current block time. If requested, all the locked coins are unlocked as
+// well. This can only be executed by the funder of the vesting account.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+func (k Keeper) AccelerateVesting(
+	goCtx context.Context,
+	msg *types.MsgAccelerateVesting,
+) (*types.MsgAccelerateVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	va, err := k.getFundedVestingAccount(ctx, msg.FunderAddress, msg.VestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// accelerated events can not be placed before the start of the schedules
+	if !ctx.BlockTime().After(va.StartTime) {
+		return nil, errorsmod.Wrapf(types.ErrVestingLockup,
+			"schedules of account %s start at %s", msg.VestingAddress, va.StartTime,
+		)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	vested := va.AccelerateVesting(blockTime, nil)
+
+	unlocked := sdk.NewCoins()
+	if msg.Unlock {
+		unlocked = va.AccelerateLockup(blockTime)
+	}
+
+	if vested.IsZero() && unlocked.IsZero() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"account %s has no unvested or locked coins to accelerate", msg.VestingAddress,
+		)
+	}
+
+	k.accountKeeper.SetAccount(ctx, va)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAccelerateVesting,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, vested.String()),
+				sdk.NewAttribute(types.AttributeKeyUnlocked, unlocked.String()),
+			),
+		},
+	)
+
+	return &types.MsgAccelerateVestingResponse{
+		VestedCoins:   vested,
+		UnlockedCoins: unlocked,
+	}, nil
+}
+
+// AcceleratePeriod vests a single future vesting period of a
+// ClawbackVestingAccount at the current block time. This can only be executed
+// by the funder of the vesting account.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+func (k Keeper) AcceleratePeriod(
+	goCtx context.Context,
+	msg *types.MsgAcceleratePeriod,
+) (*types.MsgAcceleratePeriodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	va, err := k.getFundedVestingAccount(ctx, msg.FunderAddress, msg.VestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// accelerated events can not be placed before the start of the schedules
+	if !ctx.BlockTime().After(va.StartTime) {
+		return nil, errorsmod.Wrapf(types.ErrVestingLockup,
+			"schedules of account %s start at %s", msg.VestingAddress, va.StartTime,
+		)
+	}
+
+	if int(msg.PeriodIndex) >= len(va.VestingPeriods) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"period index %d out of range, account has %d vesting periods", msg.PeriodIndex, len(va.VestingPeriods),
+		)
+	}
+
+	vested := va.AccelerateVesting(ctx.BlockTime().Unix(), func(idx int) bool {
+		return idx == int(msg.PeriodIndex)
+	})
+	if vested.IsZero() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"vesting period %d has already vested", msg.PeriodIndex,
+		)
+	}
+
+	k.accountKeeper.SetAccount(ctx, va)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAccelerateVesting,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, vested.String()),
+			),
+		},
+	)
+
+	return &types.MsgAcceleratePeriodResponse{VestedCoins: vested}, nil
+}
+
+// ExtendLockup delays the future unlocking events of a ClawbackVestingAccount.
+// The message is signed by both the funder and the vesting account, so the
+// lockup can only be extended with the consent of the vesting account.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - the extension is at least one second
+func (k Keeper) ExtendLockup(
+	goCtx context.Context,
+	msg *types.MsgExtendLockup,
+) (*types.MsgExtendLockupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	va, err := k.getFundedVestingAccount(ctx, msg.FunderAddress, msg.VestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := va.ExtendLockup(ctx.BlockTime().Unix(), int64(msg.Extension.Seconds())); err != nil {
+		return nil, err
+	}
+
+	k.accountKeeper.SetAccount(ctx, va)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeExtendLockup,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyExtension, msg.Extension.String()),
+			),
+		},
+	)
+
+	return &types.MsgExtendLockupResponse{}, nil
+}
+
+// SetUnvestedStaking allows or disallows a ClawbackVestingAccount to delegate
+// its unvested coins. Delegated unvested coins are undelegated on clawback and
+// transferred to the destination once unbonded.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+func (k Keeper) SetUnvestedStaking(
+	goCtx context.Context,
+	msg *types.MsgSetUnvestedStaking,
+) (*types.MsgSetUnvestedStakingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	va, err := k.getFundedVestingAccount(ctx, msg.FunderAddress, msg.VestingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	va.UnvestedStaking = msg.Enabled
+	k.accountKeeper.SetAccount(ctx, va)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetUnvestedStaking,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+			),
+		},
+	)
+
+	return &types.MsgSetUnvestedStakingResponse{}, nil
+}
+
+// BatchFundVestingAccounts funds multiple ClawbackVestingAccounts with the
+// schedules of the templates referenced by each grant. Every grant is
+// processed like a MsgFundVestingAccount and the whole batch fails if any of
+// them fails.
+//
+// Checks performed on the ValidateBasic include:
+//   - funder and vesting addresses are correct bech32 format
+//   - vesting addresses are not the zero address and are not repeated
+//   - templates contain valid amounts and lengths
+//   - grants reference existing templates
+func (k Keeper) BatchFundVestingAccounts(
+	goCtx context.Context,
+	msg *types.MsgBatchFundVestingAccounts,
+) (*types.MsgBatchFundVestingAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	total := sdk.NewCoins()
+	for i, grant := range msg.Grants {
+		template := msg.Templates[grant.TemplateIndex]
+		fundMsg := &types.MsgFundVestingAccount{
+			FunderAddress:  msg.FunderAddress,
+			VestingAddress: grant.VestingAddress,
+			StartTime:      template.StartTime,
+			LockupPeriods:  template.LockupPeriods,
+			VestingPeriods: template.VestingPeriods,
+		}
+
+		if _, err := k.FundVestingAccount(ctx, fundMsg); err != nil {
+			return nil, errorsmod.Wrapf(err, "grant %d", i)
+		}
+
+		// the schedules describe the same amount, unless one of them is absent
+		grantCoins := template.VestingPeriods.TotalAmount()
+		if grantCoins.IsZero() {
+			grantCoins = template.LockupPeriods.TotalAmount()
+		}
+		total = total.Add(grantCoins...)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeBatchFundVestingAccounts,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, total.String()),
+				sdk.NewAttribute(types.AttributeKeyGrants, strconv.Itoa(len(msg.Grants))),
+			),
+		},
+	)
+
+	return &types.MsgBatchFundVestingAccountsResponse{Total: total}, nil
+}
+
+// getFundedVestingAccount returns the ClawbackVestingAccount at the given
+// address after checking that its schedules can be modified by the funder.
+func (k Keeper) getFundedVestingAccount(
+	ctx sdk.Context,
+	funderAddress, vestingAddress string,
+) (*types.ClawbackVestingAccount, error) {
+	// NOTE: errors checked during msg validation
+	vestingAccAddr := sdk.MustAccAddressFromBech32(vestingAddress)
+
+	// Schedules must not change while governance votes on the clawback amount
+	if k.HasActiveClawbackProposal(ctx, vestingAccAddr) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"cannot modify the schedules while there is an active clawback proposal for account %s",
+			vestingAddress,
+		)
+	}
+
+	va, err := k.GetClawbackVestingAccount(ctx, vestingAccAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if va.FunderAddress != funderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"schedules can only be modified by the funder: %s", va.FunderAddress,
+		)
+	}
+
+	return va, nil
+}
+
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
 // the destination address. Then, it updates the lockup schedule, removes future
 // vesting events and deletes the store entry for governance clawback if it exists.
+// Unvested tokens that are delegated are undelegated and transferred once
+// unbonded. It returns the transferred and the unbonding tokens.
 func (k Keeper) transferClawback(
 	ctx sdk.Context,
 	vestingAccount types.ClawbackVestingAccount,
 	destinationAddr sdk.AccAddress,
-) (sdk.Coins, error) {
+) (sdk.Coins, sdk.Coins, error) {
 	// Compute clawback amount, unlock unvested tokens and remove future vesting events
 	updatedAcc, toClawBack := vestingAccount.ComputeClawback(ctx.BlockTime().Unix())
 
@@ -403,15 +679,35 @@ func (k Keeper) transferClawback(
 	k.accountKeeper.SetAccount(ctx, baseAcc)
 
 	address := updatedAcc.GetAddress()
+	k.deleteClawbackVestingAccountIndex(ctx, address)
 
 	// if gov clawback is disabled, remove the entry from the store.
 	// if no entry is found for the address, this will no-op
 	k.DeleteGovClawbackDisabled(ctx, address)
 
+	// Unvested tokens that are not in the balance have been delegated, so they
+	// are undelegated and transferred once the unbonding completes
+	var unbonding sdk.Coins
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, address, bondDenom)
+	if shortfall := toClawBack.AmountOf(bondDenom).Sub(balance.Amount); shortfall.IsPositive() {
+		pending, err := k.clawbackDelegations(ctx, address, destinationAddr, bondDenom, shortfall)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		unbonding = unbonding.Add(sdk.NewCoin(bondDenom, pending))
+		toClawBack = toClawBack.Sub(sdk.NewCoin(bondDenom, shortfall))
+	}
+
 	// In case destination is community pool (e.g. Gov Clawback)
 	// call the corresponding function
 	if destinationAddr.String() == authtypes.NewModuleAddress(distributiontypes.ModuleName).String() {
-		return toClawBack, k.distributionKeeper.FundCommunityPool(ctx, toClawBack, address)
+		return toClawBack, unbonding, k.distributionKeeper.FundCommunityPool(ctx, toClawBack, address)
 	}
 
 	// NOTE: don't use `SpendableCoins` to get the minimum value to clawback since
@@ -420,5 +716,5 @@ func (k Keeper) transferClawback(
 	// different denoms (because of store iteration).
 
 	// Transfer clawback to the destination (funder)
-	return toClawBack, k.bankKeeper.SendCoins(ctx, address, destinationAddr, toClawBack)
+	return toClawBack, unbonding, k.bankKeeper.SendCoins(ctx, address, destinationAddr, toClawBack)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -22,6 +23,7 @@ import (
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/utils"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	evmosstakingkeeper "github.com/evmos/evmos/v20/x/staking/keeper"
 	"github.com/evmos/evmos/v20/x/vesting/types"
 )
 
@@ -741,6 +743,329 @@ func TestMsgUpdateVestingFunder(t *testing.T) {
 	}
 }
 
+func TestMsgModifySchedules(t *testing.T) {
+	var (
+		ctx sdk.Context
+		nw  *network.UnitTestNetwork
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func() error
+		expPass     bool
+		errContains string
+		// expLocked and expClawback are read after the modification
+		expLocked   sdk.Coins
+		expClawback sdk.Coins
+	}{
+		{
+			name: "fail - wrong funder",
+			malleate: func() error {
+				msg := types.NewMsgAccelerateVesting(addr3, vestingAddr, false)
+				return runMsg(ctx, nw, msg)
+			},
+			errContains: "schedules can only be modified by the funder",
+		},
+		{
+			name: "fail - schedules have not started",
+			malleate: func() error {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(-time.Hour))
+				msg := types.NewMsgAccelerateVesting(funder, vestingAddr, false)
+				return runMsg(ctx, nw, msg)
+			},
+			errContains: "schedules of account",
+		},
+		{
+			name: "fail - period already vested",
+			malleate: func() error {
+				msg := types.NewMsgAcceleratePeriod(funder, vestingAddr, 0)
+				return runMsg(ctx, nw, msg)
+			},
+			errContains: "has already vested",
+		},
+		{
+			name: "fail - period out of range",
+			malleate: func() error {
+				msg := types.NewMsgAcceleratePeriod(funder, vestingAddr, 4)
+				return runMsg(ctx, nw, msg)
+			},
+			errContains: "out of range",
+		},
+		{
+			name: "fail - no future unlocking events",
+			malleate: func() error {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * time.Hour))
+				msg := types.NewMsgExtendLockup(funder, vestingAddr, time.Hour)
+				return runMsg(ctx, nw, msg)
+			},
+			errContains: "no future unlocking events",
+		},
+		{
+			name: "pass - accelerate vesting",
+			malleate: func() error {
+				msg := types.NewMsgAccelerateVesting(funder, vestingAddr, false)
+				return runMsg(ctx, nw, msg)
+			},
+			expPass:     true,
+			expLocked:   balances,
+			expClawback: sdk.NewCoins(),
+		},
+		{
+			name: "pass - accelerate vesting and unlock",
+			malleate: func() error {
+				msg := types.NewMsgAccelerateVesting(funder, vestingAddr, true)
+				return runMsg(ctx, nw, msg)
+			},
+			expPass:     true,
+			expLocked:   sdk.NewCoins(),
+			expClawback: sdk.NewCoins(),
+		},
+		{
+			name: "pass - accelerate the last period",
+			malleate: func() error {
+				msg := types.NewMsgAcceleratePeriod(funder, vestingAddr, 3)
+				return runMsg(ctx, nw, msg)
+			},
+			expPass:     true,
+			expLocked:   balances,
+			expClawback: quarter.Add(quarter...),
+		},
+		{
+			name: "pass - extend lockup",
+			malleate: func() error {
+				msg := types.NewMsgExtendLockup(funder, vestingAddr, time.Hour)
+				return runMsg(ctx, nw, msg)
+			},
+			expPass:     true,
+			expLocked:   balances,
+			expClawback: quarter.Add(quarter...).Add(quarter...),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			// reset
+			nw = network.NewUnitTestNetwork()
+			ctx = nw.GetContext()
+			startTime := ctx.BlockTime()
+
+			err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+			require.NoError(t, err)
+			err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+			require.NoError(t, err)
+
+			createMsg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+			_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+			require.NoError(t, err)
+			fundMsg := types.NewMsgFundVestingAccount(funder, vestingAddr, startTime, lockupPeriods, vestingPeriods)
+			_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+			require.NoError(t, err)
+
+			// the first vesting period has passed
+			ctx = ctx.WithBlockTime(startTime.Add(3000 * time.Second))
+
+			err = tc.malleate()
+			if !tc.expPass {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			va, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, vestingAddr)
+			require.NoError(t, err)
+			require.NoError(t, va.Validate())
+			require.Equal(t, balances, va.OriginalVesting)
+
+			readTime := ctx.BlockTime().Add(time.Second)
+			require.Equal(t, tc.expLocked, va.GetLockedUpCoins(readTime))
+
+			clawbackRes, err := nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(funder, vestingAddr, nil))
+			require.NoError(t, err)
+			require.Equal(t, tc.expClawback, clawbackRes.Coins)
+		})
+	}
+}
+
+func TestClawbackDelegatedUnvestedCoins(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	delegated := sdk.NewCoin(baseDenom, math.NewInt(600))
+
+	err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+	require.NoError(t, err)
+	err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+	require.NoError(t, err)
+
+	createMsg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+	require.NoError(t, err)
+	fundMsg := types.NewMsgFundVestingAccount(funder, vestingAddr, ctx.BlockTime(), lockupPeriods, vestingPeriods)
+	_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+	require.NoError(t, err)
+
+	// only the funder can enable unvested staking
+	_, err = nw.App.VestingKeeper.SetUnvestedStaking(ctx, types.NewMsgSetUnvestedStaking(addr3, vestingAddr, true))
+	require.ErrorContains(t, err, "schedules can only be modified by the funder")
+	_, err = nw.App.VestingKeeper.SetUnvestedStaking(ctx, types.NewMsgSetUnvestedStaking(funder, vestingAddr, true))
+	require.NoError(t, err)
+
+	valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[0].OperatorAddress)
+	require.NoError(t, err)
+	stakingSrv := evmosstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+	_, err = stakingSrv.Delegate(ctx, stakingtypes.NewMsgDelegate(vestingAddr.String(), valAddr.String(), delegated))
+	require.NoError(t, err)
+
+	// the delegated coins are undelegated and transferred once unbonded
+	res, err := nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(funder, vestingAddr, nil))
+	require.NoError(t, err)
+	require.Equal(t, balances.Sub(delegated), res.Coins)
+	require.Equal(t, sdk.NewCoins(delegated), res.Unbonding)
+	require.Equal(t, balances.Sub(delegated), nw.App.BankKeeper.GetAllBalances(ctx, funder))
+
+	pending := nw.App.VestingKeeper.GetPendingClawbacks(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, delegated, pending[0].Amount)
+	require.Equal(t, funder.String(), pending[0].DestinationAddress)
+
+	// nothing is transferred while unbonding
+	require.NoError(t, nw.App.VestingKeeper.EndBlock(ctx))
+	require.Len(t, nw.App.VestingKeeper.GetPendingClawbacks(ctx), 1)
+
+	ubd, err := nw.App.StakingKeeper.GetUnbondingDelegation(ctx, vestingAddr, valAddr)
+	require.NoError(t, err)
+	require.Len(t, ubd.Entries, 1)
+
+	ctx = ctx.WithBlockTime(ubd.Entries[0].CompletionTime)
+	_, err = nw.App.StakingKeeper.CompleteUnbonding(ctx, vestingAddr, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, nw.App.VestingKeeper.EndBlock(ctx))
+
+	require.Empty(t, nw.App.VestingKeeper.GetPendingClawbacks(ctx))
+	require.Equal(t, balances, nw.App.BankKeeper.GetAllBalances(ctx, funder))
+	require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, vestingAddr).IsZero())
+}
+
+func TestCompletePendingClawbacksFailure(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	amount := sdk.NewInt64Coin(baseDenom, 100)
+
+	// the coins of the vesting account are locked, so the transfer fails
+	setupClawbackVestingAccount(t, ctx, nw, funder, vestingAddr, lockupPeriods)
+	failing := types.PendingClawback{
+		VestingAddress:     vestingAddr.String(),
+		DestinationAddress: funder.String(),
+		UnbondingId:        1000,
+		Amount:             amount,
+		EntryBalance:       amount.Amount,
+	}
+	nw.App.VestingKeeper.SetPendingClawback(ctx, failing)
+
+	require.NoError(t, testutil.FundAccount(ctx, nw.App.BankKeeper, addr3, sdk.NewCoins(amount)))
+	completed := types.PendingClawback{
+		VestingAddress:     addr3.String(),
+		DestinationAddress: addr4.String(),
+		UnbondingId:        1001,
+		Amount:             amount,
+		EntryBalance:       amount.Amount,
+	}
+	nw.App.VestingKeeper.SetPendingClawback(ctx, completed)
+
+	// the failing clawback does not halt the chain nor the other clawbacks
+	require.NoError(t, nw.App.VestingKeeper.EndBlock(ctx))
+	require.Equal(t, []types.PendingClawback{failing}, nw.App.VestingKeeper.GetPendingClawbacks(ctx))
+	require.Equal(t, sdk.NewCoins(amount), nw.App.BankKeeper.GetAllBalances(ctx, addr4))
+	require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, funder).IsZero())
+}
+
+func TestMsgBatchFundVestingAccounts(t *testing.T) {
+	template := types.VestingTemplate{
+		StartTime:      time.Unix(1000, 0).UTC(),
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+
+	testCases := []struct {
+		name        string
+		grants      []types.BatchGrant
+		expPass     bool
+		errContains string
+	}{
+		{
+			name: "fail - account is not a vesting account",
+			grants: []types.BatchGrant{
+				{VestingAddress: vestingAddr.String()},
+				{VestingAddress: addr4.String()},
+			},
+			errContains: "grant 1",
+		},
+		{
+			name: "pass - fund multiple accounts with a shared template",
+			grants: []types.BatchGrant{
+				{VestingAddress: vestingAddr.String()},
+				{VestingAddress: addr3.String()},
+			},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+
+			for _, addr := range []sdk.AccAddress{vestingAddr, addr3} {
+				err := testutil.FundAccount(ctx, nw.App.BankKeeper, addr, balances)
+				require.NoError(t, err)
+				err = nw.App.BankKeeper.SendCoins(ctx, addr, funder, balances)
+				require.NoError(t, err)
+
+				createMsg := types.NewMsgCreateClawbackVestingAccount(funder, addr, false)
+				_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+				require.NoError(t, err)
+			}
+
+			msg := types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, tc.grants)
+			require.NoError(t, msg.ValidateBasic())
+
+			res, err := nw.App.VestingKeeper.BatchFundVestingAccounts(ctx, msg)
+			if !tc.expPass {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, balances.Add(balances...), res.Total)
+
+			for _, grant := range tc.grants {
+				va, err := nw.App.VestingKeeper.GetClawbackVestingAccount(ctx, sdk.MustAccAddressFromBech32(grant.VestingAddress))
+				require.NoError(t, err)
+				require.Equal(t, balances, va.OriginalVesting)
+				require.Equal(t, template.StartTime, va.StartTime)
+				require.Equal(t, vestingPeriods, va.VestingPeriods)
+				require.Equal(t, lockupPeriods, va.LockupPeriods)
+			}
+			require.True(t, nw.App.BankKeeper.GetAllBalances(ctx, funder).IsZero())
+		})
+	}
+}
+
+// runMsg executes one of the messages modifying the schedules of a
+// vesting account.
+func runMsg(ctx sdk.Context, nw *network.UnitTestNetwork, msg sdk.Msg) error {
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgAccelerateVesting:
+		_, err = nw.App.VestingKeeper.AccelerateVesting(ctx, msg)
+	case *types.MsgAcceleratePeriod:
+		_, err = nw.App.VestingKeeper.AcceleratePeriod(ctx, msg)
+	case *types.MsgExtendLockup:
+		_, err = nw.App.VestingKeeper.ExtendLockup(ctx, msg)
+	}
+	return err
+}
+
 func TestClawbackVestingAccountStore(t *testing.T) {
 	nw := network.NewUnitTestNetwork()
 	ctx := nw.GetContext()
@@ -880,3 +1205,57 @@ func TestConvertVestingAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestClawbackDelegatedUnvestedCoinsMaxEntries(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	delegated := sdk.NewCoin(baseDenom, math.NewInt(600))
+
+	err := testutil.FundAccount(ctx, nw.App.BankKeeper, vestingAddr, balances)
+	require.NoError(t, err)
+	err = nw.App.BankKeeper.SendCoins(ctx, vestingAddr, funder, balances)
+	require.NoError(t, err)
+
+	createMsg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, false)
+	_, err = nw.App.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+	require.NoError(t, err)
+	fundMsg := types.NewMsgFundVestingAccount(funder, vestingAddr, ctx.BlockTime(), lockupPeriods, vestingPeriods)
+	_, err = nw.App.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+	require.NoError(t, err)
+	_, err = nw.App.VestingKeeper.SetUnvestedStaking(ctx, types.NewMsgSetUnvestedStaking(funder, vestingAddr, true))
+	require.NoError(t, err)
+
+	valAddr, err := sdk.ValAddressFromBech32(nw.GetValidators()[0].OperatorAddress)
+	require.NoError(t, err)
+	stakingSrv := evmosstakingkeeper.NewMsgServerImpl(&nw.App.StakingKeeper)
+	_, err = stakingSrv.Delegate(ctx, stakingtypes.NewMsgDelegate(vestingAddr.String(), valAddr.String(), delegated))
+	require.NoError(t, err)
+
+	// fill the unbonding entries of the delegation in different blocks
+	maxEntries, err := nw.App.StakingKeeper.MaxEntries(ctx)
+	require.NoError(t, err)
+	undelegated := sdk.NewCoin(baseDenom, math.ZeroInt())
+	for i := uint32(0); i < maxEntries; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		amount := sdk.NewCoin(baseDenom, math.NewInt(10))
+		_, err = stakingSrv.Undelegate(ctx, stakingtypes.NewMsgUndelegate(vestingAddr.String(), valAddr.String(), amount))
+		require.NoError(t, err)
+		undelegated = undelegated.Add(amount)
+	}
+
+	// the delegation cannot be undelegated, but the clawback still takes the
+	// unbonding coins
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	res, err := nw.App.VestingKeeper.Clawback(ctx, types.NewMsgClawback(funder, vestingAddr, nil))
+	require.NoError(t, err)
+	require.Equal(t, balances.Sub(delegated), res.Coins)
+	require.Equal(t, sdk.NewCoins(undelegated), res.Unbonding)
+
+	pending := nw.App.VestingKeeper.GetPendingClawbacks(ctx)
+	require.Len(t, pending, int(maxEntries))
+
+	// the rest of the delegation is kept by the account
+	delegation, err := nw.App.StakingKeeper.GetDelegation(ctx, vestingAddr, valAddr)
+	require.NoError(t, err)
+	require.True(t, delegation.Shares.IsPositive())
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -28,3 +29,115 @@ func (k Keeper) GetClawbackVestingAccount(ctx context.Context, addr sdk.AccAddre
 
 	return clawbackAccount, nil
 }
+
+// IterateClawbackVestingAccounts iterates over all the clawback vesting
+// accounts in address order and performs a callback function. The iteration
+// stops when the callback returns true.
+func (k Keeper) IterateClawbackVestingAccounts(ctx context.Context, cb func(va *types.ClawbackVestingAccount) (stop bool)) {
+	k.iterateClawbackVestingAccountsFrom(ctx, nil, cb)
+}
+
+// iterateClawbackVestingAccountsFrom iterates over the index of clawback
+// vesting accounts in address order, starting at the given address.
+func (k Keeper) iterateClawbackVestingAccountsFrom(
+	ctx context.Context,
+	start sdk.AccAddress,
+	cb func(va *types.ClawbackVestingAccount) (stop bool),
+) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.KeyPrefixClawbackAccount)
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		va, ok := k.accountKeeper.GetAccount(ctx, iterator.Key()).(*types.ClawbackVestingAccount)
+		if !ok {
+			continue
+		}
+		if cb(va) {
+			break
+		}
+	}
+}
+
+// IndexClawbackVestingAccounts adds all the clawback vesting accounts to the
+// index. It iterates over all the accounts, so it is only used on genesis and
+// in store migrations.
+func (k Keeper) IndexClawbackVestingAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		if _, ok := acc.(*types.ClawbackVestingAccount); ok {
+			k.setClawbackVestingAccountIndex(ctx, acc.GetAddress())
+		}
+		return false
+	})
+}
+
+// setClawbackVestingAccountIndex adds the address of a clawback vesting
+// account to the index.
+func (k Keeper) setClawbackVestingAccountIndex(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClawbackAccount)
+	store.Set(addr.Bytes(), []byte{0x01})
+}
+
+// deleteClawbackVestingAccountIndex removes the address of an account that is
+// no longer a clawback vesting account from the index.
+func (k Keeper) deleteClawbackVestingAccountIndex(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClawbackAccount)
+	store.Delete(addr.Bytes())
+}
+
+// GetUnvestedSupply returns the locked and unvested coins held by all clawback
+// vesting accounts, as of the last day epoch. Computing them iterates over all
+// clawback vesting accounts, so the snapshot is only updated once per day by
+// the epoch hooks.
+// Before the first snapshot is stored, the supply is computed at the current
+// block time.
+func (k Keeper) GetUnvestedSupply(ctx sdk.Context) types.UnvestedSupply {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyUnvestedSupply)
+	if bz == nil {
+		return k.computeUnvestedSupply(ctx)
+	}
+
+	var supply types.UnvestedSupply
+	k.cdc.MustUnmarshal(bz, &supply)
+	return supply
+}
+
+// GetNonSpendableSupply returns the coins held by clawback vesting accounts
+// that are not spendable, as of the last day epoch.
+func (k Keeper) GetNonSpendableSupply(ctx sdk.Context) sdk.Coins {
+	return k.GetUnvestedSupply(ctx).NonSpendable
+}
+
+// UpdateUnvestedSupply stores a snapshot of the locked and unvested coins held
+// by all clawback vesting accounts at the current block time.
+func (k Keeper) UpdateUnvestedSupply(ctx sdk.Context) {
+	supply := k.computeUnvestedSupply(ctx)
+	bz := k.cdc.MustMarshal(&supply)
+	ctx.KVStore(k.storeKey).Set(types.KeyUnvestedSupply, bz)
+}
+
+// computeUnvestedSupply iterates over all clawback vesting accounts and sums
+// their locked and unvested coins at the current block time. Accounts can only
+// spend the coins that are both vested and unlocked, so the non spendable
+// supply adds up the larger of both amounts for each account.
+func (k Keeper) computeUnvestedSupply(ctx sdk.Context) types.UnvestedSupply {
+	var locked, unvested, nonSpendable sdk.Coins
+
+	blockTime := ctx.BlockTime()
+	k.IterateClawbackVestingAccounts(ctx, func(va *types.ClawbackVestingAccount) bool {
+		accLocked := va.GetLockedUpCoins(blockTime)
+		accUnvested := va.GetVestingCoins(blockTime)
+
+		locked = locked.Add(accLocked...)
+		unvested = unvested.Add(accUnvested...)
+		nonSpendable = nonSpendable.Add(accLocked.Max(accUnvested)...)
+		return false
+	})
+
+	return types.UnvestedSupply{
+		Locked:       locked,
+		Unvested:     unvested,
+		NonSpendable: nonSpendable,
+	}
+}
@@ -105,9 +105,10 @@ func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
 // values for the amount of delegated free coins.
 // The 'balance' input parameter is the delegator account balance.
 // The 'amount' input parameter are the delegated coins
-// Note that unvested coins cannot be delegated
+// Note that unvested coins can only be delegated if unvested staking is enabled
 func (va *ClawbackVestingAccount) TrackDelegation(_ time.Time, balance, amount sdk.Coins) {
-	// Can only delegate vested (free) coins
+	// Delegations are tracked as free coins, unvested coins stay locked
+	// through the vesting schedule regardless of the bank balance
 	for _, coin := range amount {
 		baseAmt := balance.AmountOf(coin.Denom)
 		// Panic if the delegation amount is zero or if the base coins does not
@@ -277,3 +278,50 @@ func (va *ClawbackVestingAccount) AddGrant(
 
 	return nil
 }
+
+// AccelerateVesting vests the selected future vesting periods at the given
+// time, or all of them if the selector is nil. It returns the vested coins.
+// Since the original vesting coins are unchanged, the accelerated coins are no
+// longer subject to clawback.
+func (va *ClawbackVestingAccount) AccelerateVesting(accelerationTime int64, selected func(idx int) bool) sdk.Coins {
+	vestingEnd, vestingPeriods, vested := AcceleratePeriods(va.GetStartTime(), accelerationTime, va.VestingPeriods, selected)
+	if vested.IsZero() {
+		return vested
+	}
+
+	lockupEnd := va.GetStartTime() + va.LockupPeriods.TotalLength()
+	va.VestingPeriods = vestingPeriods
+	va.EndTime = Max64(vestingEnd, lockupEnd)
+
+	return vested
+}
+
+// AccelerateLockup unlocks all the future lockup periods at the given time.
+// It returns the unlocked coins.
+func (va *ClawbackVestingAccount) AccelerateLockup(accelerationTime int64) sdk.Coins {
+	lockupEnd, lockupPeriods, unlocked := AcceleratePeriods(va.GetStartTime(), accelerationTime, va.LockupPeriods, nil)
+	if unlocked.IsZero() {
+		return unlocked
+	}
+
+	vestingEnd := va.GetStartTime() + va.VestingPeriods.TotalLength()
+	va.LockupPeriods = lockupPeriods
+	va.EndTime = Max64(vestingEnd, lockupEnd)
+
+	return unlocked
+}
+
+// ExtendLockup delays the unlocking events after the given time by the
+// extension.
+func (va *ClawbackVestingAccount) ExtendLockup(readTime, extension int64) error {
+	lockupEnd, lockupPeriods, ok := DelayPeriods(va.GetStartTime(), readTime, extension, va.LockupPeriods)
+	if !ok {
+		return errorsmod.Wrap(ErrVestingLockup, "account has no future unlocking events")
+	}
+
+	vestingEnd := va.GetStartTime() + va.VestingPeriods.TotalLength()
+	va.LockupPeriods = lockupPeriods
+	va.EndTime = Max64(vestingEnd, lockupEnd)
+
+	return nil
+}
@@ -538,6 +538,85 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 	}
 }
 
+func (suite *VestingAccountTestSuite) TestModifySchedules() {
+	now := cmttime.Now()
+	funder := sdk.AccAddress([]byte("funder"))
+
+	testCases := []struct {
+		name           string
+		malleate       func(va *types.ClawbackVestingAccount)
+		expClawedBack  sdk.Coins
+		expLockedCoins sdk.Coins
+	}{
+		{
+			"accelerate vesting of all periods",
+			func(va *types.ClawbackVestingAccount) {
+				vested := va.AccelerateVesting(now.Add(13*time.Hour).Unix(), nil)
+				suite.Require().Equal(getPercentOfVestingCoins(50), vested)
+			},
+			sdk.Coins{},
+			origCoins,
+		},
+		{
+			"accelerate vesting of the last period",
+			func(va *types.ClawbackVestingAccount) {
+				vested := va.AccelerateVesting(now.Add(13*time.Hour).Unix(), func(idx int) bool { return idx == 2 })
+				suite.Require().Equal(getPercentOfVestingCoins(25), vested)
+			},
+			getPercentOfVestingCoins(25),
+			origCoins,
+		},
+		{
+			"accelerate vesting and lockup",
+			func(va *types.ClawbackVestingAccount) {
+				va.AccelerateVesting(now.Add(13*time.Hour).Unix(), nil)
+				unlocked := va.AccelerateLockup(now.Add(13 * time.Hour).Unix())
+				suite.Require().Equal(origCoins, unlocked)
+			},
+			sdk.Coins{},
+			sdk.Coins{},
+		},
+		{
+			"extend lockup",
+			func(va *types.ClawbackVestingAccount) {
+				suite.Require().NoError(va.ExtendLockup(now.Add(13*time.Hour).Unix(), int64(8*3600)))
+			},
+			getPercentOfVestingCoins(50),
+			origCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now, lockupPeriods, vestingPeriods)
+
+			tc.malleate(va)
+			suite.Require().NoError(va.Validate())
+			suite.Require().Equal(origCoins, va.OriginalVesting)
+
+			// read right after the modification, before any of the original
+			// future events
+			readTime := now.Add(14 * time.Hour)
+			suite.Require().Equal(tc.expLockedCoins, va.GetLockedUpCoins(readTime))
+
+			_, clawedBack := va.ComputeClawback(readTime.Unix())
+			suite.Require().Equal(tc.expClawedBack, clawedBack)
+		})
+	}
+
+	// extending the lockup delays the unlocking past the original lockup end
+	va := types.NewClawbackVestingAccount(
+		authtypes.NewBaseAccountWithAddress(sdk.AccAddress(utiltx.GenerateAddress().Bytes())),
+		funder, origCoins, now, lockupPeriods, vestingPeriods,
+	)
+	suite.Require().NoError(va.ExtendLockup(now.Unix(), int64(8*3600)))
+	suite.Require().True(va.HasLockedCoins(now.Add(20 * time.Hour)))
+	suite.Require().False(va.HasLockedCoins(now.Add(24 * time.Hour)))
+	suite.Require().Error(va.ExtendLockup(now.Add(24*time.Hour).Unix(), int64(3600)))
+}
+
 // getPercentOfVestingCoins is a helper function to calculate
 // the specified percentage of the coins in the vesting schedule
 func getPercentOfVestingCoins(percentage int64) sdk.Coins {
@@ -31,6 +31,11 @@ const (
 	updateVestingFunder          = "evmos/MsgUpdateVestingFunder"
 	convertVestingAccount        = "evmos/MsgConvertVestingAccount"
 	fundVestingAccount           = "evmos/MsgFundVestingAccount"
+	accelerateVesting            = "evmos/MsgAccelerateVesting"
+	acceleratePeriod             = "evmos/MsgAcceleratePeriod"
+	extendLockup                 = "evmos/MsgExtendLockup"
+	batchFundVestingAccounts     = "evmos/MsgBatchFundVestingAccounts"
+	setUnvestedStaking           = "evmos/MsgSetUnvestedStaking"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -71,6 +76,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateVestingFunder{},
 		&MsgFundVestingAccount{},
 		&MsgConvertVestingAccount{},
+		&MsgAccelerateVesting{},
+		&MsgAcceleratePeriod{},
+		&MsgExtendLockup{},
+		&MsgBatchFundVestingAccounts{},
+		&MsgSetUnvestedStaking{},
 	)
 
 	registry.RegisterImplementations(
@@ -90,4 +100,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
 	cdc.RegisterConcrete(&MsgConvertVestingAccount{}, convertVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgFundVestingAccount{}, fundVestingAccount, nil)
+	cdc.RegisterConcrete(&MsgAccelerateVesting{}, accelerateVesting, nil)
+	cdc.RegisterConcrete(&MsgAcceleratePeriod{}, acceleratePeriod, nil)
+	cdc.RegisterConcrete(&MsgExtendLockup{}, extendLockup, nil)
+	cdc.RegisterConcrete(&MsgBatchFundVestingAccounts{}, batchFundVestingAccounts, nil)
+	cdc.RegisterConcrete(&MsgSetUnvestedStaking{}, setUnvestedStaking, nil)
 }
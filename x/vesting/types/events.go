@@ -9,6 +9,11 @@ const (
 	EventTypeFundVestingAccount           = "fund_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeAccelerateVesting            = "accelerate_vesting"
+	EventTypeExtendLockup                 = "extend_lockup"
+	EventTypeBatchFundVestingAccounts     = "batch_fund_vesting_accounts"
+	EventTypeSetUnvestedStaking           = "set_unvested_staking"
+	EventTypeCompleteClawback             = "complete_clawback"
 
 	AttributeKeyCoins       = "coins"
 	AttributeKeyStartTime   = "start_time"
@@ -16,4 +21,9 @@ const (
 	AttributeKeyFunder      = "funder"
 	AttributeKeyNewFunder   = "new_funder"
 	AttributeKeyDestination = "destination"
+	AttributeKeyUnlocked    = "unlocked"
+	AttributeKeyExtension   = "extension"
+	AttributeKeyGrants      = "grants"
+	AttributeKeyEnabled     = "enabled"
+	AttributeKeyUnbonding   = "unbonding"
 )
@@ -0,0 +1,39 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(pendingClawbacks []PendingClawback) GenesisState {
+	return GenesisState{
+		PendingClawbacks: pendingClawbacks,
+	}
+}
+
+// DefaultGenesisState returns the default vesting genesis state without any
+// pending clawbacks.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenClawbacks := make(map[string]bool)
+	for _, pc := range gs.PendingClawbacks {
+		if err := pc.Validate(); err != nil {
+			return fmt.Errorf("invalid pending clawback on genesis: %w", err)
+		}
+
+		// pending clawbacks are stored by unbonding ID and vesting account
+		key := fmt.Sprintf("%d/%s", pc.UnbondingId, pc.VestingAddress)
+		if seenClawbacks[key] {
+			return fmt.Errorf("pending clawback duplicated on genesis: unbonding ID %d, account %s", pc.UnbondingId, pc.VestingAddress)
+		}
+		seenClawbacks[key] = true
+	}
+
+	return nil
+}
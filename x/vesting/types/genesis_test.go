@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/vesting/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	vestingAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	destAddr := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	amount := sdk.NewInt64Coin("aevmos", 100)
+	pc := types.PendingClawback{
+		VestingAddress:     vestingAddr,
+		DestinationAddress: destAddr,
+		UnbondingId:        1,
+		Amount:             amount,
+		EntryBalance:       amount.Amount,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(pc types.PendingClawback) []types.PendingClawback
+		expPass  bool
+	}{
+		{
+			"pass - valid pending clawback",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				return []types.PendingClawback{pc}
+			},
+			true,
+		},
+		{
+			"pass - same unbonding ID for different accounts",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				other := pc
+				other.VestingAddress = destAddr
+				return []types.PendingClawback{pc, other}
+			},
+			true,
+		},
+		{
+			"fail - duplicated pending clawback",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				return []types.PendingClawback{pc, pc}
+			},
+			false,
+		},
+		{
+			"fail - invalid vesting address",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				pc.VestingAddress = "evmos1"
+				return []types.PendingClawback{pc}
+			},
+			false,
+		},
+		{
+			"fail - invalid destination address",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				pc.DestinationAddress = ""
+				return []types.PendingClawback{pc}
+			},
+			false,
+		},
+		{
+			"fail - zero unbonding ID",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				pc.UnbondingId = 0
+				return []types.PendingClawback{pc}
+			},
+			false,
+		},
+		{
+			"fail - invalid amount",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				pc.Amount = sdk.Coin{Denom: "aevmos", Amount: math.NewInt(-1)}
+				return []types.PendingClawback{pc}
+			},
+			false,
+		},
+		{
+			"fail - negative entry balance",
+			func(pc types.PendingClawback) []types.PendingClawback {
+				pc.EntryBalance = math.NewInt(-1)
+				return []types.PendingClawback{pc}
+			},
+			false,
+		},
+	}
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(tc.malleate(pc))
+			err := gs.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
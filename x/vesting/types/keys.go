@@ -10,6 +10,15 @@ const (
 	// prefixGovClawbackProposalKey to be used in the KVStore to track vesting accounts that are subject
 	// to active governance clawback proposals.
 	prefixGovClawbackProposalKey
+	// prefixPendingClawbackKey to be used in the KVStore to track the clawed
+	// back coins that are still unbonding.
+	prefixPendingClawbackKey
+	// prefixUnvestedSupplyKey to be used in the KVStore to store the unvested
+	// supply snapshot of the last day epoch.
+	prefixUnvestedSupplyKey
+	// prefixClawbackAccountKey to be used in the KVStore to index the
+	// addresses of the clawback vesting accounts.
+	prefixClawbackAccountKey
 )
 
 var (
@@ -18,6 +27,14 @@ var (
 	// KeyPrefixGovClawbackProposalKey is the slice of prefix bytes for storing the vesting account
 	// of governance clawback proposals.
 	KeyPrefixGovClawbackProposalKey = []byte{prefixGovClawbackProposalKey}
+	// KeyPrefixPendingClawback is the slice of prefix bytes for storing the
+	// pending clawbacks of unbonding coins.
+	KeyPrefixPendingClawback = []byte{prefixPendingClawbackKey}
+	// KeyUnvestedSupply is the key for storing the unvested supply snapshot.
+	KeyUnvestedSupply = []byte{prefixUnvestedSupplyKey}
+	// KeyPrefixClawbackAccount is the slice of prefix bytes for indexing the
+	// clawback vesting accounts.
+	KeyPrefixClawbackAccount = []byte{prefixClawbackAccountKey}
 )
 
 const (
@@ -20,6 +20,11 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgAccelerateVesting{}
+	_ sdk.Msg = &MsgAcceleratePeriod{}
+	_ sdk.Msg = &MsgExtendLockup{}
+	_ sdk.Msg = &MsgBatchFundVestingAccounts{}
+	_ sdk.Msg = &MsgSetUnvestedStaking{}
 )
 
 const (
@@ -28,6 +33,11 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgAccelerateVesting            = "accelerate_vesting"
+	TypeMsgAcceleratePeriod             = "accelerate_period"
+	TypeMsgExtendLockup                 = "extend_lockup"
+	TypeMsgBatchFundVestingAccounts     = "batch_fund_vesting_accounts"
+	TypeMsgSetUnvestedStaking           = "set_unvested_staking"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -114,8 +124,19 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "vesting address cannot be the zero address")
 	}
 
+	return validateSchedules(msg.LockupPeriods, msg.VestingPeriods)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgFundVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// validateSchedules checks that the lockup and vesting periods of a grant
+// contain valid amounts and lengths and describe the same total amount.
+func validateSchedules(lockupPeriods, vestingPeriods sdkvesting.Periods) error {
 	lockupCoins := sdk.NewCoins()
-	for i, period := range msg.LockupPeriods {
+	for i, period := range lockupPeriods {
 		if period.Length < 1 {
 			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
 		}
@@ -126,7 +147,7 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 	}
 
 	vestingCoins := sdk.NewCoins()
-	for i, period := range msg.VestingPeriods {
+	for i, period := range vestingPeriods {
 		if period.Length < 1 {
 			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
 		}
@@ -144,18 +165,13 @@ func (msg MsgFundVestingAccount) ValidateBasic() error {
 
 	// If both schedules are present, they must describe the same total amount.
 	// IsEqual can panic, so use (a == b) <=> (a <= b && b <= a).
-	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 && !CoinEq(lockupCoins, vestingCoins) {
+	if len(lockupPeriods) > 0 && len(vestingPeriods) > 0 && !CoinEq(lockupCoins, vestingCoins) {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and lockup schedules must have same total coins")
 	}
 
 	return nil
 }
 
-// GetSignBytes encodes the message for signing
-func (msg *MsgFundVestingAccount) GetSignBytes() []byte {
-	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
-}
-
 // NewMsgClawback creates new instance of MsgClawback. The dest address may be
 // nil - defaulting to the funder.
 func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
@@ -273,3 +289,207 @@ func (msg MsgConvertVestingAccount) ValidateBasic() error {
 func (msg *MsgConvertVestingAccount) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
 }
+
+// NewMsgAccelerateVesting creates new instance of MsgAccelerateVesting
+func NewMsgAccelerateVesting(funder, vesting sdk.AccAddress, unlock bool) *MsgAccelerateVesting {
+	return &MsgAccelerateVesting{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		Unlock:         unlock,
+	}
+}
+
+// Route returns the message route for a MsgAccelerateVesting.
+func (msg MsgAccelerateVesting) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAccelerateVesting.
+func (msg MsgAccelerateVesting) Type() string { return TypeMsgAccelerateVesting }
+
+// ValidateBasic runs stateless checks on the MsgAccelerateVesting message
+func (msg MsgAccelerateVesting) ValidateBasic() error {
+	return validateFunderAndVesting(msg.FunderAddress, msg.VestingAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAccelerateVesting) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgAcceleratePeriod creates new instance of MsgAcceleratePeriod
+func NewMsgAcceleratePeriod(funder, vesting sdk.AccAddress, periodIndex uint32) *MsgAcceleratePeriod {
+	return &MsgAcceleratePeriod{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		PeriodIndex:    periodIndex,
+	}
+}
+
+// Route returns the message route for a MsgAcceleratePeriod.
+func (msg MsgAcceleratePeriod) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAcceleratePeriod.
+func (msg MsgAcceleratePeriod) Type() string { return TypeMsgAcceleratePeriod }
+
+// ValidateBasic runs stateless checks on the MsgAcceleratePeriod message
+func (msg MsgAcceleratePeriod) ValidateBasic() error {
+	return validateFunderAndVesting(msg.FunderAddress, msg.VestingAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAcceleratePeriod) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgExtendLockup creates new instance of MsgExtendLockup
+func NewMsgExtendLockup(funder, vesting sdk.AccAddress, extension time.Duration) *MsgExtendLockup {
+	return &MsgExtendLockup{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		Extension:      extension,
+	}
+}
+
+// Route returns the message route for a MsgExtendLockup.
+func (msg MsgExtendLockup) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgExtendLockup.
+func (msg MsgExtendLockup) Type() string { return TypeMsgExtendLockup }
+
+// ValidateBasic runs stateless checks on the MsgExtendLockup message
+func (msg MsgExtendLockup) ValidateBasic() error {
+	if err := validateFunderAndVesting(msg.FunderAddress, msg.VestingAddress); err != nil {
+		return err
+	}
+
+	// schedules have a precision of seconds
+	if msg.Extension < time.Second {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "lockup extension must be at least one second, got %s", msg.Extension)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgExtendLockup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgSetUnvestedStaking creates new instance of MsgSetUnvestedStaking
+func NewMsgSetUnvestedStaking(funder, vesting sdk.AccAddress, enabled bool) *MsgSetUnvestedStaking {
+	return &MsgSetUnvestedStaking{
+		FunderAddress:  funder.String(),
+		VestingAddress: vesting.String(),
+		Enabled:        enabled,
+	}
+}
+
+// Route returns the message route for a MsgSetUnvestedStaking.
+func (msg MsgSetUnvestedStaking) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgSetUnvestedStaking.
+func (msg MsgSetUnvestedStaking) Type() string { return TypeMsgSetUnvestedStaking }
+
+// ValidateBasic runs stateless checks on the MsgSetUnvestedStaking message
+func (msg MsgSetUnvestedStaking) ValidateBasic() error {
+	return validateFunderAndVesting(msg.FunderAddress, msg.VestingAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetUnvestedStaking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// NewMsgBatchFundVestingAccounts creates new instance of MsgBatchFundVestingAccounts
+func NewMsgBatchFundVestingAccounts(
+	funderAddr sdk.AccAddress,
+	templates []VestingTemplate,
+	grants []BatchGrant,
+) *MsgBatchFundVestingAccounts {
+	return &MsgBatchFundVestingAccounts{
+		FunderAddress: funderAddr.String(),
+		Templates:     templates,
+		Grants:        grants,
+	}
+}
+
+// Route returns the message route for a MsgBatchFundVestingAccounts.
+func (msg MsgBatchFundVestingAccounts) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgBatchFundVestingAccounts.
+func (msg MsgBatchFundVestingAccounts) Type() string { return TypeMsgBatchFundVestingAccounts }
+
+// ValidateBasic runs stateless checks on the MsgBatchFundVestingAccounts
+// message. Every template must be a valid schedule and every grant must
+// reference an existing template. A vesting account can only be funded once
+// per message.
+func (msg MsgBatchFundVestingAccounts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if len(msg.Templates) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "templates cannot be empty")
+	}
+
+	for i, template := range msg.Templates {
+		if err := validateSchedules(template.LockupPeriods, template.VestingPeriods); err != nil {
+			return errorsmod.Wrapf(err, "template %d", i)
+		}
+	}
+
+	if len(msg.Grants) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "grants cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(msg.Grants))
+	for i, grant := range msg.Grants {
+		if err := grant.Validate(len(msg.Templates)); err != nil {
+			return errorsmod.Wrapf(err, "grant %d", i)
+		}
+
+		if seen[grant.VestingAddress] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "grant %d: duplicate vesting address %s", i, grant.VestingAddress)
+		}
+		seen[grant.VestingAddress] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgBatchFundVestingAccounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// Validate checks that the grant has a valid vesting address and references
+// one of the given number of templates.
+func (g BatchGrant) Validate(templates int) error {
+	vestingAddr, err := sdk.AccAddressFromBech32(g.VestingAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if equal := bytes.Compare(vestingAddr.Bytes(), common.Address{}.Bytes()); equal == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "vesting address cannot be the zero address")
+	}
+
+	if int(g.TemplateIndex) >= templates {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "template index %d out of range, got %d templates", g.TemplateIndex, templates)
+	}
+
+	return nil
+}
+
+// validateFunderAndVesting checks the funder and vesting addresses of the
+// messages that modify the schedules of a vesting account.
+func validateFunderAndVesting(funder, vesting string) error {
+	if _, err := sdk.AccAddressFromBech32(funder); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(vesting); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	return nil
+}
@@ -488,3 +488,141 @@ func (suite *MsgsTestSuite) TestMsgConvertVestingAccount() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgExtendLockup() {
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	vesting := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgExtendLockup
+		expPass bool
+	}{
+		{
+			"fail - not a valid funder address",
+			&types.MsgExtendLockup{
+				FunderAddress:  "invalid_address",
+				VestingAddress: vesting.String(),
+				Extension:      time.Hour,
+			},
+			false,
+		},
+		{
+			"fail - not a valid vesting address",
+			&types.MsgExtendLockup{
+				FunderAddress:  funder.String(),
+				VestingAddress: "invalid_address",
+				Extension:      time.Hour,
+			},
+			false,
+		},
+		{
+			"fail - extension shorter than a second",
+			types.NewMsgExtendLockup(funder, vesting, time.Millisecond),
+			false,
+		},
+		{
+			"pass - valid msg",
+			types.NewMsgExtendLockup(funder, vesting, time.Hour),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgBatchFundVestingAccounts() {
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	vesting1 := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	vesting2 := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	template := types.VestingTemplate{
+		StartTime:      time.Unix(1000, 0).UTC(),
+		VestingPeriods: sdkvesting.Periods{{Length: 100, Amount: coins}},
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgBatchFundVestingAccounts
+		expPass bool
+	}{
+		{
+			"fail - not a valid funder address",
+			&types.MsgBatchFundVestingAccounts{
+				FunderAddress: "invalid_address",
+				Templates:     []types.VestingTemplate{template},
+				Grants:        []types.BatchGrant{{VestingAddress: vesting1}},
+			},
+			false,
+		},
+		{
+			"fail - no templates",
+			types.NewMsgBatchFundVestingAccounts(funder, nil, []types.BatchGrant{{VestingAddress: vesting1}}),
+			false,
+		},
+		{
+			"fail - empty template",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{{}}, []types.BatchGrant{{VestingAddress: vesting1}}),
+			false,
+		},
+		{
+			"fail - no grants",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, nil),
+			false,
+		},
+		{
+			"fail - not a valid vesting address",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, []types.BatchGrant{{VestingAddress: "invalid_address"}}),
+			false,
+		},
+		{
+			"fail - zero vesting address",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, []types.BatchGrant{{VestingAddress: sdk.AccAddress(zeroAddress).String()}}),
+			false,
+		},
+		{
+			"fail - template index out of range",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, []types.BatchGrant{{VestingAddress: vesting1, TemplateIndex: 1}}),
+			false,
+		},
+		{
+			"fail - duplicate vesting address",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, []types.BatchGrant{{VestingAddress: vesting1}, {VestingAddress: vesting1}}),
+			false,
+		},
+		{
+			"pass - valid msg",
+			types.NewMsgBatchFundVestingAccounts(funder, []types.VestingTemplate{template}, []types.BatchGrant{{VestingAddress: vesting1}, {VestingAddress: vesting2}}),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetUnvestedStaking() {
+	funder := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	vesting := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	msg := types.NewMsgSetUnvestedStaking(funder, vesting, true)
+	suite.Require().Equal(types.TypeMsgSetUnvestedStaking, msg.Type())
+	suite.Require().NoError(msg.ValidateBasic())
+
+	msg.VestingAddress = "invalid_address"
+	suite.Require().Error(msg.ValidateBasic())
+}
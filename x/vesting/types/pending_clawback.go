@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the pending clawback fields.
+func (pc PendingClawback) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pc.VestingAddress); err != nil {
+		return fmt.Errorf("invalid vesting address %s: %w", pc.VestingAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(pc.DestinationAddress); err != nil {
+		return fmt.Errorf("invalid destination address %s: %w", pc.DestinationAddress, err)
+	}
+
+	if pc.UnbondingId == 0 {
+		return fmt.Errorf("invalid unbonding ID 0 for %s", pc.VestingAddress)
+	}
+
+	if err := pc.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount for %s: %w", pc.VestingAddress, err)
+	}
+
+	if pc.EntryBalance.IsNil() || pc.EntryBalance.IsNegative() {
+		return fmt.Errorf("invalid entry balance %s for %s", pc.EntryBalance, pc.VestingAddress)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -143,40 +144,363 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+// QueryClawbackVestingAccountsRequest is the request type for the
+// Query/ClawbackVestingAccounts RPC method.
+type QueryClawbackVestingAccountsRequest struct {
+	// funder_address optionally restricts the results to the accounts funded by
+	// the given address
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClawbackVestingAccountsRequest) Reset()         { *m = QueryClawbackVestingAccountsRequest{} }
+func (m *QueryClawbackVestingAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackVestingAccountsRequest) ProtoMessage()    {}
+func (*QueryClawbackVestingAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{2}
+}
+func (m *QueryClawbackVestingAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackVestingAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackVestingAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackVestingAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackVestingAccountsRequest.Merge(m, src)
+}
+func (m *QueryClawbackVestingAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackVestingAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackVestingAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackVestingAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryClawbackVestingAccountsRequest) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *QueryClawbackVestingAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryClawbackVestingAccountsResponse is the response type for the
+// Query/ClawbackVestingAccounts RPC method.
+type QueryClawbackVestingAccountsResponse struct {
+	// accounts defines the clawback vesting accounts and their current balances
+	Accounts []VestingAccountBalances `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClawbackVestingAccountsResponse) Reset()         { *m = QueryClawbackVestingAccountsResponse{} }
+func (m *QueryClawbackVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClawbackVestingAccountsResponse) ProtoMessage()    {}
+func (*QueryClawbackVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{3}
+}
+func (m *QueryClawbackVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClawbackVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClawbackVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClawbackVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClawbackVestingAccountsResponse.Merge(m, src)
+}
+func (m *QueryClawbackVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClawbackVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClawbackVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClawbackVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryClawbackVestingAccountsResponse) GetAccounts() []VestingAccountBalances {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *QueryClawbackVestingAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// VestingAccountBalances defines the funder and the current locked, unvested
+// and vested tokens of a clawback vesting account.
+type VestingAccountBalances struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// funder_address of the clawback vesting account
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// locked defines the current amount of locked tokens
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// unvested defines the current amount of unvested tokens
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// vested defines the current amount of vested tokens
+	Vested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=vested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested"`
+}
+
+func (m *VestingAccountBalances) Reset()         { *m = VestingAccountBalances{} }
+func (m *VestingAccountBalances) String() string { return proto.CompactTextString(m) }
+func (*VestingAccountBalances) ProtoMessage()    {}
+func (*VestingAccountBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{4}
+}
+func (m *VestingAccountBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingAccountBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingAccountBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingAccountBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingAccountBalances.Merge(m, src)
+}
+func (m *VestingAccountBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingAccountBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingAccountBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingAccountBalances proto.InternalMessageInfo
+
+func (m *VestingAccountBalances) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VestingAccountBalances) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *VestingAccountBalances) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *VestingAccountBalances) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *VestingAccountBalances) GetVested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vested
+	}
+	return nil
+}
+
+// QueryUnvestedSupplyRequest is the request type for the Query/UnvestedSupply
+// RPC method.
+type QueryUnvestedSupplyRequest struct {
+}
+
+func (m *QueryUnvestedSupplyRequest) Reset()         { *m = QueryUnvestedSupplyRequest{} }
+func (m *QueryUnvestedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnvestedSupplyRequest) ProtoMessage()    {}
+func (*QueryUnvestedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{5}
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnvestedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnvestedSupplyRequest.Merge(m, src)
+}
+func (m *QueryUnvestedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnvestedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnvestedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnvestedSupplyRequest proto.InternalMessageInfo
+
+// QueryUnvestedSupplyResponse is the response type for the
+// Query/UnvestedSupply RPC method.
+type QueryUnvestedSupplyResponse struct {
+	// locked defines the total amount of locked tokens
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// unvested defines the total amount of unvested tokens
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// non_spendable defines the sum over all accounts of the larger of their
+	// locked and unvested tokens, which are not part of the circulating supply
+	NonSpendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=non_spendable,json=nonSpendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"non_spendable"`
+}
+
+func (m *QueryUnvestedSupplyResponse) Reset()         { *m = QueryUnvestedSupplyResponse{} }
+func (m *QueryUnvestedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnvestedSupplyResponse) ProtoMessage()    {}
+func (*QueryUnvestedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e31744b0ce27e85a, []int{6}
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnvestedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnvestedSupplyResponse.Merge(m, src)
+}
+func (m *QueryUnvestedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnvestedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnvestedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnvestedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryUnvestedSupplyResponse) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *QueryUnvestedSupplyResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *QueryUnvestedSupplyResponse) GetNonSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NonSpendable
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v2.QueryBalancesRequest")
 	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v2.QueryBalancesResponse")
+	proto.RegisterType((*QueryClawbackVestingAccountsRequest)(nil), "evmos.vesting.v2.QueryClawbackVestingAccountsRequest")
+	proto.RegisterType((*QueryClawbackVestingAccountsResponse)(nil), "evmos.vesting.v2.QueryClawbackVestingAccountsResponse")
+	proto.RegisterType((*VestingAccountBalances)(nil), "evmos.vesting.v2.VestingAccountBalances")
+	proto.RegisterType((*QueryUnvestedSupplyRequest)(nil), "evmos.vesting.v2.QueryUnvestedSupplyRequest")
+	proto.RegisterType((*QueryUnvestedSupplyResponse)(nil), "evmos.vesting.v2.QueryUnvestedSupplyResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/query.proto", fileDescriptor_e31744b0ce27e85a) }
 
 var fileDescriptor_e31744b0ce27e85a = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0xf5, 0x82, 0x4a, 0xe9, 0xf6, 0xd2, 0x5a, 0x54, 0x72, 0x11, 0x32, 0x08, 0x55, 0x14, 0xa1,
-	0x76, 0x17, 0x5c, 0xf5, 0x07, 0x68, 0x7f, 0xa0, 0x1c, 0x7b, 0x5b, 0xdb, 0x2b, 0x63, 0x61, 0x76,
-	0x0c, 0xbb, 0xb6, 0x8a, 0xaa, 0x5e, 0x7a, 0xeb, 0xad, 0x12, 0x3f, 0x11, 0xe5, 0x94, 0xcf, 0xe0,
-	0x88, 0x94, 0x4b, 0x72, 0x49, 0x22, 0x88, 0x94, 0xdf, 0x88, 0x6c, 0x2f, 0x28, 0x22, 0x91, 0x72,
-	0xe2, 0x62, 0x8f, 0xfd, 0x66, 0xde, 0xbc, 0x37, 0x33, 0xb8, 0xc1, 0xd3, 0x29, 0x48, 0x9a, 0x72,
-	0xa9, 0x42, 0x11, 0xd0, 0xd4, 0xa1, 0xb3, 0x84, 0xcf, 0x17, 0x24, 0x9e, 0x83, 0x02, 0xf3, 0x4d,
-	0x8e, 0x12, 0x8d, 0x92, 0xd4, 0xa9, 0xbf, 0x65, 0xd3, 0x50, 0x00, 0xcd, 0x9f, 0x45, 0x52, 0xdd,
-	0xf6, 0x40, 0x66, 0x1c, 0x2e, 0x93, 0x9c, 0xa6, 0x03, 0x97, 0x2b, 0x36, 0xa0, 0x1e, 0x84, 0x42,
-	0xe3, 0xb5, 0x00, 0x02, 0xc8, 0x43, 0x9a, 0x45, 0xfa, 0x6f, 0x23, 0x00, 0x08, 0x22, 0x4e, 0x59,
-	0x1c, 0x52, 0x26, 0x04, 0x28, 0xa6, 0x42, 0x10, 0xb2, 0x40, 0xdb, 0x7d, 0x5c, 0xfb, 0x91, 0xe9,
-	0x18, 0xb2, 0x88, 0x09, 0x8f, 0xcb, 0x11, 0x9f, 0x25, 0x5c, 0x2a, 0xd3, 0xc2, 0x2f, 0x99, 0xef,
-	0xcf, 0xb9, 0x94, 0x16, 0x6a, 0xa1, 0xee, 0xab, 0xd1, 0xee, 0xb3, 0x7d, 0x59, 0xc2, 0xef, 0x0e,
-	0x4a, 0x64, 0x0c, 0x42, 0x72, 0x73, 0x8c, 0x2b, 0x11, 0x78, 0x13, 0xee, 0x5b, 0xa8, 0x55, 0xee,
-	0xbe, 0x76, 0xde, 0x93, 0x42, 0x30, 0xc9, 0x04, 0x13, 0x2d, 0x98, 0x7c, 0x83, 0x50, 0x0c, 0xbf,
-	0xae, 0xae, 0x9a, 0xc6, 0xe9, 0x75, 0xb3, 0x1b, 0x84, 0x6a, 0x9c, 0xb8, 0xc4, 0x83, 0x29, 0xd5,
-	0xee, 0x8a, 0xd7, 0x67, 0xe9, 0x4f, 0xa8, 0x5a, 0xc4, 0x5c, 0xe6, 0x05, 0xf2, 0xe4, 0xee, 0xac,
-	0x87, 0x46, 0x9a, 0xdf, 0x8c, 0x70, 0x35, 0x11, 0xd9, 0xb0, 0xb8, 0x6f, 0x95, 0x8e, 0xd4, 0x6b,
-	0xdf, 0x21, 0xf3, 0xa5, 0x7b, 0x95, 0x8f, 0xe5, 0xab, 0xe0, 0x77, 0x96, 0x08, 0xbf, 0xc8, 0x67,
-	0x6b, 0xfe, 0x43, 0xb8, 0xba, 0x1b, 0xb0, 0xd9, 0x21, 0x87, 0xe7, 0x41, 0x9e, 0x5a, 0x5a, 0xfd,
-	0xe3, 0xb3, 0x79, 0xc5, 0xa6, 0xda, 0x9f, 0xfe, 0x9e, 0xdf, 0x2e, 0x4b, 0x1d, 0xf3, 0x03, 0x7d,
-	0x74, 0x95, 0xae, 0xce, 0xa5, 0xbf, 0xf5, 0xc2, 0xff, 0x0c, 0xbf, 0xaf, 0x36, 0x36, 0x5a, 0x6f,
-	0x6c, 0x74, 0xb3, 0xb1, 0xd1, 0xff, 0xad, 0x6d, 0xac, 0xb7, 0xb6, 0x71, 0xb1, 0xb5, 0x8d, 0x9f,
-	0xbd, 0x07, 0x36, 0x0b, 0x26, 0xcd, 0xe7, 0xf4, 0xe9, 0xaf, 0x3d, 0x6b, 0x6e, 0xd7, 0xad, 0xe4,
-	0x07, 0xf7, 0xe5, 0x3e, 0x00, 0x00, 0xff, 0xff, 0x04, 0xf4, 0x26, 0xc1, 0x09, 0x03, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0x2d, 0xf0, 0xe3, 0x37, 0x08, 0xd1, 0x09, 0x6a, 0xad, 0xcd, 0x82, 0x0b, 0x42,
+	0x25, 0xb0, 0x03, 0x35, 0x78, 0xa7, 0x18, 0x4d, 0x3c, 0x69, 0x89, 0x1e, 0xbc, 0x90, 0xd9, 0xdd,
+	0x71, 0xd9, 0xb0, 0xcc, 0x2c, 0x9d, 0xdd, 0x2a, 0x31, 0x5e, 0xbc, 0x79, 0x33, 0xd1, 0x83, 0x89,
+	0x6f, 0xc0, 0x18, 0x0f, 0xde, 0x7d, 0x03, 0x1c, 0x49, 0xbc, 0xe8, 0xc1, 0x3f, 0x01, 0x13, 0x2f,
+	0xbe, 0x08, 0xb3, 0x33, 0xb3, 0x85, 0xd2, 0x2d, 0xe8, 0xa1, 0x1c, 0xbc, 0xb4, 0xdb, 0x99, 0xe7,
+	0x79, 0xbe, 0xdf, 0x79, 0xe6, 0x33, 0xb3, 0x85, 0x65, 0xda, 0xdc, 0xe0, 0x02, 0x37, 0xa9, 0x88,
+	0x7c, 0xe6, 0xe1, 0x66, 0x15, 0x6f, 0xc6, 0xb4, 0xb1, 0x65, 0x85, 0x0d, 0x1e, 0x71, 0x74, 0x5a,
+	0xce, 0x5a, 0x7a, 0xd6, 0x6a, 0x56, 0x4b, 0x67, 0xc8, 0x86, 0xcf, 0x38, 0x96, 0x9f, 0x2a, 0xa8,
+	0x34, 0xe3, 0x70, 0x91, 0xd4, 0xb0, 0x89, 0xa0, 0x2a, 0x1b, 0x37, 0x17, 0x6c, 0x1a, 0x91, 0x05,
+	0x1c, 0x12, 0xcf, 0x67, 0x24, 0xf2, 0x39, 0xd3, 0xb1, 0xc6, 0xc1, 0xd8, 0x34, 0xca, 0xe1, 0x7e,
+	0x3a, 0x3f, 0xea, 0x71, 0x8f, 0xcb, 0x47, 0x9c, 0x3c, 0xe9, 0xd1, 0xb2, 0xc7, 0xb9, 0x17, 0x50,
+	0x4c, 0x42, 0x1f, 0x13, 0xc6, 0x78, 0x24, 0x4b, 0x0a, 0x35, 0x6b, 0xce, 0xc3, 0xd1, 0x3b, 0x89,
+	0x6a, 0x8d, 0x04, 0x84, 0x39, 0x54, 0xd4, 0xe9, 0x66, 0x4c, 0x45, 0x84, 0x8a, 0xf0, 0x3f, 0xe2,
+	0xba, 0x0d, 0x2a, 0x44, 0x11, 0x8c, 0x83, 0xca, 0xff, 0xf5, 0xf4, 0xa7, 0xf9, 0x39, 0x0f, 0xcf,
+	0x1e, 0x4a, 0x11, 0x21, 0x67, 0x82, 0xa2, 0x35, 0x38, 0x10, 0x70, 0x67, 0x9d, 0xba, 0x45, 0x30,
+	0x5e, 0xa8, 0x0c, 0x55, 0x2f, 0x58, 0xca, 0xb0, 0x95, 0x18, 0xb6, 0xb4, 0x61, 0x6b, 0x99, 0xfb,
+	0xac, 0xb6, 0xb8, 0xfd, 0x75, 0x2c, 0xf7, 0xf6, 0xdb, 0x58, 0xc5, 0xf3, 0xa3, 0xb5, 0xd8, 0xb6,
+	0x1c, 0xbe, 0x81, 0xf5, 0xea, 0xd4, 0xd7, 0x9c, 0x70, 0xd7, 0x71, 0xb4, 0x15, 0x52, 0x21, 0x13,
+	0xc4, 0x9b, 0x9f, 0xef, 0x67, 0x40, 0x5d, 0xd7, 0x47, 0x01, 0x1c, 0x8c, 0x59, 0xd2, 0x58, 0xea,
+	0x16, 0xf3, 0x3d, 0xd2, 0x6a, 0x29, 0x24, 0xeb, 0xd2, 0x5a, 0x85, 0x5e, 0xad, 0x4b, 0xd5, 0x37,
+	0x5f, 0x02, 0x38, 0x21, 0x7b, 0xbb, 0x1c, 0x90, 0x87, 0x36, 0x71, 0xd6, 0xef, 0x29, 0x78, 0x96,
+	0x1c, 0x87, 0xc7, 0x2c, 0x6a, 0xed, 0xce, 0x65, 0x38, 0xf2, 0x20, 0x66, 0x2e, 0x6d, 0xac, 0xb6,
+	0x6f, 0xd2, 0xb0, 0x1a, 0x5d, 0x52, 0x83, 0xe8, 0x06, 0x84, 0xfb, 0x10, 0x15, 0xf3, 0xe3, 0xa0,
+	0x32, 0x54, 0x9d, 0x6a, 0x33, 0xaf, 0x78, 0x4d, 0x97, 0x70, 0x9b, 0x78, 0x54, 0x4b, 0xd4, 0x0f,
+	0x64, 0x9a, 0x1f, 0x00, 0x9c, 0x3c, 0xda, 0x96, 0x26, 0xe0, 0x16, 0x1c, 0x24, 0x7a, 0x4c, 0x33,
+	0x50, 0xb1, 0x0e, 0x9f, 0x02, 0xab, 0x3d, 0x39, 0xa5, 0xa8, 0xd6, 0x97, 0xb4, 0xae, 0xde, 0xca,
+	0x47, 0x37, 0x33, 0xcc, 0x4f, 0x1f, 0x6b, 0x5e, 0x19, 0x69, 0x73, 0xff, 0xba, 0x00, 0xcf, 0x65,
+	0x6b, 0x76, 0xa7, 0x3c, 0xa3, 0xc3, 0xf9, 0xac, 0x0e, 0xef, 0x23, 0x5f, 0x38, 0x41, 0xe4, 0xfb,
+	0x4e, 0x10, 0xf9, 0xfe, 0x1e, 0x23, 0x5f, 0x86, 0x25, 0x89, 0xd6, 0x5d, 0x2d, 0xbd, 0x12, 0x87,
+	0x61, 0xb0, 0xa5, 0x29, 0x34, 0x7f, 0xe5, 0xe1, 0xc5, 0xcc, 0xe9, 0x7f, 0xfc, 0xca, 0x89, 0xe1,
+	0x30, 0xe3, 0x6c, 0x55, 0x84, 0x94, 0xb9, 0xc4, 0x0e, 0x68, 0xcf, 0xf0, 0x3a, 0xc5, 0x38, 0x5b,
+	0x49, 0x55, 0xaa, 0x5f, 0x0a, 0xb0, 0x5f, 0xb6, 0x1b, 0x3d, 0x03, 0x70, 0xb0, 0x75, 0x4c, 0xa6,
+	0x3a, 0x0f, 0x71, 0xd6, 0x4b, 0xa3, 0x34, 0x7d, 0x6c, 0x9c, 0xda, 0x36, 0x73, 0xf6, 0xe9, 0xc7,
+	0x1f, 0x2f, 0xf2, 0x53, 0x68, 0x12, 0x77, 0xbc, 0x41, 0x6d, 0x1d, 0x8b, 0x1f, 0xeb, 0x83, 0xf7,
+	0x04, 0xbd, 0x03, 0xf0, 0x7c, 0x97, 0x9b, 0x07, 0x2d, 0x76, 0x91, 0x3c, 0xfa, 0x02, 0x2d, 0x5d,
+	0xfb, 0xdb, 0x34, 0x6d, 0xdc, 0x94, 0xc6, 0xcb, 0xa8, 0xd4, 0x69, 0xbc, 0x75, 0x71, 0xbd, 0x02,
+	0x70, 0xa4, 0x1d, 0x57, 0x34, 0xdb, 0x45, 0x2e, 0x13, 0xfa, 0xd2, 0xdc, 0x1f, 0x46, 0x6b, 0x4f,
+	0x57, 0xa4, 0xa7, 0x09, 0x74, 0xa9, 0xd3, 0x53, 0xca, 0xd3, 0xaa, 0x90, 0x29, 0xb5, 0xeb, 0xdb,
+	0xbb, 0x06, 0xd8, 0xd9, 0x35, 0xc0, 0xf7, 0x5d, 0x03, 0x3c, 0xdf, 0x33, 0x72, 0x3b, 0x7b, 0x46,
+	0xee, 0xd3, 0x9e, 0x91, 0xbb, 0x3f, 0x73, 0x00, 0x1b, 0x55, 0x46, 0x17, 0xab, 0xce, 0xe3, 0x47,
+	0xad, 0x92, 0x12, 0x1f, 0x7b, 0x40, 0xfe, 0x75, 0xb8, 0xfa, 0x7b, 0x00, 0xa4, 0x0d, 0x08, 0x2f,
+	0xff, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -193,6 +517,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// ClawbackVestingAccounts retrieves all clawback vesting accounts, optionally
+	// filtered by funder
+	ClawbackVestingAccounts(ctx context.Context, in *QueryClawbackVestingAccountsRequest, opts ...grpc.CallOption) (*QueryClawbackVestingAccountsResponse, error)
+	// UnvestedSupply retrieves the total unvested and locked tokens held by all
+	// clawback vesting accounts at the current block time
+	UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -212,10 +542,34 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ClawbackVestingAccounts(ctx context.Context, in *QueryClawbackVestingAccountsRequest, opts ...grpc.CallOption) (*QueryClawbackVestingAccountsResponse, error) {
+	out := new(QueryClawbackVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/ClawbackVestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnvestedSupply(ctx context.Context, in *QueryUnvestedSupplyRequest, opts ...grpc.CallOption) (*QueryUnvestedSupplyResponse, error) {
+	out := new(QueryUnvestedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Query/UnvestedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// ClawbackVestingAccounts retrieves all clawback vesting accounts, optionally
+	// filtered by funder
+	ClawbackVestingAccounts(context.Context, *QueryClawbackVestingAccountsRequest) (*QueryClawbackVestingAccountsResponse, error)
+	// UnvestedSupply retrieves the total unvested and locked tokens held by all
+	// clawback vesting accounts at the current block time
+	UnvestedSupply(context.Context, *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -225,6 +579,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (*UnimplementedQueryServer) ClawbackVestingAccounts(ctx context.Context, req *QueryClawbackVestingAccountsRequest) (*QueryClawbackVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClawbackVestingAccounts not implemented")
+}
+func (*UnimplementedQueryServer) UnvestedSupply(ctx context.Context, req *QueryUnvestedSupplyRequest) (*QueryUnvestedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnvestedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -248,6 +608,43 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClawbackVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClawbackVestingAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClawbackVestingAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/ClawbackVestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClawbackVestingAccounts(ctx, req.(*QueryClawbackVestingAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnvestedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnvestedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnvestedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Query/UnvestedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnvestedSupply(ctx, req.(*QueryUnvestedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v2.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -256,6 +653,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "ClawbackVestingAccounts",
+			Handler:    _Query_ClawbackVestingAccounts_Handler,
+		},
+		{
+			MethodName: "UnvestedSupply",
+			Handler:    _Query_UnvestedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v2/query.proto",
@@ -349,71 +754,908 @@ func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackVestingAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackVestingAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackVestingAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClawbackVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClawbackVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClawbackVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingAccountBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingAccountBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingAccountBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vested) > 0 {
+		for iNdEx := len(m.Vested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnvestedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnvestedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnvestedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnvestedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnvestedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnvestedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonSpendable) > 0 {
+		for iNdEx := len(m.NonSpendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonSpendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClawbackVestingAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClawbackVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingAccountBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vested) > 0 {
+		for _, e := range m.Vested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnvestedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnvestedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NonSpendable) > 0 {
+		for _, e := range m.NonSpendable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClawbackVestingAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClawbackVestingAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClawbackVestingAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClawbackVestingAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClawbackVestingAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClawbackVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, VestingAccountBalances{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Locked) > 0 {
-		for _, e := range m.Locked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Unvested) > 0 {
-		for _, e := range m.Unvested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Vested) > 0 {
-		for _, e := range m.Vested {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *VestingAccountBalances) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -436,10 +1678,10 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingAccountBalances: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingAccountBalances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -474,6 +1716,140 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vested = append(m.Vested, types.Coin{})
+			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -495,7 +1871,7 @@ func (m *QueryBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnvestedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -518,10 +1894,60 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnvestedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnvestedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnvestedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnvestedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnvestedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -594,7 +2020,7 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonSpendable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -621,8 +2047,8 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vested = append(m.Vested, types.Coin{})
-			if err := m.Vested[len(m.Vested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NonSpendable = append(m.NonSpendable, types.Coin{})
+			if err := m.NonSpendable[len(m.NonSpendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
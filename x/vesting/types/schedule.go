@@ -4,7 +4,9 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
@@ -382,3 +384,135 @@ func AlignSchedules(
 
 	return startTime, endTime
 }
+
+// AcceleratePeriods moves the events of the selected future periods of a
+// schedule to the acceleration time. Events at or before the acceleration time
+// have already happened and are kept, and the remaining future events keep
+// their absolute time. A nil selector accelerates all future periods. The
+// acceleration time must not be before the start time of the schedule.
+//
+// It returns the new end time of the schedule, the resulting periods and the
+// sum of the accelerated amounts.
+func AcceleratePeriods(
+	startTime, accelerationTime int64,
+	periods sdkvesting.Periods,
+	selected func(idx int) bool,
+) (endTime int64, newPeriods sdkvesting.Periods, accelerated sdk.Coins) {
+	var (
+		// kept contains the periods that are not accelerated, with their lengths
+		// adjusted to keep the absolute time of their events
+		kept       sdkvesting.Periods
+		eventTime  = startTime
+		lastKeptAt = startTime
+	)
+
+	accelerated = sdk.NewCoins()
+
+	for i, period := range periods {
+		eventTime += period.Length
+		if eventTime > accelerationTime && (selected == nil || selected(i)) {
+			accelerated = accelerated.Add(period.Amount...)
+			continue
+		}
+
+		kept = append(kept, sdkvesting.Period{
+			Length: eventTime - lastKeptAt,
+			Amount: period.Amount,
+		})
+		lastKeptAt = eventTime
+	}
+
+	if accelerated.IsZero() {
+		return startTime + periods.TotalLength(), periods, accelerated
+	}
+
+	// merge the accelerated amount as a single event at the acceleration time
+	acceleratedPeriods := sdkvesting.Periods{{Length: 0, Amount: accelerated}}
+	_, endTime, newPeriods = DisjunctPeriods(startTime, accelerationTime, kept, acceleratedPeriods)
+
+	return endTime, newPeriods, accelerated
+}
+
+// DelayPeriods delays all the events of a schedule that happen after the read
+// time by the given extension. It returns false if the schedule has no future
+// events.
+func DelayPeriods(
+	startTime, readTime, extension int64,
+	periods sdkvesting.Periods,
+) (endTime int64, newPeriods sdkvesting.Periods, ok bool) {
+	newPeriods = make(sdkvesting.Periods, len(periods))
+	copy(newPeriods, periods)
+
+	// the first future event is delayed, which delays all subsequent events
+	// since period lengths are relative
+	passed := ReadPastPeriodCount(startTime, startTime+periods.TotalLength(), periods, readTime)
+	if passed == len(periods) {
+		return startTime + periods.TotalLength(), periods, false
+	}
+
+	newPeriods[passed].Length += extension
+	return startTime + newPeriods.TotalLength(), newPeriods, true
+}
+
+// NewCliffVestingPeriods returns a schedule that vests the given amount
+// linearly over the duration, with an event at the end of every period of the
+// given length. Nothing vests before the cliff, at which the amount that
+// accrued until then vests at once. All times are in seconds relative to the
+// start time.
+func NewCliffVestingPeriods(
+	amount sdk.Coins,
+	cliff, duration, periodLength int64,
+) (sdkvesting.Periods, error) {
+	switch {
+	case !amount.IsValid() || amount.IsZero():
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", amount)
+	case duration < 1:
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duration must be positive, got %d", duration)
+	case periodLength < 1:
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "period length must be positive, got %d", periodLength)
+	case cliff < 0 || cliff > duration:
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "cliff must be between 0 and the duration %d, got %d", duration, cliff)
+	}
+
+	// accrued returns the amount vested linearly until the given time
+	accrued := func(t int64) sdk.Coins {
+		if t >= duration {
+			return amount
+		}
+		coins := sdk.NewCoins()
+		for _, coin := range amount {
+			coins = coins.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(t).QuoRaw(duration)))
+		}
+		return coins
+	}
+
+	var (
+		periods  sdkvesting.Periods
+		prevTime int64
+		vested   = sdk.NewCoins()
+	)
+
+	eventTime := periodLength
+	if cliff > 0 {
+		eventTime = cliff
+	}
+
+	for prevTime < duration {
+		if eventTime > duration {
+			eventTime = duration
+		}
+
+		// events that would not vest anything are merged into the next one
+		total := accrued(eventTime)
+		if amt := total.Sub(vested...); !amt.IsZero() {
+			periods = append(periods, sdkvesting.Period{Length: eventTime - prevTime, Amount: amt})
+			vested = total
+			prevTime = eventTime
+		}
+
+		// continue with the first period boundary after the current event
+		eventTime = (eventTime/periodLength + 1) * periodLength
+	}
+
+	return periods, nil
+}
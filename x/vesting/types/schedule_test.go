@@ -521,3 +521,234 @@ func (suite *ScheduleTestSuite) TestAlignSchedules() {
 		})
 	}
 }
+
+func (suite *ScheduleTestSuite) TestAcceleratePeriods() {
+	// events at 110, 130 and 160
+	periods := sdkvesting.Periods{period(10, 50), period(20, 50), period(30, 50)}
+
+	testCases := []struct {
+		name             string
+		accelerationTime int64
+		selected         func(int) bool
+		expEndTime       int64
+		expPeriods       sdkvesting.Periods
+		expAccelerated   sdk.Coins
+	}{
+		{
+			"accelerate all future periods",
+			120,
+			nil,
+			120,
+			sdkvesting.Periods{period(10, 50), period(10, 100)},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		{
+			"accelerate all future periods at the time of an event",
+			130,
+			nil,
+			130,
+			sdkvesting.Periods{period(10, 50), period(20, 100)},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+		},
+		{
+			"accelerate a single future period",
+			120,
+			func(idx int) bool { return idx == 2 },
+			130,
+			sdkvesting.Periods{period(10, 50), period(10, 50), period(10, 50)},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+		},
+		{
+			"past periods are not accelerated",
+			120,
+			func(idx int) bool { return idx == 0 },
+			160,
+			periods,
+			sdk.NewCoins(),
+		},
+		{
+			"nothing to accelerate after the end time",
+			200,
+			nil,
+			160,
+			periods,
+			sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			endTime, newPeriods, accelerated := AcceleratePeriods(100, tc.accelerationTime, periods, tc.selected)
+			suite.Require().Equal(tc.expEndTime, endTime)
+			suite.Require().Equal(tc.expPeriods, newPeriods)
+			suite.Require().Equal(tc.expAccelerated, accelerated)
+			suite.Require().Equal(periods.TotalAmount(), newPeriods.TotalAmount())
+		})
+	}
+}
+
+func (suite *ScheduleTestSuite) TestDelayPeriods() {
+	// events at 110, 130 and 160
+	periods := sdkvesting.Periods{period(10, 50), period(20, 50), period(30, 50)}
+
+	testCases := []struct {
+		name       string
+		readTime   int64
+		expOk      bool
+		expEndTime int64
+		expPeriods sdkvesting.Periods
+	}{
+		{
+			"delay all periods before the start time",
+			50,
+			true,
+			175,
+			sdkvesting.Periods{period(25, 50), period(20, 50), period(30, 50)},
+		},
+		{
+			"delay future periods",
+			120,
+			true,
+			175,
+			sdkvesting.Periods{period(10, 50), period(35, 50), period(30, 50)},
+		},
+		{
+			"no future periods",
+			160,
+			false,
+			160,
+			periods,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			endTime, newPeriods, ok := DelayPeriods(100, tc.readTime, 15, periods)
+			suite.Require().Equal(tc.expOk, ok)
+			suite.Require().Equal(tc.expEndTime, endTime)
+			suite.Require().Equal(tc.expPeriods, newPeriods)
+			// the input schedule is not mutated
+			suite.Require().Equal(int64(10), periods[0].Length)
+		})
+	}
+}
+
+func (suite *ScheduleTestSuite) TestNewCliffVestingPeriods() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	testCases := []struct {
+		name         string
+		amount       sdk.Coins
+		cliff        int64
+		duration     int64
+		periodLength int64
+		expPeriods   sdkvesting.Periods
+		errContains  string
+	}{
+		{
+			"no cliff",
+			amount,
+			0,
+			400,
+			100,
+			sdkvesting.Periods{period(100, 250), period(100, 250), period(100, 250), period(100, 250)},
+			"",
+		},
+		{
+			"cliff on a period boundary",
+			amount,
+			200,
+			400,
+			100,
+			sdkvesting.Periods{period(200, 500), period(100, 250), period(100, 250)},
+			"",
+		},
+		{
+			"cliff between period boundaries",
+			amount,
+			150,
+			400,
+			100,
+			sdkvesting.Periods{period(150, 375), period(50, 125), period(100, 250), period(100, 250)},
+			"",
+		},
+		{
+			"duration is not a multiple of the period length",
+			amount,
+			0,
+			250,
+			100,
+			sdkvesting.Periods{period(100, 400), period(100, 400), period(50, 200)},
+			"",
+		},
+		{
+			"cliff at the end",
+			amount,
+			400,
+			400,
+			100,
+			sdkvesting.Periods{period(400, 1000)},
+			"",
+		},
+		{
+			"periods that vest nothing are merged",
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)),
+			0,
+			400,
+			100,
+			sdkvesting.Periods{period(200, 1), period(200, 1)},
+			"",
+		},
+		{
+			"empty amount",
+			sdk.NewCoins(),
+			0,
+			400,
+			100,
+			nil,
+			"invalid amount",
+		},
+		{
+			"zero duration",
+			amount,
+			0,
+			0,
+			100,
+			nil,
+			"duration must be positive",
+		},
+		{
+			"zero period length",
+			amount,
+			0,
+			400,
+			0,
+			nil,
+			"period length must be positive",
+		},
+		{
+			"cliff after the duration",
+			amount,
+			500,
+			400,
+			100,
+			nil,
+			"cliff must be between 0 and the duration",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			periods, err := NewCliffVestingPeriods(tc.amount, tc.cliff, tc.duration, tc.periodLength)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPeriods, periods)
+			suite.Require().Equal(tc.amount, periods.TotalAmount())
+			suite.Require().Equal(tc.duration, periods.TotalLength())
+		})
+	}
+}
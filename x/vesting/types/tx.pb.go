@@ -20,6 +20,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -337,6 +338,9 @@ func (m *MsgClawback) GetDestAddress() string {
 type MsgClawbackResponse struct {
 	// coins is the slice of clawed back coins
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// unbonding is the slice of clawed back coins that were delegated and are
+	// transferred to the destination once they are unbonded
+	Unbonding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unbonding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unbonding"`
 }
 
 func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
@@ -379,6 +383,13 @@ func (m *MsgClawbackResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coin
 	return nil
 }
 
+func (m *MsgClawbackResponse) GetUnbonding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unbonding
+	}
+	return nil
+}
+
 // MsgUpdateVestingFunder defines a message that updates the funder account of a
 // ClawbackVestingAccount.
 type MsgUpdateVestingFunder struct {
@@ -565,863 +576,3811 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccount")
-	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccountResponse")
-	proto.RegisterType((*MsgFundVestingAccount)(nil), "evmos.vesting.v2.MsgFundVestingAccount")
-	proto.RegisterType((*MsgFundVestingAccountResponse)(nil), "evmos.vesting.v2.MsgFundVestingAccountResponse")
-	proto.RegisterType((*MsgClawback)(nil), "evmos.vesting.v2.MsgClawback")
-	proto.RegisterType((*MsgClawbackResponse)(nil), "evmos.vesting.v2.MsgClawbackResponse")
-	proto.RegisterType((*MsgUpdateVestingFunder)(nil), "evmos.vesting.v2.MsgUpdateVestingFunder")
-	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingFunderResponse")
-	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v2.MsgConvertVestingAccount")
-	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v2.MsgConvertVestingAccountResponse")
+// MsgAccelerateVesting defines a message that vests all the unvested coins of a
+// ClawbackVestingAccount at the current block time.
+type MsgAccelerateVesting struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// unlock specifies whether the locked coins are unlocked as well
+	Unlock bool `protobuf:"varint,3,opt,name=unlock,proto3" json:"unlock,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
-
-var fileDescriptor_a372bb0b868e4c86 = []byte{
-	// 918 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4d, 0x6b, 0x24, 0x45,
-	0x18, 0x9e, 0xda, 0xd9, 0x2c, 0xbb, 0x15, 0x13, 0x37, 0x1d, 0x57, 0xb3, 0x6d, 0xd2, 0x33, 0x36,
-	0x86, 0x4c, 0xc6, 0xd8, 0xb5, 0xd3, 0x1b, 0x95, 0x0d, 0x5e, 0x76, 0x22, 0xd1, 0x4b, 0x40, 0x82,
-	0x7a, 0xf0, 0x32, 0xd4, 0x74, 0x57, 0x7a, 0x9b, 0x64, 0xba, 0x9a, 0xa9, 0xea, 0x4e, 0x04, 0x05,
-	0xd9, 0x93, 0x08, 0xe2, 0x82, 0x7f, 0x40, 0x0f, 0x82, 0x28, 0x42, 0x7e, 0xc6, 0x22, 0x22, 0x0b,
-	0x5e, 0x3c, 0xb9, 0x92, 0x28, 0xf1, 0x67, 0x48, 0x7d, 0x74, 0xcd, 0x3a, 0xa9, 0x7c, 0xec, 0x41,
-	0x2f, 0xf3, 0x51, 0xef, 0x53, 0xef, 0xfb, 0xd4, 0xf3, 0xbc, 0xf5, 0x16, 0xbc, 0x49, 0xca, 0x01,
-	0x65, 0xa8, 0x24, 0x8c, 0xa7, 0x59, 0x82, 0xca, 0x10, 0xf1, 0xfd, 0x20, 0x1f, 0x52, 0x4e, 0x9d,
-	0xeb, 0x32, 0x14, 0xe8, 0x50, 0x50, 0x86, 0xee, 0x0c, 0x1e, 0xa4, 0x19, 0x45, 0xf2, 0x53, 0x81,
-	0x5c, 0x2f, 0xa2, 0x4c, 0x24, 0xe8, 0x63, 0x46, 0x50, 0xd9, 0xe9, 0x13, 0x8e, 0x3b, 0x28, 0xa2,
-	0x69, 0xa6, 0xe3, 0x2f, 0xe8, 0xf8, 0x80, 0x25, 0xa8, 0xec, 0x88, 0x2f, 0x1d, 0x78, 0x59, 0x07,
-	0x4c, 0x65, 0xbd, 0xb7, 0x2a, 0xa7, 0x50, 0xcf, 0x25, 0x34, 0xa1, 0xf2, 0x27, 0x12, 0xbf, 0xf4,
-	0xea, 0x7c, 0x42, 0x69, 0xb2, 0x4b, 0x10, 0xce, 0x53, 0x84, 0xb3, 0x8c, 0x72, 0xcc, 0x53, 0x9a,
-	0x31, 0x1d, 0x6d, 0xe8, 0xa8, 0xfc, 0xd7, 0x2f, 0xb6, 0x11, 0x4f, 0x07, 0x84, 0x71, 0x3c, 0xc8,
-	0x15, 0xc0, 0xff, 0x0b, 0xc0, 0xc6, 0x26, 0x4b, 0xd6, 0x87, 0x04, 0x73, 0xb2, 0xbe, 0x8b, 0xf7,
-	0xfa, 0x38, 0xda, 0xf9, 0x40, 0xd5, 0xbd, 0x1b, 0x45, 0xb4, 0xc8, 0xb8, 0xb3, 0x08, 0xa7, 0xb7,
-	0x8b, 0x2c, 0x26, 0xc3, 0x1e, 0x8e, 0xe3, 0x21, 0x61, 0x6c, 0x0e, 0x34, 0x41, 0xeb, 0xda, 0xd6,
-	0x94, 0x5a, 0xbd, 0xab, 0x16, 0x9d, 0x25, 0xf8, 0xac, 0x26, 0x6c, 0x70, 0x97, 0x24, 0x6e, 0x5a,
-	0x2f, 0x57, 0xc0, 0x00, 0xce, 0x92, 0x0c, 0xf7, 0x77, 0x49, 0x2f, 0xa1, 0x65, 0x2f, 0xd2, 0x45,
-	0xe7, 0xea, 0x4d, 0xd0, 0xba, 0xba, 0x35, 0xa3, 0x42, 0x6f, 0xd3, 0xb2, 0x62, 0xb3, 0xd6, 0xfd,
-	0xfb, 0xeb, 0x46, 0xed, 0xfe, 0xf1, 0x41, 0x7b, 0x3c, 0xff, 0xe7, 0xc7, 0x07, 0xed, 0x45, 0x65,
-	0xda, 0x39, 0x67, 0xf0, 0x97, 0xe1, 0xd2, 0x39, 0x90, 0x2d, 0xc2, 0x72, 0x9a, 0x31, 0xe2, 0x7f,
-	0x76, 0x19, 0xde, 0xd8, 0x64, 0xc9, 0x46, 0x91, 0xc5, 0xff, 0xb1, 0x10, 0xef, 0x40, 0xc8, 0x38,
-	0x1e, 0xf2, 0x9e, 0x70, 0x45, 0x9e, 0x7f, 0x32, 0x74, 0x03, 0x65, 0x59, 0x50, 0x59, 0x16, 0xbc,
-	0x57, 0x59, 0xd6, 0x9d, 0x7a, 0xf8, 0x7b, 0xa3, 0xf6, 0xe0, 0x71, 0x03, 0x7c, 0x77, 0x7c, 0xd0,
-	0x06, 0x5b, 0xd7, 0xe4, 0x66, 0x11, 0x76, 0xbe, 0x00, 0x70, 0x7a, 0x97, 0x46, 0x3b, 0x45, 0xde,
-	0xcb, 0xc9, 0x30, 0xa5, 0x31, 0x9b, 0xbb, 0xdc, 0xac, 0xb7, 0x26, 0x43, 0x2f, 0x50, 0xbd, 0x35,
-	0x6a, 0x5d, 0xd5, 0x5b, 0xc1, 0xbb, 0x12, 0xd6, 0xdd, 0x10, 0x29, 0xbf, 0x7f, 0xdc, 0xb8, 0x93,
-	0xa4, 0xfc, 0x5e, 0xd1, 0x0f, 0x22, 0x3a, 0x40, 0xba, 0x1b, 0xd5, 0xd7, 0xab, 0x2c, 0xde, 0x41,
-	0xfb, 0x08, 0x17, 0xfc, 0x9e, 0xe9, 0x4f, 0xfe, 0x51, 0x4e, 0x98, 0xce, 0xc0, 0x14, 0x97, 0x29,
-	0x55, 0x5d, 0xaf, 0x39, 0x5f, 0x82, 0x91, 0x06, 0x15, 0xa1, 0x89, 0xff, 0x95, 0x50, 0xa5, 0xb5,
-	0x5e, 0x5c, 0xbb, 0x2d, 0x1a, 0x68, 0xcc, 0x3e, 0xd1, 0x3f, 0x2f, 0x9a, 0xfe, 0x39, 0x69, 0xb8,
-	0xdf, 0x80, 0x0b, 0xd6, 0x80, 0xe9, 0x95, 0x1f, 0x00, 0x9c, 0x14, 0x7d, 0xa5, 0x3b, 0xea, 0x29,
-	0x3a, 0x04, 0xab, 0x4c, 0xe3, 0x1d, 0xa2, 0x97, 0x2b, 0xe0, 0x4b, 0xf0, 0x99, 0x98, 0xb0, 0x11,
-	0xaa, 0x2e, 0x51, 0x93, 0x62, 0x4d, 0x43, 0xd6, 0x96, 0x4f, 0x39, 0xd8, 0xcc, 0xe8, 0x62, 0x68,
-	0x76, 0xfe, 0x27, 0x70, 0xf6, 0x89, 0xbf, 0xd5, 0x21, 0x9c, 0x6d, 0x38, 0x21, 0xa6, 0x94, 0xe0,
-	0x2a, 0x1c, 0xba, 0x59, 0x39, 0x24, 0xe6, 0x98, 0xb1, 0x67, 0x9d, 0xa6, 0x59, 0xf7, 0x35, 0x6d,
-	0x4e, 0xeb, 0x4c, 0x73, 0x94, 0x1b, 0x62, 0x83, 0xf6, 0x42, 0xa5, 0xf7, 0x7f, 0x06, 0xf0, 0xf9,
-	0x4d, 0x96, 0xbc, 0x9f, 0xc7, 0x98, 0x13, 0x2d, 0xe8, 0x86, 0xe4, 0x7d, 0x51, 0xdd, 0x56, 0xa0,
-	0x93, 0x91, 0xbd, 0xde, 0x18, 0x54, 0x49, 0x77, 0x3d, 0x23, 0x7b, 0x1b, 0xe7, 0xdd, 0xc3, 0xba,
-	0xed, 0x1e, 0xae, 0xad, 0x9e, 0x22, 0xe1, 0xbc, 0x91, 0xd0, 0xc2, 0xd9, 0x6f, 0x42, 0xcf, 0x1e,
-	0x31, 0xdd, 0xf1, 0x31, 0x9c, 0x13, 0x7a, 0xd3, 0xac, 0x24, 0x43, 0x3e, 0x36, 0x4b, 0x2c, 0xe4,
-	0x80, 0x95, 0xdc, 0x1b, 0xa7, 0x4d, 0x3e, 0x6f, 0x64, 0xb0, 0xad, 0x82, 0xef, 0xc3, 0xe6, 0x69,
-	0xb1, 0x8a, 0x61, 0xf8, 0xcb, 0x15, 0x58, 0xdf, 0x64, 0x89, 0xf3, 0x13, 0x80, 0xf3, 0x67, 0xbe,
-	0x01, 0x9d, 0x60, 0xfc, 0x05, 0x0c, 0xce, 0x99, 0xa7, 0xee, 0x9d, 0xa7, 0xde, 0x62, 0x84, 0x7b,
-	0xf3, 0xfe, 0xaf, 0x7f, 0x7e, 0x75, 0xe9, 0x75, 0x67, 0x15, 0x59, 0x9e, 0x64, 0x14, 0xc9, 0x14,
-	0xe6, 0xe1, 0xe8, 0x19, 0x6d, 0x34, 0xd7, 0x6f, 0x00, 0x74, 0x2c, 0xd3, 0x7b, 0xc9, 0xca, 0xe7,
-	0x24, 0xd0, 0x45, 0x17, 0x04, 0x1a, 0xba, 0x1d, 0x49, 0xf7, 0x15, 0x67, 0xd9, 0x4a, 0x57, 0xf4,
-	0xd5, 0x09, 0x8e, 0x7b, 0xf0, 0xaa, 0x19, 0x1a, 0x0b, 0x76, 0xa1, 0x74, 0xd8, 0x5d, 0x3c, 0x33,
-	0x6c, 0x48, 0x2c, 0x4a, 0x12, 0x0d, 0x67, 0xc1, 0xae, 0x59, 0x55, 0xec, 0x5b, 0x00, 0x67, 0x6d,
-	0x37, 0xb0, 0x65, 0xad, 0x62, 0x41, 0xba, 0xb7, 0x2e, 0x8a, 0x34, 0xd4, 0x42, 0x49, 0x6d, 0xc5,
-	0x69, 0x5b, 0xa9, 0x15, 0x72, 0xa7, 0x51, 0x48, 0x5d, 0x43, 0xe7, 0x47, 0x00, 0x6f, 0xd8, 0x6f,
-	0x4e, 0xdb, 0xae, 0x87, 0x0d, 0xeb, 0x86, 0x17, 0xc7, 0x1a, 0xb6, 0xab, 0x92, 0x6d, 0xe0, 0xac,
-	0xd8, 0x85, 0x54, 0x7b, 0xc7, 0x0d, 0x75, 0x27, 0x3e, 0x15, 0xa3, 0xae, 0xfb, 0xd6, 0xc3, 0x43,
-	0x0f, 0x3c, 0x3a, 0xf4, 0xc0, 0x1f, 0x87, 0x1e, 0x78, 0x70, 0xe4, 0xd5, 0x1e, 0x1d, 0x79, 0xb5,
-	0xdf, 0x8e, 0xbc, 0xda, 0x87, 0xed, 0x27, 0x66, 0xa6, 0x4a, 0xac, 0xd3, 0x87, 0xb7, 0xd0, 0xfe,
-	0xbf, 0x5f, 0xb2, 0xfe, 0x15, 0xf9, 0xf8, 0xdf, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x56, 0xd4,
-	0x08, 0x47, 0x92, 0x0a, 0x00, 0x00,
+func (m *MsgAccelerateVesting) Reset()         { *m = MsgAccelerateVesting{} }
+func (m *MsgAccelerateVesting) String() string { return proto.CompactTextString(m) }
+func (*MsgAccelerateVesting) ProtoMessage()    {}
+func (*MsgAccelerateVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
-	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
-	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
-	// according to the vesting and lockup schedules.
-	FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
-	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
-	// UpdateVestingFunder updates the funder address of an existing
-	// ClawbackVestingAccount.
-	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
-	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
-	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+func (m *MsgAccelerateVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAccelerateVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccelerateVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAccelerateVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccelerateVesting.Merge(m, src)
+}
+func (m *MsgAccelerateVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccelerateVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccelerateVesting.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
-	out := new(MsgCreateClawbackVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/CreateClawbackVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAccelerateVesting proto.InternalMessageInfo
+
+func (m *MsgAccelerateVesting) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error) {
-	out := new(MsgFundVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/FundVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAccelerateVesting) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
-	out := new(MsgClawbackResponse)
-	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/Clawback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAccelerateVesting) GetUnlock() bool {
+	if m != nil {
+		return m.Unlock
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error) {
-	out := new(MsgUpdateVestingFunderResponse)
-	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/UpdateVestingFunder", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgAccelerateVestingResponse defines the MsgAccelerateVesting response type.
+type MsgAccelerateVestingResponse struct {
+	// vested_coins are the coins vested by the acceleration
+	VestedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=vested_coins,json=vestedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested_coins"`
+	// unlocked_coins are the coins unlocked by the acceleration
+	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
+}
+
+func (m *MsgAccelerateVestingResponse) Reset()         { *m = MsgAccelerateVestingResponse{} }
+func (m *MsgAccelerateVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAccelerateVestingResponse) ProtoMessage()    {}
+func (*MsgAccelerateVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{11}
+}
+func (m *MsgAccelerateVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAccelerateVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccelerateVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAccelerateVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccelerateVestingResponse.Merge(m, src)
+}
+func (m *MsgAccelerateVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccelerateVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccelerateVestingResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error) {
-	out := new(MsgConvertVestingAccountResponse)
-	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/ConvertVestingAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAccelerateVestingResponse proto.InternalMessageInfo
+
+func (m *MsgAccelerateVestingResponse) GetVestedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VestedCoins
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
-	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
-	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
-	// according to the vesting and lockup schedules.
-	FundVestingAccount(context.Context, *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
-	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
-	// UpdateVestingFunder updates the funder address of an existing
-	// ClawbackVestingAccount.
-	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
-	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
-	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+func (m *MsgAccelerateVestingResponse) GetUnlockedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UnlockedCoins
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgAcceleratePeriod defines a message that vests a single future vesting
+// period of a ClawbackVestingAccount at the current block time.
+type MsgAcceleratePeriod struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// period_index is the index of the vesting period in the account's vesting
+	// schedule
+	PeriodIndex uint32 `protobuf:"varint,3,opt,name=period_index,json=periodIndex,proto3" json:"period_index,omitempty"`
 }
 
-func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+func (m *MsgAcceleratePeriod) Reset()         { *m = MsgAcceleratePeriod{} }
+func (m *MsgAcceleratePeriod) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceleratePeriod) ProtoMessage()    {}
+func (*MsgAcceleratePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{12}
 }
-func (*UnimplementedMsgServer) FundVestingAccount(ctx context.Context, req *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccount not implemented")
+func (m *MsgAcceleratePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+func (m *MsgAcceleratePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceleratePeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingFunder not implemented")
+func (m *MsgAcceleratePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceleratePeriod.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
+func (m *MsgAcceleratePeriod) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgAcceleratePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceleratePeriod.DiscardUnknown(m)
 }
 
-func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClawbackVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.vesting.v2.Msg/CreateClawbackVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgAcceleratePeriod proto.InternalMessageInfo
 
-func _Msg_FundVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.vesting.v2.Msg/FundVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundVestingAccount(ctx, req.(*MsgFundVestingAccount))
+func (m *MsgAcceleratePeriod) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClawback)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Clawback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.vesting.v2.Msg/Clawback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+func (m *MsgAcceleratePeriod) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateVestingFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateVestingFunder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateVestingFunder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.vesting.v2.Msg/UpdateVestingFunder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateVestingFunder(ctx, req.(*MsgUpdateVestingFunder))
+func (m *MsgAcceleratePeriod) GetPeriodIndex() uint32 {
+	if m != nil {
+		return m.PeriodIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertVestingAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertVestingAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.vesting.v2.Msg/ConvertVestingAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertVestingAccount(ctx, req.(*MsgConvertVestingAccount))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAcceleratePeriodResponse defines the MsgAcceleratePeriod response type.
+type MsgAcceleratePeriodResponse struct {
+	// vested_coins are the coins vested by the acceleration
+	VestedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=vested_coins,json=vestedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vested_coins"`
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.vesting.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateClawbackVestingAccount",
-			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
-		},
-		{
-			MethodName: "FundVestingAccount",
-			Handler:    _Msg_FundVestingAccount_Handler,
-		},
-		{
-			MethodName: "Clawback",
-			Handler:    _Msg_Clawback_Handler,
-		},
-		{
-			MethodName: "UpdateVestingFunder",
-			Handler:    _Msg_UpdateVestingFunder_Handler,
-		},
-		{
-			MethodName: "ConvertVestingAccount",
-			Handler:    _Msg_ConvertVestingAccount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/vesting/v2/tx.proto",
+func (m *MsgAcceleratePeriodResponse) Reset()         { *m = MsgAcceleratePeriodResponse{} }
+func (m *MsgAcceleratePeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceleratePeriodResponse) ProtoMessage()    {}
+func (*MsgAcceleratePeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{13}
+}
+func (m *MsgAcceleratePeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceleratePeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceleratePeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceleratePeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceleratePeriodResponse.Merge(m, src)
+}
+func (m *MsgAcceleratePeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceleratePeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceleratePeriodResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceleratePeriodResponse proto.InternalMessageInfo
+
+func (m *MsgAcceleratePeriodResponse) GetVestedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.VestedCoins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgExtendLockup defines a message that delays the future unlocking events of
+// a ClawbackVestingAccount. It must be signed by both the funder and the
+// vesting account.
+type MsgExtendLockup struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// extension is the duration by which the future unlocking events are delayed
+	Extension time.Duration `protobuf:"bytes,3,opt,name=extension,proto3,stdduration" json:"extension"`
 }
 
-func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EnableGovClawback {
-		i--
-		if m.EnableGovClawback {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgExtendLockup) Reset()         { *m = MsgExtendLockup{} }
+func (m *MsgExtendLockup) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendLockup) ProtoMessage()    {}
+func (*MsgExtendLockup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{14}
+}
+func (m *MsgExtendLockup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendLockup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendLockup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgExtendLockup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendLockup.Merge(m, src)
+}
+func (m *MsgExtendLockup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendLockup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendLockup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendLockup proto.InternalMessageInfo
+
+func (m *MsgExtendLockup) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExtendLockup) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgExtendLockup) GetExtension() time.Duration {
+	if m != nil {
+		return m.Extension
+	}
+	return 0
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgExtendLockupResponse defines the MsgExtendLockup response type.
+type MsgExtendLockupResponse struct {
 }
 
-func (m *MsgFundVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgExtendLockupResponse) Reset()         { *m = MsgExtendLockupResponse{} }
+func (m *MsgExtendLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendLockupResponse) ProtoMessage()    {}
+func (*MsgExtendLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{15}
+}
+func (m *MsgExtendLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgExtendLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendLockupResponse.Merge(m, src)
+}
+func (m *MsgExtendLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendLockupResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFundVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgExtendLockupResponse proto.InternalMessageInfo
+
+// MsgSetUnvestedStaking defines a message that allows or disallows a
+// ClawbackVestingAccount to delegate its unvested coins.
+type MsgSetUnvestedStaking struct {
+	// funder_address is the address which funded the account
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// enabled specifies whether the account can delegate unvested coins
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgFundVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingPeriods) > 0 {
-		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.LockupPeriods) > 0 {
-		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgSetUnvestedStaking) Reset()         { *m = MsgSetUnvestedStaking{} }
+func (m *MsgSetUnvestedStaking) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnvestedStaking) ProtoMessage()    {}
+func (*MsgSetUnvestedStaking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{16}
+}
+func (m *MsgSetUnvestedStaking) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnvestedStaking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnvestedStaking.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetUnvestedStaking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnvestedStaking.Merge(m, src)
+}
+func (m *MsgSetUnvestedStaking) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnvestedStaking) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnvestedStaking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnvestedStaking proto.InternalMessageInfo
+
+func (m *MsgSetUnvestedStaking) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFundVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetUnvestedStaking) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFundVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetUnvestedStaking) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MsgFundVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetUnvestedStakingResponse defines the MsgSetUnvestedStaking response
+// type.
+type MsgSetUnvestedStakingResponse struct {
 }
 
-func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetUnvestedStakingResponse) Reset()         { *m = MsgSetUnvestedStakingResponse{} }
+func (m *MsgSetUnvestedStakingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnvestedStakingResponse) ProtoMessage()    {}
+func (*MsgSetUnvestedStakingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{17}
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnvestedStakingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnvestedStakingResponse.Merge(m, src)
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnvestedStakingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnvestedStakingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetUnvestedStakingResponse proto.InternalMessageInfo
+
+// VestingTemplate defines the lockup and vesting schedules that are shared by
+// the grants of a MsgBatchFundVestingAccounts.
+type VestingTemplate struct {
+	// start_time defines the time at which the vesting period begins
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the unlocking schedule relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,2,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
 }
 
-func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DestAddress) > 0 {
-		i -= len(m.DestAddress)
-		copy(dAtA[i:], m.DestAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (m *VestingTemplate) Reset()         { *m = VestingTemplate{} }
+func (m *VestingTemplate) String() string { return proto.CompactTextString(m) }
+func (*VestingTemplate) ProtoMessage()    {}
+func (*VestingTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{18}
+}
+func (m *VestingTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *VestingTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingTemplate.Merge(m, src)
+}
+func (m *VestingTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingTemplate proto.InternalMessageInfo
+
+func (m *VestingTemplate) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *VestingTemplate) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VestingTemplate) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchGrant defines a single vesting account funded by a
+// MsgBatchFundVestingAccounts.
+type BatchGrant struct {
+	// vesting_address specifies the account that receives the funds
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// template_index is the index of the template that defines the schedules
+	// of the grant
+	TemplateIndex uint32 `protobuf:"varint,2,opt,name=template_index,json=templateIndex,proto3" json:"template_index,omitempty"`
 }
 
-func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *BatchGrant) Reset()         { *m = BatchGrant{} }
+func (m *BatchGrant) String() string { return proto.CompactTextString(m) }
+func (*BatchGrant) ProtoMessage()    {}
+func (*BatchGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{19}
+}
+func (m *BatchGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateVestingFunder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BatchGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGrant.Merge(m, src)
 }
-
-func (m *MsgUpdateVestingFunder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGrant.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewFunderAddress) > 0 {
-		i -= len(m.NewFunderAddress)
-		copy(dAtA[i:], m.NewFunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFunderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_BatchGrant proto.InternalMessageInfo
+
+func (m *BatchGrant) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateVestingFunderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchGrant) GetTemplateIndex() uint32 {
+	if m != nil {
+		return m.TemplateIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateVestingFunderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgBatchFundVestingAccounts defines a message that enables funding multiple
+// existing clawback vesting accounts at once.
+type MsgBatchFundVestingAccounts struct {
+	// funder_address specifies the account that funds the vesting accounts
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// templates defines the schedules referenced by the grants
+	Templates []VestingTemplate `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates"`
+	// grants defines the vesting accounts to fund
+	Grants []BatchGrant `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *MsgUpdateVestingFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgBatchFundVestingAccounts) Reset()         { *m = MsgBatchFundVestingAccounts{} }
+func (m *MsgBatchFundVestingAccounts) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchFundVestingAccounts) ProtoMessage()    {}
+func (*MsgBatchFundVestingAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{20}
 }
-
-func (m *MsgConvertVestingAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchFundVestingAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchFundVestingAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchFundVestingAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgConvertVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchFundVestingAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchFundVestingAccounts.Merge(m, src)
+}
+func (m *MsgBatchFundVestingAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchFundVestingAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchFundVestingAccounts.DiscardUnknown(m)
 }
 
-func (m *MsgConvertVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VestingAddress) > 0 {
-		i -= len(m.VestingAddress)
-		copy(dAtA[i:], m.VestingAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgBatchFundVestingAccounts proto.InternalMessageInfo
+
+func (m *MsgBatchFundVestingAccounts) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgConvertVestingAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchFundVestingAccounts) GetTemplates() []VestingTemplate {
+	if m != nil {
+		return m.Templates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgConvertVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchFundVestingAccounts) GetGrants() []BatchGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
 }
 
-func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgBatchFundVestingAccountsResponse defines the MsgBatchFundVestingAccounts
+// response type.
+type MsgBatchFundVestingAccountsResponse struct {
+	// total is the total amount of coins transferred to the vesting accounts
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgBatchFundVestingAccountsResponse) Reset()         { *m = MsgBatchFundVestingAccountsResponse{} }
+func (m *MsgBatchFundVestingAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchFundVestingAccountsResponse) ProtoMessage()    {}
+func (*MsgBatchFundVestingAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a372bb0b868e4c86, []int{21}
 }
-func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchFundVestingAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchFundVestingAccountsResponse.Merge(m, src)
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchFundVestingAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchFundVestingAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchFundVestingAccountsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchFundVestingAccountsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccount")
+	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v2.MsgCreateClawbackVestingAccountResponse")
+	proto.RegisterType((*MsgFundVestingAccount)(nil), "evmos.vesting.v2.MsgFundVestingAccount")
+	proto.RegisterType((*MsgFundVestingAccountResponse)(nil), "evmos.vesting.v2.MsgFundVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "evmos.vesting.v2.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "evmos.vesting.v2.MsgClawbackResponse")
+	proto.RegisterType((*MsgUpdateVestingFunder)(nil), "evmos.vesting.v2.MsgUpdateVestingFunder")
+	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v2.MsgUpdateVestingFunderResponse")
+	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v2.MsgConvertVestingAccount")
+	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v2.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgAccelerateVesting)(nil), "evmos.vesting.v2.MsgAccelerateVesting")
+	proto.RegisterType((*MsgAccelerateVestingResponse)(nil), "evmos.vesting.v2.MsgAccelerateVestingResponse")
+	proto.RegisterType((*MsgAcceleratePeriod)(nil), "evmos.vesting.v2.MsgAcceleratePeriod")
+	proto.RegisterType((*MsgAcceleratePeriodResponse)(nil), "evmos.vesting.v2.MsgAcceleratePeriodResponse")
+	proto.RegisterType((*MsgExtendLockup)(nil), "evmos.vesting.v2.MsgExtendLockup")
+	proto.RegisterType((*MsgExtendLockupResponse)(nil), "evmos.vesting.v2.MsgExtendLockupResponse")
+	proto.RegisterType((*MsgSetUnvestedStaking)(nil), "evmos.vesting.v2.MsgSetUnvestedStaking")
+	proto.RegisterType((*MsgSetUnvestedStakingResponse)(nil), "evmos.vesting.v2.MsgSetUnvestedStakingResponse")
+	proto.RegisterType((*VestingTemplate)(nil), "evmos.vesting.v2.VestingTemplate")
+	proto.RegisterType((*BatchGrant)(nil), "evmos.vesting.v2.BatchGrant")
+	proto.RegisterType((*MsgBatchFundVestingAccounts)(nil), "evmos.vesting.v2.MsgBatchFundVestingAccounts")
+	proto.RegisterType((*MsgBatchFundVestingAccountsResponse)(nil), "evmos.vesting.v2.MsgBatchFundVestingAccountsResponse")
+}
+
+func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
+
+var fileDescriptor_a372bb0b868e4c86 = []byte{
+	// 1465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x38, 0x6d, 0xbf, 0xcd, 0xcb, 0x8f, 0x36, 0xdb, 0x5f, 0xce, 0x36, 0xb5, 0x93, 0xfd,
+	0x36, 0x4d, 0x62, 0xda, 0xdd, 0xc6, 0x6d, 0x81, 0x5a, 0x48, 0xa8, 0x6e, 0x49, 0x01, 0x11, 0x09,
+	0xa5, 0x2d, 0x07, 0x84, 0xb4, 0x5a, 0x7b, 0xa7, 0x5b, 0x2b, 0xf6, 0xae, 0xe5, 0x19, 0x3b, 0x41,
+	0xe2, 0x80, 0x7a, 0xaa, 0x10, 0x15, 0x15, 0x5c, 0x7a, 0xe0, 0x00, 0x07, 0x24, 0x04, 0x42, 0xaa,
+	0x38, 0x70, 0x80, 0x7f, 0xa0, 0x42, 0x1c, 0x2a, 0x71, 0xe1, 0x44, 0x51, 0x0b, 0x0a, 0x07, 0xb8,
+	0x71, 0x45, 0x42, 0xf3, 0x63, 0xc7, 0xa9, 0x77, 0x1c, 0x3b, 0x52, 0x42, 0xb9, 0x34, 0xde, 0xf7,
+	0x3e, 0x33, 0xef, 0x33, 0xef, 0xf3, 0xf6, 0xcd, 0xdb, 0xc2, 0x04, 0x6e, 0xd5, 0x22, 0xe2, 0xb4,
+	0x30, 0xa1, 0x95, 0x30, 0x70, 0x5a, 0x79, 0x87, 0xae, 0xd9, 0xf5, 0x46, 0x44, 0x23, 0x63, 0x3f,
+	0x77, 0xd9, 0xd2, 0x65, 0xb7, 0xf2, 0xe6, 0xb8, 0x57, 0xab, 0x84, 0x91, 0xc3, 0xff, 0x15, 0x20,
+	0x33, 0x53, 0x8e, 0x08, 0xdb, 0xa0, 0xe4, 0x11, 0xec, 0xb4, 0x16, 0x4a, 0x98, 0x7a, 0x0b, 0x4e,
+	0x39, 0xaa, 0x84, 0xd2, 0x7f, 0x44, 0xfa, 0x6b, 0x24, 0x70, 0x5a, 0x0b, 0xec, 0x8f, 0x74, 0x1c,
+	0x97, 0x0e, 0x15, 0x59, 0xae, 0x8d, 0xc3, 0x09, 0xd4, 0xc1, 0x20, 0x0a, 0x22, 0xfe, 0xd3, 0x61,
+	0xbf, 0xa4, 0x75, 0x32, 0x88, 0xa2, 0xa0, 0x8a, 0x1d, 0xaf, 0x5e, 0x71, 0xbc, 0x30, 0x8c, 0xa8,
+	0x47, 0x2b, 0x51, 0x48, 0x62, 0x4a, 0xd2, 0xcb, 0x9f, 0x4a, 0xcd, 0xeb, 0x8e, 0xdf, 0x6c, 0x70,
+	0x80, 0xf4, 0x67, 0x3b, 0xfd, 0xb4, 0x52, 0xc3, 0x84, 0x7a, 0xb5, 0xba, 0x00, 0x58, 0xbf, 0x21,
+	0xc8, 0x2e, 0x91, 0xe0, 0x62, 0x03, 0x7b, 0x14, 0x5f, 0xac, 0x7a, 0xab, 0x25, 0xaf, 0xbc, 0xf2,
+	0x86, 0xe0, 0x75, 0xa1, 0x5c, 0x8e, 0x9a, 0x21, 0x35, 0x66, 0x60, 0xec, 0x7a, 0x33, 0xf4, 0x71,
+	0xc3, 0xf5, 0x7c, 0xbf, 0x81, 0x09, 0x49, 0xa3, 0x29, 0x34, 0x37, 0xb4, 0x3c, 0x2a, 0xac, 0x17,
+	0x84, 0xd1, 0x98, 0x85, 0x7d, 0xf2, 0x40, 0x0a, 0x97, 0xe2, 0xb8, 0x31, 0x69, 0x8e, 0x81, 0x36,
+	0x1c, 0xc0, 0xa1, 0x57, 0xaa, 0x62, 0x37, 0x88, 0x5a, 0x6e, 0x59, 0x06, 0x4d, 0x0f, 0x4e, 0xa1,
+	0xb9, 0xbd, 0xcb, 0xe3, 0xc2, 0x75, 0x39, 0x6a, 0xc5, 0x6c, 0x0a, 0xc5, 0xdf, 0x3f, 0xc9, 0x0e,
+	0xdc, 0x5c, 0xbf, 0x97, 0xeb, 0xdc, 0xff, 0xbd, 0xf5, 0x7b, 0xb9, 0x19, 0x21, 0x6a, 0x8f, 0x33,
+	0x58, 0xf3, 0x30, 0xdb, 0x03, 0xb2, 0x8c, 0x49, 0x3d, 0x0a, 0x09, 0xb6, 0x6e, 0xed, 0x82, 0x43,
+	0x4b, 0x24, 0x58, 0x6c, 0x86, 0xfe, 0x0e, 0x27, 0xe2, 0x65, 0x00, 0x42, 0xbd, 0x06, 0x75, 0x99,
+	0x2a, 0xfc, 0xfc, 0xc3, 0x79, 0xd3, 0x16, 0x92, 0xd9, 0xb1, 0x64, 0xf6, 0xd5, 0x58, 0xb2, 0xe2,
+	0xe8, 0xfd, 0x9f, 0xb3, 0x03, 0x77, 0x1e, 0x66, 0xd1, 0xe7, 0xeb, 0xf7, 0x72, 0x68, 0x79, 0x88,
+	0x2f, 0x66, 0x6e, 0xe3, 0x36, 0x82, 0xb1, 0x6a, 0x54, 0x5e, 0x69, 0xd6, 0xdd, 0x3a, 0x6e, 0x54,
+	0x22, 0x9f, 0xa4, 0x77, 0x4d, 0x0d, 0xce, 0x0d, 0xe7, 0x33, 0xb6, 0xa8, 0xbd, 0x76, 0x69, 0x8b,
+	0xda, 0xb3, 0x5f, 0xe7, 0xb0, 0xe2, 0x22, 0xdb, 0xf2, 0x8b, 0x87, 0xd9, 0xf3, 0x41, 0x85, 0xde,
+	0x68, 0x96, 0xec, 0x72, 0x54, 0x73, 0x64, 0xb5, 0x8a, 0x3f, 0xa7, 0x88, 0xbf, 0xe2, 0xac, 0x39,
+	0x5e, 0x93, 0xde, 0x50, 0xf5, 0x4b, 0xdf, 0xae, 0x63, 0x22, 0x77, 0x20, 0x82, 0xcb, 0xa8, 0x88,
+	0x2e, 0x6d, 0xc6, 0x07, 0xa8, 0x9d, 0x83, 0x98, 0xd0, 0xee, 0x7f, 0x95, 0x50, 0x9c, 0x6b, 0x69,
+	0x2c, 0x9c, 0x61, 0x05, 0xd4, 0x21, 0x1f, 0xab, 0x9f, 0xa3, 0xaa, 0x7e, 0x92, 0x82, 0x5b, 0x59,
+	0x38, 0xa6, 0x75, 0xa8, 0x5a, 0xf9, 0x12, 0xc1, 0x30, 0xab, 0x2b, 0x59, 0x51, 0x5b, 0xa8, 0x10,
+	0x4f, 0xec, 0xd4, 0x59, 0x21, 0xd2, 0x1c, 0x03, 0xa7, 0x61, 0xc4, 0xc7, 0xa4, 0x8d, 0x1a, 0xe4,
+	0xa8, 0x61, 0x66, 0x93, 0x90, 0xc2, 0x7c, 0x97, 0x83, 0x8d, 0xb7, 0x5f, 0x0c, 0xc9, 0xce, 0xfa,
+	0x13, 0xc1, 0x81, 0x0d, 0xcf, 0xf1, 0x29, 0x8c, 0xeb, 0xb0, 0x9b, 0xb5, 0x31, 0x46, 0x96, 0x49,
+	0x34, 0x11, 0x4b, 0xc4, 0x1a, 0x9d, 0xd2, 0xe7, 0x62, 0x54, 0x09, 0x8b, 0xe7, 0xa4, 0x3a, 0x73,
+	0x9b, 0xaa, 0x23, 0xe4, 0x60, 0x0b, 0xa4, 0x18, 0x62, 0x7b, 0x23, 0x84, 0xa1, 0x66, 0x58, 0x8a,
+	0x42, 0xbf, 0x12, 0x06, 0xe9, 0xd4, 0x0e, 0xc5, 0x6a, 0x87, 0xb0, 0x7e, 0x40, 0x70, 0x78, 0x89,
+	0x04, 0xd7, 0xea, 0xbe, 0x47, 0xb1, 0x54, 0x70, 0x91, 0x27, 0xaa, 0x5f, 0xa1, 0x4e, 0x82, 0x11,
+	0xe2, 0x55, 0xb7, 0x03, 0x2a, 0xb4, 0xda, 0x1f, 0xe2, 0xd5, 0xc5, 0x5e, 0x2f, 0xfe, 0xa0, 0xee,
+	0xc5, 0x2f, 0x9c, 0xed, 0xa2, 0xd9, 0xa4, 0xd2, 0x4c, 0xc3, 0xd9, 0x9a, 0x82, 0x8c, 0xde, 0xa3,
+	0xca, 0xf1, 0x1d, 0x48, 0x33, 0x7d, 0xa3, 0xb0, 0x85, 0x1b, 0xb4, 0xa3, 0x79, 0x69, 0xc8, 0x21,
+	0x2d, 0xb9, 0xe7, 0xba, 0xb5, 0xda, 0x4c, 0xbb, 0xa2, 0x74, 0x11, 0x2c, 0x0b, 0xa6, 0xba, 0xf9,
+	0x14, 0xc3, 0xaf, 0x11, 0x1c, 0x5c, 0x22, 0xcc, 0x8c, 0xab, 0xb8, 0xd1, 0x3e, 0xc8, 0xb6, 0xf7,
+	0xd6, 0xc3, 0xb0, 0xa7, 0x19, 0xb2, 0xa6, 0x24, 0xef, 0x15, 0xf9, 0x54, 0xc8, 0x77, 0x49, 0xbd,
+	0xa9, 0x0e, 0x97, 0xe0, 0x66, 0xbd, 0x9f, 0x82, 0x49, 0x9d, 0x43, 0xbd, 0x40, 0x04, 0x46, 0x58,
+	0x78, 0xec, 0xbb, 0x3b, 0xfb, 0x1e, 0x0d, 0x8b, 0x28, 0xdc, 0x62, 0xac, 0xc2, 0x98, 0x38, 0x93,
+	0x0a, 0xbb, 0x53, 0xaf, 0xd4, 0x68, 0x1c, 0x87, 0xdb, 0xac, 0xef, 0x44, 0x1b, 0x69, 0xa7, 0x43,
+	0xf4, 0xd8, 0x6d, 0x97, 0x70, 0x1a, 0x46, 0xc4, 0xdd, 0xe1, 0x56, 0x42, 0x1f, 0xaf, 0x71, 0x21,
+	0x47, 0x97, 0x87, 0x85, 0xed, 0x15, 0x66, 0x2a, 0x2c, 0x74, 0x51, 0x73, 0x42, 0xa3, 0xa6, 0x60,
+	0x69, 0x7d, 0x88, 0xe0, 0xa8, 0xc6, 0xfe, 0x54, 0xb5, 0xb4, 0xfe, 0x40, 0xb0, 0x6f, 0x89, 0x04,
+	0x2f, 0xad, 0x51, 0x1c, 0xfa, 0xaf, 0xf1, 0xab, 0x74, 0xdb, 0xd3, 0xb9, 0x08, 0x43, 0x98, 0xed,
+	0x4f, 0x2a, 0x51, 0x28, 0x87, 0x8d, 0x89, 0xc4, 0xb0, 0x71, 0x49, 0xce, 0x8f, 0x62, 0xd6, 0xb8,
+	0xdb, 0x9e, 0x35, 0xd4, 0xd2, 0xc2, 0x05, 0x4d, 0xce, 0xbb, 0xb5, 0x8c, 0x43, 0x4a, 0x87, 0x8d,
+	0x47, 0xb3, 0x26, 0xe0, 0x48, 0x87, 0x49, 0x35, 0x88, 0x6f, 0x10, 0x9f, 0xbe, 0xae, 0x60, 0x7a,
+	0x2d, 0x14, 0x19, 0xba, 0x42, 0xbd, 0x95, 0x9d, 0xe8, 0x10, 0x69, 0xf8, 0x9f, 0x98, 0x35, 0x7d,
+	0xd9, 0x22, 0xe2, 0xc7, 0x3e, 0x66, 0x85, 0x24, 0x3d, 0x39, 0x2b, 0x24, 0x1d, 0xea, 0x64, 0x7f,
+	0xa7, 0x60, 0x9f, 0x6c, 0x1c, 0x57, 0x71, 0xad, 0x5e, 0xf5, 0x28, 0xee, 0x98, 0x00, 0xd1, 0xf6,
+	0x4e, 0x80, 0xa9, 0xff, 0xda, 0x04, 0x38, 0xf8, 0x34, 0x27, 0x40, 0xeb, 0x2d, 0x80, 0xa2, 0x47,
+	0xcb, 0x37, 0x2e, 0x37, 0xbc, 0x2d, 0x5c, 0x87, 0xac, 0xec, 0xa8, 0x94, 0x4b, 0xf6, 0xa1, 0x14,
+	0xef, 0x43, 0xa3, 0xb1, 0x95, 0x77, 0x22, 0x76, 0x47, 0xb0, 0xb6, 0xc2, 0x23, 0x24, 0x07, 0x46,
+	0xd2, 0x6f, 0xf5, 0xbe, 0x0a, 0x43, 0xf1, 0xbe, 0xb1, 0x80, 0xd3, 0x76, 0xe7, 0xc7, 0xa9, 0xdd,
+	0x51, 0x46, 0xc5, 0x21, 0x96, 0x32, 0x59, 0x12, 0x6a, 0xb9, 0xf1, 0x22, 0xec, 0x09, 0xd8, 0x59,
+	0xe3, 0xc4, 0x4f, 0x26, 0x37, 0x6a, 0x27, 0x64, 0xe3, 0x1e, 0x72, 0x59, 0xe1, 0x7c, 0x97, 0xf7,
+	0x60, 0x5a, 0xbd, 0x07, 0xdd, 0x8e, 0x6b, 0xdd, 0x46, 0xf0, 0xff, 0x4d, 0xfc, 0x1b, 0x47, 0x4f,
+	0x1a, 0x51, 0xaf, 0xba, 0x73, 0xa3, 0x27, 0xdf, 0x3e, 0xff, 0xd7, 0x08, 0x0c, 0x2e, 0x91, 0xc0,
+	0xf8, 0x1e, 0xc1, 0xe4, 0xa6, 0x1f, 0xbb, 0x0b, 0xc9, 0x24, 0xf5, 0xf8, 0x70, 0x34, 0xcf, 0x6f,
+	0x79, 0x89, 0xea, 0x09, 0x2f, 0xdc, 0xfc, 0xf1, 0xd7, 0x8f, 0x52, 0xcf, 0x1a, 0x67, 0x1d, 0xcd,
+	0xff, 0x4d, 0x38, 0x65, 0xbe, 0x85, 0xfa, 0x42, 0x76, 0x55, 0xc1, 0x4a, 0xae, 0x9f, 0x22, 0x30,
+	0x34, 0x9f, 0xa9, 0xb3, 0x5a, 0x3e, 0x49, 0xa0, 0xe9, 0xf4, 0x09, 0x54, 0x74, 0x17, 0x38, 0xdd,
+	0x67, 0x8c, 0x79, 0x2d, 0x5d, 0x56, 0x28, 0x09, 0x8e, 0xab, 0xb0, 0x57, 0x7d, 0x1d, 0x1d, 0xd3,
+	0x27, 0x4a, 0xba, 0xcd, 0x99, 0x4d, 0xdd, 0x8a, 0xc4, 0x0c, 0x27, 0x91, 0x35, 0x8e, 0xe9, 0x73,
+	0x16, 0x07, 0xfb, 0x0c, 0xc1, 0x01, 0xdd, 0xe4, 0x3f, 0xa7, 0x8d, 0xa2, 0x41, 0x9a, 0xa7, 0xfb,
+	0x45, 0x2a, 0x6a, 0x79, 0x4e, 0xed, 0xa4, 0x91, 0xd3, 0x52, 0x6b, 0xf2, 0x95, 0x2a, 0x43, 0xe2,
+	0xbd, 0x32, 0xbe, 0x42, 0x70, 0x48, 0x3f, 0xb1, 0xe7, 0xf4, 0xf9, 0xd0, 0x61, 0xcd, 0x7c, 0xff,
+	0x58, 0xc5, 0xf6, 0x2c, 0x67, 0x6b, 0x1b, 0x27, 0xf5, 0x89, 0x14, 0x6b, 0x13, 0x82, 0x7e, 0x8c,
+	0x60, 0x3c, 0x39, 0xbe, 0x9f, 0xd0, 0xc6, 0x4f, 0xe0, 0x4c, 0xbb, 0x3f, 0x9c, 0xe2, 0xe8, 0x70,
+	0x8e, 0xf3, 0xc6, 0xac, 0x96, 0xa3, 0xa7, 0xd6, 0xc5, 0x34, 0x8d, 0xbb, 0x08, 0xf6, 0x27, 0x27,
+	0xd3, 0x1e, 0x51, 0x05, 0xcc, 0x3c, 0xd5, 0x17, 0x4c, 0x71, 0xb3, 0x39, 0xb7, 0x39, 0xe3, 0x44,
+	0x2f, 0x6e, 0xe2, 0xfe, 0x33, 0x6e, 0x21, 0x18, 0x79, 0x62, 0xc2, 0x9b, 0xd6, 0xc6, 0xdb, 0x08,
+	0x31, 0xe7, 0x7b, 0x42, 0x14, 0x9d, 0x1c, 0xa7, 0x73, 0xdc, 0xb0, 0xb4, 0x74, 0xf8, 0xfc, 0xe6,
+	0xbb, 0xe2, 0x92, 0xe6, 0x9d, 0x43, 0x33, 0x62, 0xe9, 0x3b, 0x47, 0x12, 0x68, 0x3a, 0x7d, 0x02,
+	0xfb, 0xec, 0x1c, 0x04, 0x53, 0xb7, 0x29, 0x57, 0xba, 0x44, 0x92, 0xf9, 0x16, 0x41, 0xba, 0xeb,
+	0x75, 0xaa, 0x97, 0xaa, 0x1b, 0xdc, 0x3c, 0xb7, 0x25, 0xb8, 0x62, 0xfd, 0x3c, 0x67, 0x9d, 0x37,
+	0x4e, 0x6b, 0x59, 0x97, 0xd8, 0x72, 0x57, 0xd7, 0xf5, 0x88, 0xb9, 0xfb, 0x5d, 0x76, 0xfb, 0x14,
+	0x2f, 0xdd, 0x7f, 0x94, 0x41, 0x0f, 0x1e, 0x65, 0xd0, 0x2f, 0x8f, 0x32, 0xe8, 0xce, 0xe3, 0xcc,
+	0xc0, 0x83, 0xc7, 0x99, 0x81, 0x9f, 0x1e, 0x67, 0x06, 0xde, 0xcc, 0x6d, 0xb8, 0xc6, 0xc4, 0xe6,
+	0x32, 0x44, 0xfe, 0xb4, 0xb3, 0xf6, 0xe4, 0x58, 0x53, 0xda, 0xc3, 0x27, 0xc1, 0x33, 0xff, 0x0c,
+	0x00, 0x5f, 0x86, 0x77, 0xc9, 0xc1, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
+	// according to the vesting and lockup schedules.
+	FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error)
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+	// UpdateVestingFunder updates the funder address of an existing
+	// ClawbackVestingAccount.
+	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
+	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
+	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// AccelerateVesting vests all the unvested coins of a ClawbackVestingAccount
+	// and optionally unlocks all its locked coins.
+	AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error)
+	// AcceleratePeriod vests a single future vesting period of a
+	// ClawbackVestingAccount.
+	AcceleratePeriod(ctx context.Context, in *MsgAcceleratePeriod, opts ...grpc.CallOption) (*MsgAcceleratePeriodResponse, error)
+	// ExtendLockup delays the future unlocking events of a
+	// ClawbackVestingAccount with the consent of the vesting account.
+	ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error)
+	// SetUnvestedStaking allows or disallows a ClawbackVestingAccount to
+	// delegate its unvested coins.
+	SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error)
+	// BatchFundVestingAccounts funds multiple existing ClawbackVestingAccounts
+	// in a single transaction using shared lockup and vesting schedules.
+	BatchFundVestingAccounts(ctx context.Context, in *MsgBatchFundVestingAccounts, opts ...grpc.CallOption) (*MsgBatchFundVestingAccountsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/CreateClawbackVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EnableGovClawback {
-		n += 2
+	return out, nil
+}
+
+func (c *msgClient) FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error) {
+	out := new(MsgFundVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/FundVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgFundVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error) {
+	out := new(MsgUpdateVestingFunderResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/UpdateVestingFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error) {
+	out := new(MsgConvertVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/ConvertVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AccelerateVesting(ctx context.Context, in *MsgAccelerateVesting, opts ...grpc.CallOption) (*MsgAccelerateVestingResponse, error) {
+	out := new(MsgAccelerateVestingResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/AccelerateVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.LockupPeriods) > 0 {
-		for _, e := range m.LockupPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) AcceleratePeriod(ctx context.Context, in *MsgAcceleratePeriod, opts ...grpc.CallOption) (*MsgAcceleratePeriodResponse, error) {
+	out := new(MsgAcceleratePeriodResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/AcceleratePeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.VestingPeriods) > 0 {
-		for _, e := range m.VestingPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) ExtendLockup(ctx context.Context, in *MsgExtendLockup, opts ...grpc.CallOption) (*MsgExtendLockupResponse, error) {
+	out := new(MsgExtendLockupResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/ExtendLockup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFundVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetUnvestedStaking(ctx context.Context, in *MsgSetUnvestedStaking, opts ...grpc.CallOption) (*MsgSetUnvestedStakingResponse, error) {
+	out := new(MsgSetUnvestedStakingResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/SetUnvestedStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgClawback) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) BatchFundVestingAccounts(ctx context.Context, in *MsgBatchFundVestingAccounts, opts ...grpc.CallOption) (*MsgBatchFundVestingAccountsResponse, error) {
+	out := new(MsgBatchFundVestingAccountsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v2.Msg/BatchFundVestingAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
+	// according to the vesting and lockup schedules.
+	FundVestingAccount(context.Context, *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error)
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+	// UpdateVestingFunder updates the funder address of an existing
+	// ClawbackVestingAccount.
+	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
+	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
+	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// AccelerateVesting vests all the unvested coins of a ClawbackVestingAccount
+	// and optionally unlocks all its locked coins.
+	AccelerateVesting(context.Context, *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error)
+	// AcceleratePeriod vests a single future vesting period of a
+	// ClawbackVestingAccount.
+	AcceleratePeriod(context.Context, *MsgAcceleratePeriod) (*MsgAcceleratePeriodResponse, error)
+	// ExtendLockup delays the future unlocking events of a
+	// ClawbackVestingAccount with the consent of the vesting account.
+	ExtendLockup(context.Context, *MsgExtendLockup) (*MsgExtendLockupResponse, error)
+	// SetUnvestedStaking allows or disallows a ClawbackVestingAccount to
+	// delegate its unvested coins.
+	SetUnvestedStaking(context.Context, *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error)
+	// BatchFundVestingAccounts funds multiple existing ClawbackVestingAccounts
+	// in a single transaction using shared lockup and vesting schedules.
+	BatchFundVestingAccounts(context.Context, *MsgBatchFundVestingAccounts) (*MsgBatchFundVestingAccountsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) FundVestingAccount(ctx context.Context, req *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingFunder not implemented")
+}
+func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) AccelerateVesting(ctx context.Context, req *MsgAccelerateVesting) (*MsgAccelerateVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccelerateVesting not implemented")
+}
+func (*UnimplementedMsgServer) AcceleratePeriod(ctx context.Context, req *MsgAcceleratePeriod) (*MsgAcceleratePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceleratePeriod not implemented")
+}
+func (*UnimplementedMsgServer) ExtendLockup(ctx context.Context, req *MsgExtendLockup) (*MsgExtendLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendLockup not implemented")
+}
+func (*UnimplementedMsgServer) SetUnvestedStaking(ctx context.Context, req *MsgSetUnvestedStaking) (*MsgSetUnvestedStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnvestedStaking not implemented")
+}
+func (*UnimplementedMsgServer) BatchFundVestingAccounts(ctx context.Context, req *MsgBatchFundVestingAccounts) (*MsgBatchFundVestingAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFundVestingAccounts not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
 	}
-	l = len(m.DestAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/CreateClawbackVestingAccount",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClawbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FundVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/FundVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundVestingAccount(ctx, req.(*MsgFundVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateVestingFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateVestingFunder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateVestingFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/UpdateVestingFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateVestingFunder(ctx, req.(*MsgUpdateVestingFunder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/ConvertVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertVestingAccount(ctx, req.(*MsgConvertVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AccelerateVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccelerateVesting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AccelerateVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/AccelerateVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AccelerateVesting(ctx, req.(*MsgAccelerateVesting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceleratePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceleratePeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceleratePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/AcceleratePeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceleratePeriod(ctx, req.(*MsgAcceleratePeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExtendLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendLockup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendLockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/ExtendLockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendLockup(ctx, req.(*MsgExtendLockup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnvestedStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnvestedStaking)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/SetUnvestedStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnvestedStaking(ctx, req.(*MsgSetUnvestedStaking))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchFundVestingAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchFundVestingAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchFundVestingAccounts(ctx, in)
 	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v2.Msg/BatchFundVestingAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchFundVestingAccounts(ctx, req.(*MsgBatchFundVestingAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.vesting.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "FundVestingAccount",
+			Handler:    _Msg_FundVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+		{
+			MethodName: "UpdateVestingFunder",
+			Handler:    _Msg_UpdateVestingFunder_Handler,
+		},
+		{
+			MethodName: "ConvertVestingAccount",
+			Handler:    _Msg_ConvertVestingAccount_Handler,
+		},
+		{
+			MethodName: "AccelerateVesting",
+			Handler:    _Msg_AccelerateVesting_Handler,
+		},
+		{
+			MethodName: "AcceleratePeriod",
+			Handler:    _Msg_AcceleratePeriod_Handler,
+		},
+		{
+			MethodName: "ExtendLockup",
+			Handler:    _Msg_ExtendLockup_Handler,
+		},
+		{
+			MethodName: "SetUnvestedStaking",
+			Handler:    _Msg_SetUnvestedStaking_Handler,
+		},
+		{
+			MethodName: "BatchFundVestingAccounts",
+			Handler:    _Msg_BatchFundVestingAccounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/vesting/v2/tx.proto",
+}
+
+func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.EnableGovClawback {
+		i--
+		if m.EnableGovClawback {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbonding) > 0 {
+		for iNdEx := len(m.Unbonding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbonding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewFunderAddress) > 0 {
+		i -= len(m.NewFunderAddress)
+		copy(dAtA[i:], m.NewFunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateVestingFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateVestingFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateVestingFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccelerateVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccelerateVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccelerateVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unlock {
+		i--
+		if m.Unlock {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccelerateVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccelerateVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccelerateVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnlockedCoins) > 0 {
+		for iNdEx := len(m.UnlockedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnlockedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VestedCoins) > 0 {
+		for iNdEx := len(m.VestedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceleratePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceleratePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceleratePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceleratePeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceleratePeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceleratePeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestedCoins) > 0 {
+		for iNdEx := len(m.VestedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendLockup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendLockup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendLockup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Extension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Extension):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnvestedStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnvestedStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnvestedStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnvestedStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnvestedStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnvestedStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TemplateIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TemplateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchFundVestingAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchFundVestingAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchFundVestingAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EnableGovClawback {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Unbonding) > 0 {
+		for _, e := range m.Unbonding {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAccelerateVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Unlock {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAccelerateVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestedCoins) > 0 {
+		for _, e := range m.VestedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UnlockedCoins) > 0 {
+		for _, e := range m.UnlockedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceleratePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PeriodIndex != 0 {
+		n += 1 + sovTx(uint64(m.PeriodIndex))
+	}
+	return n
+}
+
+func (m *MsgAcceleratePeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestedCoins) > 0 {
+		for _, e := range m.VestedCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExtendLockup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Extension)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgExtendLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetUnvestedStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetUnvestedStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VestingTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TemplateIndex != 0 {
+		n += 1 + sovTx(uint64(m.TemplateIndex))
+	}
+	return n
+}
+
+func (m *MsgBatchFundVestingAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchFundVestingAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGovClawback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types1.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbonding = append(m.Unbonding, types1.Coin{})
+			if err := m.Unbonding[len(m.Unbonding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAccelerateVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAccelerateVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAccelerateVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unlock = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAccelerateVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAccelerateVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAccelerateVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestedCoins = append(m.VestedCoins, types1.Coin{})
+			if err := m.VestedCoins[len(m.VestedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockedCoins = append(m.UnlockedCoins, types1.Coin{})
+			if err := m.UnlockedCoins[len(m.UnlockedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgUpdateVestingFunder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewFunderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgConvertVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VestingAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgConvertVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceleratePeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1444,10 +4403,10 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceleratePeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceleratePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1516,9 +4475,9 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableGovClawback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodIndex", wireType)
 			}
-			var v int
+			m.PeriodIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1528,12 +4487,11 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PeriodIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableGovClawback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1555,7 +4513,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceleratePeriodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,12 +4536,46 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceleratePeriodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceleratePeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestedCoins = append(m.VestedCoins, types1.Coin{})
+			if err := m.VestedCoins[len(m.VestedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1605,7 +4597,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1628,10 +4620,10 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1700,7 +4692,7 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1727,15 +4719,115 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Extension, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUnvestedStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnvestedStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnvestedStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1745,31 +4837,61 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1779,26 +4901,12 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1820,7 +4928,7 @@ func (m *MsgFundVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetUnvestedStakingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1843,10 +4951,10 @@ func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetUnvestedStakingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetUnvestedStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1870,7 +4978,7 @@ func (m *MsgFundVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *VestingTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,17 +5001,17 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1913,29 +5021,30 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1945,29 +5054,31 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1977,23 +5088,25 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2016,7 +5129,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2039,17 +5152,17 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2059,26 +5172,43 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types1.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateIndex", wireType)
+			}
+			m.TemplateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TemplateIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2100,7 +5230,7 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchFundVestingAccounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,10 +5253,10 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchFundVestingAccounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchFundVestingAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2163,9 +5293,9 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2175,29 +5305,31 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, VestingTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2207,74 +5339,26 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Grants = append(m.Grants, BatchGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2296,7 +5380,7 @@ func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchFundVestingAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2319,17 +5403,17 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchFundVestingAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchFundVestingAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2339,74 +5423,26 @@ func (m *MsgConvertVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Total = append(m.Total, types1.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -46,6 +50,9 @@ type ClawbackVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// unvested_staking specifies whether the funder allows the account to
+	// delegate unvested coins
+	UnvestedStaking bool `protobuf:"varint,6,opt,name=unvested_staking,json=unvestedStaking,proto3" json:"unvested_staking,omitempty"`
 }
 
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
@@ -80,6 +87,150 @@ func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
 
+// PendingClawback defines unvested coins that were clawed back while being
+// delegated. They are transferred to the destination once the unbonding
+// completes.
+type PendingClawback struct {
+	// vesting_address is the address of the clawed back account
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// destination_address is the address that receives the coins
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	// unbonding_id is the ID of the unbonding delegation entry
+	UnbondingId uint64 `protobuf:"varint,3,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// amount is the amount of coins owed to the destination
+	Amount types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// entry_balance is the balance of the unbonding delegation entry when the
+	// amount was last updated, used to apply slashing to the amount
+	EntryBalance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=entry_balance,json=entryBalance,proto3,customtype=cosmossdk.io/math.Int" json:"entry_balance"`
+}
+
+func (m *PendingClawback) Reset()         { *m = PendingClawback{} }
+func (m *PendingClawback) String() string { return proto.CompactTextString(m) }
+func (*PendingClawback) ProtoMessage()    {}
+func (*PendingClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{1}
+}
+func (m *PendingClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingClawback.Merge(m, src)
+}
+func (m *PendingClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingClawback proto.InternalMessageInfo
+
+func (m *PendingClawback) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *PendingClawback) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
+func (m *PendingClawback) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *PendingClawback) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+// UnvestedSupply defines the tokens held by all clawback vesting accounts that
+// are not spendable, as of the last day epoch.
+type UnvestedSupply struct {
+	// locked defines the total amount of locked tokens
+	Locked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=locked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"locked"`
+	// unvested defines the total amount of unvested tokens
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// non_spendable defines the sum over all accounts of the larger of their
+	// locked and unvested tokens
+	NonSpendable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=non_spendable,json=nonSpendable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"non_spendable"`
+}
+
+func (m *UnvestedSupply) Reset()         { *m = UnvestedSupply{} }
+func (m *UnvestedSupply) String() string { return proto.CompactTextString(m) }
+func (*UnvestedSupply) ProtoMessage()    {}
+func (*UnvestedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{2}
+}
+func (m *UnvestedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnvestedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnvestedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnvestedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnvestedSupply.Merge(m, src)
+}
+func (m *UnvestedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnvestedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnvestedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnvestedSupply proto.InternalMessageInfo
+
+func (m *UnvestedSupply) GetLocked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+func (m *UnvestedSupply) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *UnvestedSupply) GetNonSpendable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NonSpendable
+	}
+	return nil
+}
+
 // ClawbackProposal is a gov Content type to clawback funds
 // from a vesting account that has this functionality enabled.
 type ClawbackProposal struct {
@@ -101,7 +252,7 @@ func (m *ClawbackProposal) Reset()         { *m = ClawbackProposal{} }
 func (m *ClawbackProposal) String() string { return proto.CompactTextString(m) }
 func (*ClawbackProposal) ProtoMessage()    {}
 func (*ClawbackProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0001d894a8ee0c72, []int{1}
+	return fileDescriptor_0001d894a8ee0c72, []int{3}
 }
 func (m *ClawbackProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,46 +311,64 @@ func (m *ClawbackProposal) GetDestinationAddress() string {
 
 func init() {
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "evmos.vesting.v2.ClawbackVestingAccount")
+	proto.RegisterType((*PendingClawback)(nil), "evmos.vesting.v2.PendingClawback")
+	proto.RegisterType((*UnvestedSupply)(nil), "evmos.vesting.v2.UnvestedSupply")
 	proto.RegisterType((*ClawbackProposal)(nil), "evmos.vesting.v2.ClawbackProposal")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/vesting.proto", fileDescriptor_0001d894a8ee0c72) }
 
 var fileDescriptor_0001d894a8ee0c72 = []byte{
-	// 522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x18, 0xcd, 0xd8, 0xae, 0xd2, 0x29, 0xad, 0xeb, 0x58, 0xa4, 0xf4, 0x90, 0x94, 0x45, 0xa1, 0x16,
-	0xcc, 0xb8, 0x15, 0x04, 0xf7, 0xb6, 0x51, 0xc4, 0xe3, 0x52, 0xc4, 0x83, 0x97, 0x30, 0x49, 0x66,
-	0xb3, 0xa1, 0x49, 0x26, 0x64, 0x26, 0x75, 0xfd, 0x07, 0x22, 0x88, 0x7b, 0x14, 0xbc, 0xf4, 0x28,
-	0x9e, 0xf6, 0x5f, 0xb8, 0xc7, 0x1e, 0x3d, 0xed, 0x4a, 0x7b, 0x58, 0x7f, 0x86, 0x64, 0x66, 0xb2,
-	0xa6, 0xa8, 0x57, 0x2f, 0xc9, 0xcc, 0x7b, 0x5f, 0xbe, 0x79, 0x6f, 0xbe, 0x17, 0x68, 0xd2, 0x79,
-	0xc2, 0x38, 0x9e, 0x53, 0x2e, 0xa2, 0x34, 0xc4, 0xf3, 0x49, 0xb5, 0xb4, 0xb3, 0x9c, 0x09, 0x86,
-	0xb6, 0x25, 0x6f, 0x57, 0xe0, 0x7c, 0x32, 0xb8, 0x45, 0x92, 0x28, 0x65, 0x58, 0x3e, 0x55, 0xd1,
-	0xe0, 0xae, 0xcf, 0xf8, 0x46, 0x97, 0x5d, 0x8f, 0x0a, 0xb2, 0xbb, 0xd9, 0x6a, 0xd0, 0x0b, 0x59,
-	0xc8, 0xe4, 0x12, 0x97, 0x2b, 0x8d, 0x5a, 0x21, 0x63, 0x61, 0x4c, 0xb1, 0xdc, 0x79, 0xc5, 0x21,
-	0x16, 0x51, 0x42, 0xb9, 0x20, 0x49, 0xa6, 0x0a, 0x76, 0xbe, 0x35, 0xe1, 0x9d, 0xa7, 0x31, 0x79,
-	0xe3, 0x11, 0x7f, 0xf6, 0x4a, 0x35, 0xdc, 0xf7, 0x7d, 0x56, 0xa4, 0x02, 0x79, 0xb0, 0xe7, 0x11,
-	0x4e, 0x5d, 0x7d, 0x8e, 0x4b, 0x14, 0xde, 0x07, 0x43, 0x30, 0x6a, 0x4f, 0xc6, 0xb6, 0x92, 0xf5,
-	0x5b, 0xbc, 0x92, 0x65, 0x3b, 0x84, 0xd3, 0xcd, 0x4e, 0x4e, 0x73, 0x79, 0x6e, 0x81, 0x29, 0xf2,
-	0xfe, 0x60, 0xd0, 0x3d, 0xd8, 0x3d, 0x2c, 0xd2, 0x80, 0xe6, 0x2e, 0x09, 0x82, 0x9c, 0x72, 0xde,
-	0xbf, 0x36, 0x04, 0xa3, 0xd6, 0xb4, 0xa3, 0xd0, 0x7d, 0x05, 0xa2, 0x17, 0x10, 0x72, 0x41, 0x72,
-	0xe1, 0x96, 0xf2, 0xfb, 0x0d, 0x29, 0x60, 0x60, 0x2b, 0x6f, 0x76, 0xe5, 0xcd, 0x7e, 0x59, 0x79,
-	0x73, 0x3a, 0x67, 0xe7, 0x96, 0x71, 0x72, 0x61, 0x81, 0x2f, 0x97, 0xa7, 0x63, 0x30, 0x6d, 0xc9,
-	0x8f, 0x4b, 0x1a, 0x7d, 0x00, 0xb0, 0x1b, 0x33, 0x7f, 0x56, 0x64, 0x6e, 0x46, 0xf3, 0x88, 0x05,
-	0xbc, 0xdf, 0x1c, 0x36, 0x46, 0xed, 0x89, 0xf9, 0x2f, 0x3f, 0x07, 0xb2, 0xcc, 0x79, 0x5e, 0xb6,
-	0xfc, 0x7a, 0x61, 0x3d, 0x09, 0x23, 0x71, 0x54, 0x78, 0xb6, 0xcf, 0x12, 0xac, 0x07, 0xa3, 0x5e,
-	0x0f, 0x78, 0x30, 0xc3, 0xc7, 0x98, 0x14, 0xe2, 0xe8, 0x6a, 0x54, 0xe2, 0x6d, 0x46, 0xb9, 0xee,
-	0xc0, 0x95, 0x96, 0x8e, 0x3a, 0x5d, 0x63, 0xe8, 0x23, 0x80, 0x37, 0xab, 0x0b, 0xae, 0x04, 0x6d,
-	0xfd, 0x57, 0x41, 0x5d, 0xcd, 0x69, 0x70, 0xef, 0xf1, 0xbb, 0x85, 0x65, 0x7c, 0x5a, 0x58, 0xc6,
-	0xfb, 0xcb, 0xd3, 0xf1, 0x7d, 0x15, 0xe0, 0xe3, 0x7a, 0x84, 0xff, 0x1e, 0x97, 0x9d, 0xcf, 0x00,
-	0x6e, 0x57, 0xd4, 0x41, 0xce, 0x32, 0xc6, 0x49, 0x8c, 0x7a, 0x70, 0x4b, 0x44, 0x22, 0xa6, 0x32,
-	0x34, 0xad, 0xa9, 0xda, 0xa0, 0x21, 0x6c, 0x07, 0x94, 0xfb, 0x79, 0x94, 0x89, 0x88, 0xa5, 0x7a,
-	0xe4, 0x75, 0x08, 0xf5, 0xe1, 0x8d, 0x2a, 0x10, 0x0d, 0xc9, 0x56, 0x5b, 0x84, 0xe1, 0xed, 0x40,
-	0x1e, 0x4c, 0xca, 0xc2, 0xab, 0xd8, 0x34, 0x65, 0x15, 0xaa, 0x51, 0x3a, 0x3b, 0x7b, 0xcd, 0x9f,
-	0x0b, 0xcb, 0x70, 0x9e, 0x9d, 0xad, 0x4c, 0xb0, 0x5c, 0x99, 0xe0, 0xc7, 0xca, 0x04, 0x27, 0x6b,
-	0xd3, 0x58, 0xae, 0x4d, 0xe3, 0xfb, 0xda, 0x34, 0x5e, 0x8f, 0x6b, 0xf7, 0xa7, 0xdc, 0xea, 0x9f,
-	0x76, 0xf2, 0xb0, 0xe6, 0x5b, 0x5e, 0x9c, 0x77, 0x5d, 0x66, 0xed, 0xd1, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x47, 0xd1, 0xdd, 0x7b, 0xd8, 0x03, 0x00, 0x00,
+	// 773 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3f, 0x6f, 0xf3, 0x44,
+	0x18, 0x8f, 0x93, 0x34, 0xef, 0xdb, 0x4b, 0x93, 0x96, 0xa3, 0x20, 0xbf, 0x19, 0xec, 0x10, 0x81,
+	0x48, 0x83, 0x6a, 0xd3, 0x20, 0x90, 0xa8, 0x58, 0xea, 0x22, 0x44, 0xb7, 0x28, 0x05, 0x06, 0x16,
+	0xeb, 0x6c, 0x5f, 0x1d, 0x2b, 0xce, 0x9d, 0xe5, 0x3b, 0x87, 0xf6, 0x1b, 0x20, 0x24, 0x44, 0x47,
+	0x24, 0x24, 0xd4, 0x11, 0x31, 0x75, 0xe0, 0x43, 0x74, 0xac, 0x98, 0x10, 0x43, 0x8b, 0xda, 0xa1,
+	0xcc, 0x7c, 0x02, 0xe4, 0xfb, 0x13, 0x52, 0xa0, 0xdd, 0xfa, 0x2e, 0xce, 0xdd, 0xef, 0xf9, 0xf7,
+	0x7b, 0x9e, 0xfb, 0x3d, 0x01, 0x16, 0x9e, 0xcf, 0x28, 0x73, 0xe7, 0x98, 0xf1, 0x84, 0xc4, 0xee,
+	0x7c, 0xa8, 0x8f, 0x4e, 0x96, 0x53, 0x4e, 0xe1, 0x86, 0xb0, 0x3b, 0x1a, 0x9c, 0x0f, 0x3b, 0xaf,
+	0xa0, 0x59, 0x42, 0xa8, 0x2b, 0xbe, 0xd2, 0xa9, 0x63, 0x85, 0x94, 0x95, 0x59, 0x02, 0xc4, 0xb0,
+	0x3b, 0xdf, 0x09, 0x30, 0x47, 0x3b, 0x6e, 0x48, 0x13, 0xa2, 0xec, 0x2f, 0xa4, 0xdd, 0x17, 0x37,
+	0x57, 0x5e, 0x94, 0xe9, 0x4d, 0x15, 0xba, 0x20, 0xa0, 0xa2, 0xef, 0xb1, 0xe8, 0x6c, 0xc6, 0x34,
+	0xa6, 0x32, 0xba, 0x3c, 0x29, 0xd4, 0x8e, 0x29, 0x8d, 0x53, 0xec, 0x8a, 0x5b, 0x50, 0x1c, 0xb9,
+	0x3c, 0x99, 0x61, 0xc6, 0xd1, 0x2c, 0x93, 0x0e, 0xbd, 0xbf, 0xea, 0xe0, 0xf5, 0xfd, 0x14, 0x7d,
+	0x15, 0xa0, 0x70, 0xfa, 0x85, 0x4c, 0xb8, 0x17, 0x86, 0xb4, 0x20, 0x1c, 0x06, 0x60, 0xb3, 0x64,
+	0xeb, 0xab, 0x3a, 0x3e, 0x92, 0xb8, 0x69, 0x74, 0x8d, 0x7e, 0x73, 0x38, 0x70, 0x14, 0xc9, 0x45,
+	0xdf, 0x92, 0x96, 0xe3, 0x21, 0x86, 0xef, 0x67, 0xf2, 0xea, 0x97, 0x57, 0xb6, 0x31, 0x86, 0xc1,
+	0x7f, 0x2c, 0xf0, 0x2d, 0xd0, 0x3e, 0x2a, 0x48, 0x84, 0x73, 0x1f, 0x45, 0x51, 0x8e, 0x19, 0x33,
+	0xab, 0x5d, 0xa3, 0xbf, 0x3a, 0x6e, 0x49, 0x74, 0x4f, 0x82, 0xf0, 0x53, 0x00, 0x18, 0x47, 0x39,
+	0xf7, 0x4b, 0xfa, 0x66, 0x4d, 0x10, 0xe8, 0x38, 0xb2, 0x37, 0x47, 0xf7, 0xe6, 0x7c, 0xa6, 0x7b,
+	0xf3, 0x5a, 0x17, 0x57, 0x76, 0xe5, 0xf4, 0xda, 0x36, 0x7e, 0xba, 0x3b, 0x1f, 0x18, 0xe3, 0x55,
+	0x11, 0x5c, 0x9a, 0xe1, 0xb7, 0x06, 0x68, 0xa7, 0x34, 0x9c, 0x16, 0x99, 0x9f, 0xe1, 0x3c, 0xa1,
+	0x11, 0x33, 0xeb, 0xdd, 0x5a, 0xbf, 0x39, 0xb4, 0x1e, 0xea, 0x67, 0x24, 0xdc, 0xbc, 0x4f, 0xca,
+	0x94, 0x3f, 0x5f, 0xdb, 0x1f, 0xc6, 0x09, 0x9f, 0x14, 0x81, 0x13, 0xd2, 0x99, 0x7a, 0x26, 0xf5,
+	0xb3, 0xcd, 0xa2, 0xa9, 0x7b, 0xec, 0xa2, 0x82, 0x4f, 0x16, 0x4f, 0xc5, 0x4f, 0x32, 0xcc, 0x54,
+	0x06, 0x26, 0xb9, 0xb4, 0x64, 0x75, 0x85, 0xc1, 0xef, 0x0c, 0xb0, 0xae, 0x07, 0xac, 0x09, 0xad,
+	0xbc, 0x54, 0x42, 0x6d, 0x65, 0xd3, 0x8c, 0xb6, 0xc0, 0x46, 0x41, 0x4a, 0x0c, 0x47, 0x3e, 0xe3,
+	0x68, 0x9a, 0x90, 0xd8, 0x6c, 0x74, 0x8d, 0xfe, 0xf3, 0xf1, 0xba, 0xc6, 0x0f, 0x25, 0xbc, 0xfb,
+	0xc1, 0xd7, 0x67, 0x76, 0xe5, 0xfb, 0x33, 0xbb, 0xf2, 0xcd, 0xdd, 0xf9, 0x60, 0x4b, 0xae, 0xc9,
+	0xf1, 0xf2, 0xa2, 0xfc, 0xbf, 0xb2, 0x7a, 0x3f, 0x56, 0xc1, 0xfa, 0x08, 0x93, 0x28, 0x21, 0xb1,
+	0xf6, 0x80, 0x6f, 0xff, 0x33, 0x07, 0x2d, 0x05, 0x43, 0x48, 0x41, 0xf3, 0xd3, 0x5a, 0x70, 0xc1,
+	0xab, 0x91, 0x40, 0x10, 0x4f, 0x28, 0xf9, 0x97, 0x6e, 0xe0, 0x92, 0x49, 0x07, 0xbc, 0x01, 0xd6,
+	0x0a, 0x12, 0x50, 0x51, 0xce, 0x4f, 0x22, 0x21, 0x9f, 0xfa, 0xb8, 0xb9, 0xc0, 0x0e, 0x22, 0xf8,
+	0x11, 0x68, 0xa0, 0x99, 0x10, 0x77, 0x5d, 0x68, 0xeb, 0x85, 0x9e, 0x7d, 0x29, 0xd9, 0xc5, 0xe0,
+	0xf7, 0x69, 0x42, 0xbc, 0xd5, 0x72, 0xec, 0x72, 0x72, 0x2a, 0x06, 0x8e, 0x40, 0x0b, 0x13, 0x9e,
+	0x9f, 0xf8, 0x01, 0x4a, 0x11, 0x09, 0xb1, 0xb9, 0x52, 0x72, 0xf1, 0xde, 0x29, 0x3d, 0x7f, 0xbf,
+	0xb2, 0x5f, 0x93, 0xb9, 0x58, 0x34, 0x75, 0x12, 0xea, 0xce, 0x10, 0x9f, 0x38, 0x07, 0x84, 0xff,
+	0xfa, 0xcb, 0x36, 0x50, 0x45, 0x0e, 0x08, 0x1f, 0xaf, 0x89, 0x0c, 0x9e, 0x4c, 0xd0, 0xbb, 0xae,
+	0x82, 0xf6, 0xe7, 0x7a, 0xd8, 0x45, 0x96, 0xa5, 0x27, 0x70, 0x02, 0x1a, 0xa5, 0x72, 0x70, 0x64,
+	0x1a, 0xdd, 0xda, 0xe3, 0x14, 0xdf, 0x57, 0xca, 0xe8, 0x3f, 0xaa, 0x0c, 0x29, 0x85, 0x32, 0x40,
+	0x09, 0x41, 0xe5, 0x87, 0x29, 0x78, 0xae, 0x1f, 0xda, 0xac, 0x3e, 0x51, 0xad, 0x45, 0x05, 0x58,
+	0x80, 0x16, 0xa1, 0xc4, 0x67, 0x19, 0x26, 0x11, 0x0a, 0xd2, 0x72, 0xbb, 0x9f, 0xa6, 0xe4, 0x1a,
+	0xa1, 0xe4, 0x50, 0x57, 0xe9, 0xfd, 0x60, 0x80, 0x0d, 0xad, 0xbd, 0x51, 0x4e, 0x33, 0xca, 0x50,
+	0x0a, 0x37, 0xc1, 0x0a, 0x4f, 0x78, 0x8a, 0x95, 0xf2, 0xe4, 0x05, 0x76, 0x41, 0x33, 0xc2, 0x2c,
+	0xcc, 0x93, 0xac, 0x54, 0x95, 0x12, 0xda, 0x32, 0x04, 0x4d, 0xf0, 0x4c, 0xcb, 0xb0, 0x26, 0xac,
+	0xcf, 0xd0, 0xe3, 0x62, 0xad, 0x3f, 0x24, 0xd6, 0xdd, 0xfa, 0x9f, 0x67, 0x76, 0xc5, 0xfb, 0xf8,
+	0xe2, 0xc6, 0x32, 0x2e, 0x6f, 0x2c, 0xe3, 0x8f, 0x1b, 0xcb, 0x38, 0xbd, 0xb5, 0x2a, 0x97, 0xb7,
+	0x56, 0xe5, 0xb7, 0x5b, 0xab, 0xf2, 0xe5, 0x60, 0xa9, 0x69, 0xb9, 0x70, 0xf2, 0x3b, 0x1f, 0xbe,
+	0xbb, 0xb4, 0x7a, 0xa2, 0xf9, 0xa0, 0x21, 0xfe, 0x19, 0xdf, 0xfb, 0x7b, 0x00, 0x64, 0x9f, 0xae,
+	0x0e, 0xc1, 0x06, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -222,6 +391,16 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.UnvestedStaking {
+		i--
+		if m.UnvestedStaking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -280,6 +459,133 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EntryBalance.Size()
+		i -= size
+		if _, err := m.EntryBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVesting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UnbondingId != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnvestedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnvestedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnvestedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonSpendable) > 0 {
+		for iNdEx := len(m.NonSpendable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonSpendable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClawbackProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -370,6 +676,60 @@ func (m *ClawbackVestingAccount) Size() (n int) {
 			n += 1 + l + sovVesting(uint64(l))
 		}
 	}
+	if m.UnvestedStaking {
+		n += 2
+	}
+	return n
+}
+
+func (m *PendingClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.UnbondingId != 0 {
+		n += 1 + sovVesting(uint64(m.UnbondingId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	l = m.EntryBalance.Size()
+	n += 1 + l + sovVesting(uint64(l))
+	return n
+}
+
+func (m *UnvestedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.NonSpendable) > 0 {
+		for _, e := range m.NonSpendable {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -602,6 +962,378 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnvestedStaking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnvestedStaking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EntryBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnvestedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnvestedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnvestedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, types1.Coin{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types1.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonSpendable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonSpendable = append(m.NonSpendable, types1.Coin{})
+			if err := m.NonSpendable[len(m.NonSpendable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])